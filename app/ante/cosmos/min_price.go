@@ -38,17 +38,23 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
 	}
 
-	minGasPrice := mpd.feesKeeper.GetParams(ctx).MinGasPrice
+	feeMarketParams := mpd.feesKeeper.GetParams(ctx)
+	minGasPrice := feeMarketParams.MinGasPrice
 
 	feeCoins := feeTx.GetFee()
 	evmParams := mpd.evmKeeper.GetParams(ctx)
 	evmDenom := evmParams.GetEvmDenom()
 
-	// only allow user to pass in dhives and stake native token as transaction fees
+	// only allow user to pass in dhives, stake native token or a single accepted fee denom as transaction fees
 	// allow use stake native tokens for fees is just for unit tests to pass
-	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains([]string{evmDenom, sdk.DefaultBondDenom}, feeCoins.GetDenomByIndex(0)))
+	feeDenoms := []string{evmDenom, sdk.DefaultBondDenom}
+	for _, feeDenom := range feeMarketParams.FeeDenoms {
+		feeDenoms = append(feeDenoms, feeDenom.Denom)
+	}
+
+	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains(feeDenoms, feeCoins.GetDenomByIndex(0)))
 	if !validFees && !simulate {
-		return ctx, fmt.Errorf("expected only use native token %s for fee, but got %s", evmDenom, feeCoins.String())
+		return ctx, fmt.Errorf("expected only use native token %s for fee or an accepted fee denom, but got %s", evmDenom, feeCoins.String())
 	}
 
 	// Short-circuit if min gas price is 0 or if simulating
@@ -85,6 +91,17 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			requiredFees)
 	}
 
+	// compare the fees paid in an accepted fee denom with their EVM denom equivalent
+	if len(feeCoins) == 1 && feeCoins[0].Denom != evmDenom {
+		if _, found := feeMarketParams.GetFeeDenom(feeCoins[0].Denom); found {
+			convertedFee, err := mpd.feesKeeper.ConvertFromFeeDenom(ctx, feeCoins[0])
+			if err != nil {
+				return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "failed to convert fees %s: %s", feeCoins, err)
+			}
+			feeCoins = sdk.Coins{{Denom: evmDenom, Amount: convertedFee}}
+		}
+	}
+
 	if !feeCoins.IsAnyGTE(requiredFees) {
 		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee,
 			"provided fee < minimum global fee (%s < %s). Please increase the gas price.",
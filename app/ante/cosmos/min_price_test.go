@@ -11,6 +11,7 @@ import (
 	"github.com/evmos/evmos/v16/testutil"
 	testutiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/utils"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 var execTypes = []struct {
@@ -166,6 +167,38 @@ func (suite *AnteTestSuite) TestMinGasPriceDecorator() {
 			"",
 			true,
 		},
+		{
+			"valid cosmos tx with MinGasPrices = 10, fees paid in an accepted fee denom",
+			func() sdk.Tx {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.FeeDenoms = []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom("uusdc", math.LegacyNewDec(2))}
+				err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+
+				txBuilder := suite.CreateTestCosmosTxBuilder(math.NewInt(20), "uusdc", &testMsg)
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"invalid cosmos tx with MinGasPrices = 10, insufficient fees paid in an accepted fee denom",
+			func() sdk.Tx {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.FeeDenoms = []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom("uusdc", math.LegacyNewDec(2))}
+				err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+
+				txBuilder := suite.CreateTestCosmosTxBuilder(math.NewInt(10), "uusdc", &testMsg)
+				return txBuilder.GetTx()
+			},
+			false,
+			"provided fee < minimum global fee",
+			true,
+		},
 		{
 			"valid cosmos tx with MinGasPrices = 0, gasPrice = 0, invalid fees",
 			func() sdk.Tx {
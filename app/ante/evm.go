@@ -17,6 +17,7 @@ func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			options.EvmKeeper,
 			options.DistributionKeeper,
 			options.StakingKeeper,
+			options.TxReplacer,
 			options.MaxTxGasWanted,
 		),
 	)
@@ -8,19 +8,22 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// CheckMempoolFee checks if the provided fee is at least as large as the local validator's
-func CheckMempoolFee(fee, mempoolMinGasPrice, gasLimit sdkmath.LegacyDec, isLondon bool) error {
+// CheckMempoolFee checks if the provided fee is at least as large as the local validator's.
+// The fee is multiplied by the gas price multiplier of the called contract, so that the
+// discounted or surcharged fee that is actually charged is checked.
+func CheckMempoolFee(fee, mempoolMinGasPrice, gasLimit, gasPriceMultiplier sdkmath.LegacyDec, isLondon bool) error {
 	if isLondon {
 		return nil
 	}
 
+	effectiveFee := fee.Mul(gasPriceMultiplier)
 	requiredFee := mempoolMinGasPrice.Mul(gasLimit)
 
-	if fee.LT(requiredFee) {
+	if effectiveFee.LT(requiredFee) {
 		return errorsmod.Wrapf(
 			errortypes.ErrInsufficientFee,
 			"insufficient fee; got: %s required: %s",
-			fee, requiredFee,
+			effectiveFee, requiredFee,
 		)
 	}
 
@@ -16,6 +16,7 @@ func (suite *EvmAnteTestSuite) TestMempoolFee() {
 		txFee         sdkmath.LegacyDec
 		minGasPrice   sdkmath.LegacyDec
 		gasLimit      sdkmath.LegacyDec
+		multiplier    sdkmath.LegacyDec
 	}{
 		{
 			name:          "success: if London fork is enabled, skip check",
@@ -25,6 +26,7 @@ func (suite *EvmAnteTestSuite) TestMempoolFee() {
 			txFee:       sdkmath.LegacyOneDec(),
 			minGasPrice: sdkmath.LegacyOneDec(),
 			gasLimit:    sdkmath.LegacyOneDec(),
+			multiplier:  sdkmath.LegacyOneDec(),
 		},
 		{
 			name:          "success: fee is greater than min gas price * gas limit",
@@ -33,6 +35,7 @@ func (suite *EvmAnteTestSuite) TestMempoolFee() {
 			txFee:         sdkmath.LegacyNewDec(100),
 			minGasPrice:   sdkmath.LegacyOneDec(),
 			gasLimit:      sdkmath.LegacyOneDec(),
+			multiplier:    sdkmath.LegacyOneDec(),
 		},
 		{
 			name:          "fail: fee is less than min gas price * gas limit",
@@ -41,6 +44,25 @@ func (suite *EvmAnteTestSuite) TestMempoolFee() {
 			txFee:         sdkmath.LegacyOneDec(),
 			minGasPrice:   sdkmath.LegacyNewDec(100),
 			gasLimit:      sdkmath.LegacyOneDec(),
+			multiplier:    sdkmath.LegacyOneDec(),
+		},
+		{
+			name:          "success: surcharged fee is greater than min gas price * gas limit",
+			expectedError: nil,
+			isLondon:      false,
+			txFee:         sdkmath.LegacyNewDec(50),
+			minGasPrice:   sdkmath.LegacyNewDec(100),
+			gasLimit:      sdkmath.LegacyOneDec(),
+			multiplier:    sdkmath.LegacyNewDec(2),
+		},
+		{
+			name:          "fail: discounted fee is less than min gas price * gas limit",
+			expectedError: errortypes.ErrInsufficientFee,
+			isLondon:      false,
+			txFee:         sdkmath.LegacyNewDec(100),
+			minGasPrice:   sdkmath.LegacyNewDec(100),
+			gasLimit:      sdkmath.LegacyOneDec(),
+			multiplier:    sdkmath.LegacyNewDecWithPrec(5, 1),
 		},
 	}
 
@@ -51,6 +73,7 @@ func (suite *EvmAnteTestSuite) TestMempoolFee() {
 				tc.txFee,
 				tc.minGasPrice,
 				tc.gasLimit,
+				tc.multiplier,
 				tc.isLondon,
 			)
 
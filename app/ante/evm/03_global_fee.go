@@ -15,19 +15,22 @@ import (
 // that lowers EffectivePrice until it is < MinGasPrices, the users must
 // increase the GasTipCap (priority fee) until EffectivePrice > MinGasPrices.
 // Transactions with MinGasPrices * gasUsed < tx fees < EffectiveFee are rejected
-// by the feemarket AnteHandle
-func CheckGlobalFee(fee, globalMinGasPrice, gasLimit math.LegacyDec) error {
+// by the feemarket AnteHandle.
+// The fee is multiplied by the gas price multiplier of the called contract, so
+// that the discounted or surcharged fee that is actually charged is checked.
+func CheckGlobalFee(fee, globalMinGasPrice, gasLimit, gasPriceMultiplier math.LegacyDec) error {
 	if globalMinGasPrice.IsZero() {
 		return nil
 	}
 
+	effectiveFee := fee.Mul(gasPriceMultiplier)
 	requiredFee := globalMinGasPrice.Mul(gasLimit)
 
-	if fee.LT(requiredFee) {
+	if effectiveFee.LT(requiredFee) {
 		return errorsmod.Wrapf(
 			errortypes.ErrInsufficientFee,
 			"provided fee < minimum global fee (%s < %s). Please increase the priority tip (for EIP-1559 txs) or the gas prices (for access list or legacy txs)", //nolint:lll
-			fee.TruncateInt().String(), requiredFee.TruncateInt().String(),
+			effectiveFee.TruncateInt().String(), requiredFee.TruncateInt().String(),
 		)
 	}
 
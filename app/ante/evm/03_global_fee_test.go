@@ -15,6 +15,7 @@ func (suite *EvmAnteTestSuite) TestGlobalFee() {
 		txFee             sdkmath.LegacyDec
 		globalMinGasPrice sdkmath.LegacyDec
 		gasLimit          sdkmath.LegacyDec
+		multiplier        sdkmath.LegacyDec
 	}{
 		{
 			name:          "success: if globalMinGasPrice is 0, skip check",
@@ -23,6 +24,7 @@ func (suite *EvmAnteTestSuite) TestGlobalFee() {
 			txFee:             sdkmath.LegacyOneDec(),
 			globalMinGasPrice: sdkmath.LegacyZeroDec(),
 			gasLimit:          sdkmath.LegacyOneDec(),
+			multiplier:        sdkmath.LegacyOneDec(),
 		},
 		{
 			name:              "success: fee is greater than global gas price * gas limit",
@@ -30,6 +32,7 @@ func (suite *EvmAnteTestSuite) TestGlobalFee() {
 			txFee:             sdkmath.LegacyNewDec(100),
 			globalMinGasPrice: sdkmath.LegacyOneDec(),
 			gasLimit:          sdkmath.LegacyOneDec(),
+			multiplier:        sdkmath.LegacyOneDec(),
 		},
 		{
 			name:              "fail: fee is less than global gas price * gas limit",
@@ -37,6 +40,23 @@ func (suite *EvmAnteTestSuite) TestGlobalFee() {
 			txFee:             sdkmath.LegacyOneDec(),
 			globalMinGasPrice: sdkmath.LegacyNewDec(100),
 			gasLimit:          sdkmath.LegacyOneDec(),
+			multiplier:        sdkmath.LegacyOneDec(),
+		},
+		{
+			name:              "success: surcharged fee is greater than global gas price * gas limit",
+			expectedError:     nil,
+			txFee:             sdkmath.LegacyNewDec(50),
+			globalMinGasPrice: sdkmath.LegacyNewDec(100),
+			gasLimit:          sdkmath.LegacyOneDec(),
+			multiplier:        sdkmath.LegacyNewDec(2),
+		},
+		{
+			name:              "fail: discounted fee is less than global gas price * gas limit",
+			expectedError:     errortypes.ErrInsufficientFee,
+			txFee:             sdkmath.LegacyNewDec(100),
+			globalMinGasPrice: sdkmath.LegacyNewDec(100),
+			gasLimit:          sdkmath.LegacyOneDec(),
+			multiplier:        sdkmath.LegacyNewDecWithPrec(5, 1),
 		},
 	}
 
@@ -47,6 +67,7 @@ func (suite *EvmAnteTestSuite) TestGlobalFee() {
 				tc.txFee,
 				tc.globalMinGasPrice,
 				tc.gasLimit,
+				tc.multiplier,
 			)
 
 			if tc.expectedError != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/evmos/evmos/v16/x/evm/keeper"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // VerifyAccountBalance checks that the account balance is greater than the total transaction cost.
@@ -45,3 +46,63 @@ func VerifyAccountBalance(
 
 	return nil
 }
+
+// SelectFeeDenom returns the denom used to pay the fees of the transaction. The
+// EVM denom is selected whenever the account balance covers the total transaction
+// cost. Otherwise, the fees are paid with the first accepted fee denom of the fee
+// market parameters that the sender can afford, while the transferred value still
+// needs to be covered by the EVM denom balance. The balances are checked against
+// the fees multiplied by the gas price multiplier of the called contract, converted
+// to each fee denom, as they are charged. The balance verification error is
+// returned if no denom can be used.
+func SelectFeeDenom(
+	ctx sdk.Context,
+	accountKeeper evmtypes.AccountKeeper,
+	bankKeeper evmtypes.BankKeeper,
+	feeMarketKeeper FeeMarketKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+	evmDenom string,
+) (string, error) {
+	err := VerifyAccountBalance(ctx, accountKeeper, account, from, txData)
+	if err != nil && !errorsmod.IsOf(err, errortypes.ErrInsufficientFunds) {
+		return evmDenom, err
+	}
+
+	multiplier := feeMarketKeeper.GetGasPriceMultiplier(ctx, txData.GetTo())
+	fee := sdkmath.NewIntFromBigInt(txData.Fee())
+	value := sdkmath.NewIntFromBigInt(txData.GetValue())
+	balance := bankKeeper.GetBalance(ctx, from.Bytes(), evmDenom).Amount
+
+	evmFees := feemarkettypes.ApplyGasPriceMultiplier(sdk.Coins{{Denom: evmDenom, Amount: fee}}, multiplier)
+	cost := value.Add(evmFees[0].Amount)
+	if balance.GTE(cost) {
+		return evmDenom, nil
+	}
+
+	if err == nil {
+		err = errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < tx cost with the gas price multiplier (%s < %s%s)", balance, cost, evmDenom,
+		)
+	}
+
+	if balance.LT(value) {
+		return "", err
+	}
+
+	for _, feeDenom := range feeMarketKeeper.GetParams(ctx).FeeDenoms {
+		converted, convErr := feeMarketKeeper.ConvertToFeeDenom(ctx, fee, feeDenom.Denom)
+		if convErr != nil {
+			return "", convErr
+		}
+
+		fees := feemarkettypes.ApplyGasPriceMultiplier(sdk.Coins{converted}, multiplier)
+		if bankKeeper.GetBalance(ctx, from.Bytes(), feeDenom.Denom).IsGTE(fees[0]) {
+			return feeDenom.Denom, nil
+		}
+	}
+
+	return "", err
+}
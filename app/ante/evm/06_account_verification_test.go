@@ -5,15 +5,19 @@ import (
 	"math/big"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v16/app/ante/evm"
+	"github.com/evmos/evmos/v16/testutil"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
@@ -131,6 +135,237 @@ func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
 	}
 }
 
+func (suite *EvmAnteTestSuite) TestSelectFeeDenom() {
+	// Setup
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	senderKey := keyring.GetKey(1)
+	feeDenom := "uusdc"
+
+	testCases := []struct {
+		name           string
+		expectedError  error
+		expectedDenom  string
+		feeDenoms      []feemarkettypes.FeeDenom
+		feeDenomAmount int64
+		generateArgs   func() evmtypes.EvmTxArgs
+	}{
+		{
+			name:          "success: the EVM denom balance covers the transaction cost",
+			expectedDenom: unitNetwork.GetDenom(),
+			feeDenoms:     []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom(feeDenom, math.LegacyOneDec())},
+			generateArgs: func() evmtypes.EvmTxArgs {
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+				suite.Require().NoError(err)
+				return txArgs
+			},
+		},
+		{
+			name:          "fail: the EVM denom balance doesn't cover the cost and there are no accepted fee denoms",
+			expectedError: errortypes.ErrInsufficientFunds,
+			generateArgs: func() evmtypes.EvmTxArgs {
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+				suite.Require().NoError(err)
+				txArgs.Amount = suite.getBalance(grpcHandler, senderKey, unitNetwork.GetDenom()).BigInt()
+				return txArgs
+			},
+		},
+		{
+			name:          "fail: the EVM denom balance doesn't cover the transferred value",
+			expectedError: errortypes.ErrInsufficientFunds,
+			feeDenoms:     []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom(feeDenom, math.LegacyOneDec())},
+			generateArgs: func() evmtypes.EvmTxArgs {
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+				suite.Require().NoError(err)
+				txArgs.Amount = suite.getBalance(grpcHandler, senderKey, unitNetwork.GetDenom()).AddRaw(1).BigInt()
+				return txArgs
+			},
+		},
+		{
+			name:           "fail: the accepted fee denom balance doesn't cover the fees",
+			expectedError:  errortypes.ErrInsufficientFunds,
+			feeDenoms:      []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom(feeDenom, math.LegacyOneDec())},
+			feeDenomAmount: 1,
+			generateArgs: func() evmtypes.EvmTxArgs {
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+				suite.Require().NoError(err)
+				txArgs.Amount = suite.getBalance(grpcHandler, senderKey, unitNetwork.GetDenom()).BigInt()
+				return txArgs
+			},
+		},
+		{
+			name:           "success: the fees are paid in the accepted fee denom",
+			expectedDenom:  feeDenom,
+			feeDenoms:      []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom("uosmo", math.LegacyOneDec()), feemarkettypes.NewFeeDenom(feeDenom, math.LegacyOneDec())},
+			feeDenomAmount: 1e18,
+			generateArgs: func() evmtypes.EvmTxArgs {
+				txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+				suite.Require().NoError(err)
+				txArgs.Amount = suite.getBalance(grpcHandler, senderKey, unitNetwork.GetDenom()).BigInt()
+				return txArgs
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), tc.name), func() {
+			ctx := unitNetwork.GetContext()
+
+			params := unitNetwork.App.FeeMarketKeeper.GetParams(ctx)
+			params.FeeDenoms = tc.feeDenoms
+			suite.Require().NoError(unitNetwork.App.FeeMarketKeeper.SetParams(ctx, params))
+
+			if tc.feeDenomAmount > 0 {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, tc.feeDenomAmount))
+				suite.Require().NoError(testutil.FundAccount(ctx, unitNetwork.App.BankKeeper, senderKey.AccAddr, coins))
+			}
+
+			txArgs := tc.generateArgs()
+			txData, err := txArgs.ToTxData()
+			suite.Require().NoError(err)
+
+			//  Function to be tested
+			denom, err := evm.SelectFeeDenom(
+				ctx,
+				unitNetwork.App.AccountKeeper,
+				unitNetwork.App.BankKeeper,
+				unitNetwork.App.FeeMarketKeeper,
+				getDefaultStateDBAccount(unitNetwork, senderKey.Addr),
+				senderKey.Addr,
+				txData,
+				unitNetwork.GetDenom(),
+			)
+
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expectedDenom, denom)
+			}
+
+			// Clean block for next test
+			err = unitNetwork.NextBlock()
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *EvmAnteTestSuite) TestSelectFeeDenomWithGasPriceMultiplier() {
+	feeDenom := "uusdc"
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name          string
+		multiplier    math.LegacyDec
+		unpaidFees    math.LegacyDec
+		feeDenomFees  math.LegacyDec
+		expectedError error
+		expectedDenom string
+	}{
+		{
+			name:          "fail: the EVM denom balance doesn't cover the surcharged fees",
+			multiplier:    math.LegacyNewDec(2),
+			unpaidFees:    math.LegacyOneDec(),
+			expectedError: errortypes.ErrInsufficientFunds,
+		},
+		{
+			name:          "fail: the accepted fee denom balance doesn't cover the surcharged fees",
+			multiplier:    math.LegacyNewDec(2),
+			unpaidFees:    math.LegacyOneDec(),
+			feeDenomFees:  math.LegacyOneDec(),
+			expectedError: errortypes.ErrInsufficientFunds,
+		},
+		{
+			name:          "success: the surcharged fees are paid in the accepted fee denom",
+			multiplier:    math.LegacyNewDec(2),
+			unpaidFees:    math.LegacyOneDec(),
+			feeDenomFees:  math.LegacyNewDec(2),
+			expectedDenom: feeDenom,
+		},
+		{
+			name:          "success: the EVM denom balance covers the discounted fees",
+			multiplier:    math.LegacyNewDecWithPrec(5, 1),
+			unpaidFees:    math.LegacyNewDecWithPrec(5, 1),
+			expectedDenom: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), tc.name), func() {
+			keyring := testkeyring.New(1)
+			unitNetwork := network.NewUnitTestNetwork(
+				network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+			)
+			grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+			txFactory := factory.New(unitNetwork, grpcHandler)
+			senderKey := keyring.GetKey(0)
+			ctx := unitNetwork.GetContext()
+
+			// the fee denom is converted at half the EVM denom amount
+			params := unitNetwork.App.FeeMarketKeeper.GetParams(ctx)
+			params.FeeDenoms = []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom(feeDenom, math.LegacyNewDecWithPrec(5, 1))}
+			params.ContractGasPriceMultipliers = []feemarkettypes.ContractGasPriceMultiplier{
+				feemarkettypes.NewContractGasPriceMultiplier(contract, tc.multiplier),
+			}
+			suite.Require().NoError(unitNetwork.App.FeeMarketKeeper.SetParams(ctx, params))
+
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+			suite.Require().NoError(err)
+			txArgs.To = &contract
+			txData, err := txArgs.ToTxData()
+			suite.Require().NoError(err)
+			fee := math.NewIntFromBigInt(txData.Fee())
+
+			// the EVM denom balance left after the transferred value only covers
+			// the given portion of the unmultiplied fees
+			balance := suite.getBalance(grpcHandler, senderKey, unitNetwork.GetDenom())
+			txArgs.Amount = balance.Sub(tc.unpaidFees.MulInt(fee).TruncateInt()).BigInt()
+			txData, err = txArgs.ToTxData()
+			suite.Require().NoError(err)
+
+			if !tc.feeDenomFees.IsNil() {
+				amount := tc.feeDenomFees.MulInt(fee).QuoInt64(2).Ceil().TruncateInt()
+				coins := sdk.NewCoins(sdk.NewCoin(feeDenom, amount))
+				suite.Require().NoError(testutil.FundAccount(ctx, unitNetwork.App.BankKeeper, senderKey.AccAddr, coins))
+			}
+
+			//  Function to be tested
+			denom, err := evm.SelectFeeDenom(
+				ctx,
+				unitNetwork.App.AccountKeeper,
+				unitNetwork.App.BankKeeper,
+				unitNetwork.App.FeeMarketKeeper,
+				getDefaultStateDBAccount(unitNetwork, senderKey.Addr),
+				senderKey.Addr,
+				txData,
+				unitNetwork.GetDenom(),
+			)
+
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+
+			suite.Require().NoError(err)
+			expectedDenom := tc.expectedDenom
+			if expectedDenom == "" {
+				expectedDenom = unitNetwork.GetDenom()
+			}
+			suite.Require().Equal(expectedDenom, denom)
+		})
+	}
+}
+
+func (suite *EvmAnteTestSuite) getBalance(grpcHandler grpc.Handler, key testkeyring.Key, denom string) math.Int {
+	balanceResp, err := grpcHandler.GetBalance(key.AccAddr, denom)
+	suite.Require().NoError(err)
+	return balanceResp.Balance.Amount
+}
+
 func getDefaultStateDBAccount(unitNetwork *network.UnitTestNetwork, addr common.Address) *statedb.Account {
 	statedb := unitNetwork.GetStateDB()
 	return statedb.Keeper().GetAccount(unitNetwork.GetContext(), addr)
@@ -48,12 +48,14 @@ func ConsumeFeesAndEmitEvent(
 	keepers *ConsumeGasKeepers,
 	fees sdktypes.Coins,
 	from sdktypes.AccAddress,
+	to *common.Address,
 ) error {
 	if err := deductFees(
 		ctx,
 		keepers,
 		fees,
 		from,
+		to,
 	); err != nil {
 		return err
 	}
@@ -69,32 +71,39 @@ func ConsumeFeesAndEmitEvent(
 
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
 // If the spendable balance is not enough, it tries to claim enough staking rewards to cover the fees.
+// The fees deducted are multiplied by the gas price multiplier of the called contract, if any.
 func deductFees(
 	ctx sdktypes.Context,
 	keepers *ConsumeGasKeepers,
 	fees sdktypes.Coins,
 	feePayer sdktypes.AccAddress,
+	to *common.Address,
 ) error {
 	if fees.IsZero() {
 		return nil
 	}
 
-	// If the account balance is not sufficient, try to withdraw enough staking rewards
-	if err := anteutils.ClaimStakingRewardsIfNecessary(
-		ctx,
-		keepers.Bank,
-		keepers.Distribution,
-		keepers.Staking,
-		feePayer,
-		fees,
-	); err != nil {
-		return err
+	// If the account balance is not sufficient, try to withdraw enough staking rewards.
+	// NOTE: the fees paid in an accepted fee denom other than the EVM denom cannot be
+	// covered by staking rewards.
+	if fees.AmountOf(keepers.Evm.GetParams(ctx).EvmDenom).IsPositive() {
+		if err := anteutils.ClaimStakingRewardsIfNecessary(
+			ctx,
+			keepers.Bank,
+			keepers.Distribution,
+			keepers.Staking,
+			feePayer,
+			fees,
+		); err != nil {
+			return err
+		}
 	}
 
 	if err := keepers.Evm.DeductTxCostsFromUserBalance(
 		ctx,
 		fees,
 		common.BytesToAddress(feePayer),
+		to,
 	); err != nil {
 		return errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 	}
@@ -146,6 +155,24 @@ func CheckBlockGasLimit(ctx sdktypes.Context, gasWanted uint64, minPriority int6
 	return ctx, nil
 }
 
+// CheckMaxEVMBlockGas checks that the gas limit of the Ethereum transaction fits in the gas left to the EVM
+// transactions of the block, which is bounded by the max EVM block gas of the fee market independently from the
+// consensus max block gas. During DeliverTx, the gas used by the previous EVM transactions of the block is taken
+// into account, so that the EVM transactions never use more than the max EVM block gas.
+func CheckMaxEVMBlockGas(txGasLimit, blockGasUsed, maxEVMBlockGas uint64) error {
+	if blockGasUsed > maxEVMBlockGas || txGasLimit > maxEVMBlockGas-blockGasUsed {
+		return errorsmod.Wrapf(
+			errortypes.ErrOutOfGas,
+			"tx gas (%d) exceeds the EVM block gas left (%d used out of %d)",
+			txGasLimit,
+			blockGasUsed,
+			maxEVMBlockGas,
+		)
+	}
+
+	return nil
+}
+
 // UpdateCumulativeTxFee updates the cumulative transaction fee
 func UpdateCumulativeTxFee(
 	cumulativeTxFee sdktypes.Coins,
@@ -150,6 +150,7 @@ func (suite *EvmAnteTestSuite) TestConsumeGasAndEmitEvent() {
 				keepers,
 				tc.fees,
 				sender,
+				nil,
 			)
 
 			if tc.expectedError != nil {
@@ -192,3 +193,47 @@ func (suite *EvmAnteTestSuite) TestConsumeGasAndEmitEvent() {
 		})
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestCheckMaxEVMBlockGas() {
+	testCases := []struct {
+		name           string
+		txGasLimit     uint64
+		blockGasUsed   uint64
+		maxEVMBlockGas uint64
+		expectedError  error
+	}{
+		{
+			name:           "success: tx gas fits in the EVM block gas left",
+			txGasLimit:     21_000,
+			blockGasUsed:   100_000,
+			maxEVMBlockGas: 121_000,
+			expectedError:  nil,
+		},
+		{
+			name:           "fail: tx gas exceeds the EVM block gas left",
+			txGasLimit:     21_001,
+			blockGasUsed:   100_000,
+			maxEVMBlockGas: 121_000,
+			expectedError:  sdkerrors.ErrOutOfGas,
+		},
+		{
+			name:           "fail: EVM block gas already used up",
+			txGasLimit:     21_000,
+			blockGasUsed:   121_000,
+			maxEVMBlockGas: 121_000,
+			expectedError:  sdkerrors.ErrOutOfGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := evmante.CheckMaxEVMBlockGas(tc.txGasLimit, tc.blockGasUsed, tc.maxEVMBlockGas)
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -3,12 +3,19 @@
 package evm_test
 
 import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/evmos/evmos/v16/app/ante/evm"
+	"github.com/evmos/evmos/v16/app/mempool"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
 
 func (suite *EvmAnteTestSuite) TestIncrementSequence() {
@@ -70,3 +77,110 @@ func (suite *EvmAnteTestSuite) TestIncrementSequence() {
 		})
 	}
 }
+
+func (suite *EvmAnteTestSuite) TestCheckTxReplacement() {
+	from := utiltx.GenerateAddress()
+	account := authtypes.NewBaseAccountWithAddress(from.Bytes())
+	suite.Require().NoError(account.SetSequence(1))
+
+	newMsg := func(nonce uint64, gasPrice int64) *evmtypes.MsgEthereumTx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			Nonce:     nonce,
+			GasLimit:  21000,
+			GasFeeCap: big.NewInt(gasPrice),
+			GasTipCap: big.NewInt(gasPrice),
+		})
+		msg.From = from.Hex()
+		return msg
+	}
+
+	testCases := []struct {
+		name          string
+		checkTx       bool
+		reCheckTx     bool
+		pendingNonce  uint64
+		msg           *evmtypes.MsgEthereumTx
+		expReplaced   bool
+		expectedError error
+	}{
+		{
+			name:         "not replaced: deliver tx",
+			checkTx:      false,
+			pendingNonce: 0,
+			msg:          newMsg(0, 200),
+		},
+		{
+			name:         "not replaced: nonce is not lower than the account sequence",
+			checkTx:      true,
+			pendingNonce: 1,
+			msg:          newMsg(1, 200),
+		},
+		{
+			name:         "not replaced: no pending tx with the same nonce",
+			checkTx:      true,
+			pendingNonce: 2,
+			msg:          newMsg(0, 200),
+		},
+		{
+			name:         "not replaced: pending tx on recheck tx",
+			checkTx:      true,
+			reCheckTx:    true,
+			pendingNonce: 0,
+			msg:          newMsg(0, 100),
+		},
+		{
+			name:          "fail: replaced tx on recheck tx",
+			checkTx:       true,
+			reCheckTx:     true,
+			pendingNonce:  0,
+			msg:           newMsg(0, 200),
+			expectedError: mempool.ErrTxReplaced,
+		},
+		{
+			name:          "fail: evicted tx on recheck tx",
+			checkTx:       true,
+			reCheckTx:     true,
+			pendingNonce:  1,
+			msg:           newMsg(0, 100),
+			expectedError: mempool.ErrTxEvicted,
+		},
+		{
+			name:          "fail: replacement underpriced",
+			checkTx:       true,
+			pendingNonce:  0,
+			msg:           newMsg(0, 105),
+			expectedError: mempool.ErrReplacementUnderpriced,
+		},
+		{
+			name:         "success: replaces the pending tx",
+			checkTx:      true,
+			pendingNonce: 0,
+			msg:          newMsg(0, 200),
+			expReplaced:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := sdk.Context{}.WithContext(context.Background()).WithIsCheckTx(tc.checkTx).WithIsReCheckTx(tc.reCheckTx)
+
+			mp := mempool.NewMempool(0, 10)
+			pendingMsg := newMsg(tc.pendingNonce, 100)
+			suite.Require().NoError(mp.Insert(ctx, pendingMsg))
+
+			// Function under test
+			replacedMsg, err := evm.CheckTxReplacement(ctx, mp, tc.msg, account, tc.msg.AsTransaction().Nonce())
+
+			if tc.expReplaced {
+				suite.Require().Equal(pendingMsg, replacedMsg)
+			} else {
+				suite.Require().Nil(replacedMsg)
+			}
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -4,13 +4,18 @@
 package evm
 
 import (
+	"math/big"
+
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // IncrementNonce increments the sequence of the account.
@@ -39,3 +44,94 @@ func IncrementNonce(
 	accountKeeper.SetAccount(ctx, account)
 	return nil
 }
+
+// CheckTxReplacement checks if the transaction replaces a pending transaction
+// of the app-side mempool with the same sender and nonce, in which case the
+// account sequence must not be incremented again, and returns the replaced
+// message. Replacements are only allowed on CheckTx, where the sequence has
+// already been incremented by the pending transaction.
+//
+// NOTE: the replaced transaction is dropped from the CometBFT mempool when the
+// new one is inserted in the app-side mempool. Otherwise, it is rejected on
+// ReCheckTx, together with the transactions evicted from the app-side mempool.
+func CheckTxReplacement(
+	ctx sdk.Context,
+	txReplacer TxReplacer,
+	msg *evmtypes.MsgEthereumTx,
+	account authtypes.AccountI,
+	txNonce uint64,
+) (*evmtypes.MsgEthereumTx, error) {
+	if txReplacer == nil || !ctx.IsCheckTx() {
+		return nil, nil
+	}
+
+	if ctx.IsReCheckTx() {
+		return nil, txReplacer.CheckPending(msg)
+	}
+
+	if txNonce >= account.GetSequence() {
+		return nil, nil
+	}
+
+	replacedTx, err := txReplacer.CheckReplacement(msg)
+	if err != nil || replacedTx == nil {
+		return nil, err
+	}
+
+	msgs := replacedTx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid replaced transaction messages length %d", len(msgs))
+	}
+
+	replacedMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid replaced message type %T", msgs[0])
+	}
+
+	return replacedMsg, nil
+}
+
+// RefundReplacedTxFees refunds to the sender the fees charged on CheckTx for the
+// pending transaction replaced by a new one with the same nonce, so that the
+// sender only needs to afford the fees of the new transaction. The fees are
+// computed from the replaced transaction the same way they were deducted: the
+// effective fee at the block base fee, converted to the accepted fee denom used
+// to pay them and multiplied by the gas price multiplier of the called contract.
+func RefundReplacedTxFees(
+	ctx sdk.Context,
+	bankKeeper evmtypes.BankKeeper,
+	evmKeeper EVMKeeper,
+	feeMarketKeeper FeeMarketKeeper,
+	replacedMsg *evmtypes.MsgEthereumTx,
+	evmDenom string,
+	baseFee *big.Int,
+) error {
+	txData, err := evmtypes.UnpackTxData(replacedMsg.Data)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unpack the replaced tx data")
+	}
+
+	feeAmt := txData.EffectiveFee(baseFee)
+	if feeAmt.Sign() == 0 {
+		return nil
+	}
+
+	from := common.BytesToAddress(replacedMsg.GetFrom())
+	fees := sdk.Coins{{Denom: evmDenom, Amount: sdkmath.NewIntFromBigInt(feeAmt)}}
+
+	if feeDenom := evmKeeper.GetTxFeeDenomTransient(ctx, from, txData.GetNonce()); feeDenom != "" {
+		feeCoin, err := feeMarketKeeper.ConvertToFeeDenom(ctx, fees[0].Amount, feeDenom)
+		if err != nil {
+			return err
+		}
+		fees = sdk.Coins{feeCoin}
+	}
+
+	fees = feemarkettypes.ApplyGasPriceMultiplier(fees, feeMarketKeeper.GetGasPriceMultiplier(ctx, txData.GetTo()))
+
+	if err := bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, from.Bytes(), fees); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund the fees %s of the replaced transaction", fees)
+	}
+
+	return nil
+}
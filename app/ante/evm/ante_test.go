@@ -17,8 +17,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/core/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v16/app/mempool"
+	"github.com/evmos/evmos/v16/testutil"
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 func (suite *AnteTestSuite) TestAnteHandler() {
@@ -938,8 +941,17 @@ func (suite *AnteTestSuite) TestAnteHandler() {
 
 			suite.ctx = suite.ctx.WithIsCheckTx(tc.checkTx).WithIsReCheckTx(tc.reCheckTx)
 
+			// the transactions rechecked are pending in the mempool, where they are
+			// inserted once their sender is verified on CheckTx
+			tx := tc.txFn()
+			if tc.reCheckTx {
+				pendingMsg := *tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+				pendingMsg.From = addr.Hex()
+				suite.Require().NoError(suite.mempool.Insert(suite.ctx, &pendingMsg))
+			}
+
 			// expConsumed := params.TxGasContractCreation + params.TxGas
-			_, err := suite.anteHandler(suite.ctx, tc.txFn(), false)
+			_, err := suite.anteHandler(suite.ctx, tx, false)
 
 			// suite.Require().Equal(consumed, ctx.GasMeter().GasConsumed())
 
@@ -1125,7 +1137,16 @@ func (suite *AnteTestSuite) TestAnteHandlerWithDynamicTxFee() {
 			err := suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt((ethparams.InitialBaseFee+10)*100000))
 			suite.Require().NoError(err)
 
-			_, err = suite.anteHandler(suite.ctx, tc.txFn(), false)
+			// the transactions rechecked are pending in the mempool, where they are
+			// inserted once their sender is verified on CheckTx
+			tx := tc.txFn()
+			if tc.reCheckTx {
+				pendingMsg := *tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+				pendingMsg.From = addr.Hex()
+				suite.Require().NoError(suite.mempool.Insert(suite.ctx, &pendingMsg))
+			}
+
+			_, err = suite.anteHandler(suite.ctx, tx, false)
 			if tc.expPass {
 				suite.Require().NoError(err)
 			} else {
@@ -1248,3 +1269,132 @@ func (suite *AnteTestSuite) TestAnteHandlerWithParams() {
 	}
 	suite.evmParamsOption = nil
 }
+
+func (suite *AnteTestSuite) TestAnteHandlerTxReplacement() {
+	suite.SetupTest() // reset
+
+	addr, privKey := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+
+	newTx := func(gasFeeCap, gasTipCap int64) sdk.Tx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:   suite.app.EvmKeeper.ChainID(),
+			Nonce:     0,
+			To:        &to,
+			GasLimit:  TestGasLimit,
+			GasFeeCap: big.NewInt(gasFeeCap),
+			GasTipCap: big.NewInt(gasTipCap),
+			Accesses:  &types.AccessList{},
+		})
+		msg.From = addr.Hex()
+		return suite.CreateTestTx(msg, privKey, 1, false)
+	}
+
+	oldTx := newTx(2*ethparams.InitialBaseFee, 100)
+	replacementTx := newTx(4*ethparams.InitialBaseFee, 200)
+
+	ctx := suite.ctx.WithIsCheckTx(true)
+	baseFee := suite.app.FeeMarketKeeper.GetBaseFee(ctx)
+	effectiveFee := func(gasFeeCap, gasTipCap int64) *big.Int {
+		gasPrice := new(big.Int).Add(baseFee, big.NewInt(gasTipCap))
+		if gasPrice.Cmp(big.NewInt(gasFeeCap)) > 0 {
+			gasPrice = big.NewInt(gasFeeCap)
+		}
+		return gasPrice.Mul(gasPrice, new(big.Int).SetUint64(TestGasLimit))
+	}
+
+	// the sender can afford the replacement, but not both transactions
+	balance := big.NewInt(5 * ethparams.InitialBaseFee * int64(TestGasLimit))
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, addr, balance))
+
+	newCtx, err := suite.anteHandler(ctx, oldTx, false)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.mempool.Insert(newCtx, oldTx))
+	suite.Require().Equal(
+		new(big.Int).Sub(balance, effectiveFee(2*ethparams.InitialBaseFee, 100)),
+		suite.app.EvmKeeper.GetBalance(ctx, addr),
+	)
+
+	// the fees of the replaced transaction, computed from the tx, are refunded and
+	// the sequence is not incremented again
+	newCtx, err = suite.anteHandler(ctx, replacementTx, false)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.mempool.Insert(newCtx, replacementTx))
+	suite.Require().Equal(
+		new(big.Int).Sub(balance, effectiveFee(4*ethparams.InitialBaseFee, 200)),
+		suite.app.EvmKeeper.GetBalance(ctx, addr),
+	)
+	suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetAccount(ctx, addr.Bytes()).GetSequence())
+
+	// the replaced transaction is rejected on ReCheckTx, so that it's dropped from the
+	// CometBFT mempool, while the replacement is kept
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(oldTx)
+	suite.Require().NoError(err)
+	recheckTx, err := suite.clientCtx.TxConfig.TxDecoder()(txBytes)
+	suite.Require().NoError(err)
+
+	_, err = suite.anteHandler(ctx.WithIsReCheckTx(true), recheckTx, false)
+	suite.Require().ErrorIs(err, mempool.ErrTxReplaced)
+	suite.Require().NoError(suite.mempool.CheckPending(replacementTx))
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerTxReplacementInFeeDenom() {
+	suite.SetupTest() // reset
+
+	feeDenom := "uusdc"
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.FeeDenoms = []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom(feeDenom, sdkmath.LegacyNewDecWithPrec(5, 1))}
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	addr, privKey := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+
+	newTx := func(gasPrice int64) sdk.Tx {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:   suite.app.EvmKeeper.ChainID(),
+			Nonce:     0,
+			To:        &to,
+			GasLimit:  TestGasLimit,
+			GasFeeCap: big.NewInt(gasPrice),
+			GasTipCap: big.NewInt(gasPrice),
+			Accesses:  &types.AccessList{},
+		})
+		msg.From = addr.Hex()
+		return suite.CreateTestTx(msg, privKey, 1, false)
+	}
+
+	oldTx := newTx(2 * ethparams.InitialBaseFee)
+	replacementTx := newTx(4 * ethparams.InitialBaseFee)
+
+	// the fees are paid in the accepted fee denom at half the EVM denom amount
+	feesInDenom := func(gasPrice int64) sdkmath.Int {
+		return sdkmath.NewInt(gasPrice * int64(TestGasLimit) / 2)
+	}
+
+	// the sender has no EVM denom balance and can afford the replacement, but
+	// not both transactions
+	balance := feesInDenom(5 * ethparams.InitialBaseFee)
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr.Bytes(), sdk.NewCoins(sdk.NewCoin(feeDenom, balance))))
+
+	ctx := suite.ctx.WithIsCheckTx(true)
+	newCtx, err := suite.anteHandler(ctx, oldTx, false)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.mempool.Insert(newCtx, oldTx))
+	suite.Require().Equal(feeDenom, suite.app.EvmKeeper.GetTxFeeDenomTransient(ctx, addr, 0))
+
+	// the fees of the replaced transaction are refunded in the fee denom they
+	// were paid in
+	newCtx, err = suite.anteHandler(ctx, replacementTx, false)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.mempool.Insert(newCtx, replacementTx))
+	suite.Require().Equal(
+		balance.Sub(feesInDenom(4*ethparams.InitialBaseFee)),
+		suite.app.BankKeeper.GetBalance(ctx, addr.Bytes(), feeDenom).Amount,
+	)
+	suite.Require().Equal(feeDenom, suite.app.EvmKeeper.GetTxFeeDenomTransient(ctx, addr, 0))
+	suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetAccount(ctx, addr.Bytes()).GetSequence())
+}
@@ -84,6 +84,7 @@ func BenchmarkEthGasConsumeDecorator(b *testing.B) {
 					&keepers,
 					fees,
 					bechAddr,
+					nil,
 				)
 				s.Require().NoError(err)
 			}
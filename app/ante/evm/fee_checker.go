@@ -15,6 +15,7 @@ import (
 	anteutils "github.com/evmos/evmos/v16/app/ante/utils"
 	evmostypes "github.com/evmos/evmos/v16/types"
 	"github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // NewDynamicFeeChecker returns a `TxFeeChecker` that applies a dynamic fee to
@@ -25,7 +26,9 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
-func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) anteutils.TxFeeChecker {
+// - the fees can be paid in a single accepted fee denom, in which case they are converted
+// to the EVM denom using the fee market conversion rate.
+func NewDynamicFeeChecker(k DynamicFeeEVMKeeper, fmk FeeDenomConverter) anteutils.TxFeeChecker {
 	return func(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coins, int64, error) {
 		// TODO: in the e2e test, if the fee in the genesis transaction meet the baseFee and minGasPrice in the feemarket, we can remove this code
 		if ctx.BlockHeight() == 0 {
@@ -36,7 +39,7 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) anteutils.TxFeeChecker {
 		denom := params.EvmDenom
 		ethCfg := params.ChainConfig.EthereumConfig(k.ChainID())
 
-		return FeeChecker(ctx, k, denom, ethCfg, feeTx)
+		return FeeChecker(ctx, k, fmk, denom, ethCfg, feeTx)
 	}
 }
 
@@ -44,6 +47,7 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) anteutils.TxFeeChecker {
 func FeeChecker(
 	ctx sdk.Context,
 	k DynamicFeeEVMKeeper,
+	fmk FeeDenomConverter,
 	denom string,
 	ethConfig *params.ChainConfig,
 	feeTx sdk.FeeTx,
@@ -76,6 +80,19 @@ func FeeChecker(
 	feeCoins := feeTx.GetFee()
 	fee := feeCoins.AmountOfNoDenomValidation(denom)
 
+	// the fees paid in a single accepted fee denom are converted to the EVM denom
+	feeDenom := denom
+	if len(feeCoins) == 1 && feeCoins[0].Denom != denom {
+		convertedFee, err := fmk.ConvertFromFeeDenom(ctx, feeCoins[0])
+		switch {
+		case err == nil:
+			fee = convertedFee
+			feeDenom = feeCoins[0].Denom
+		case !errorsmod.IsOf(err, feemarkettypes.ErrInvalidFeeDenom):
+			return nil, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "failed to convert fees %s: %s", feeCoins, err)
+		}
+	}
+
 	feeCap := fee.Quo(sdkmath.NewIntFromUint64(gas))
 	baseFeeInt := sdkmath.NewIntFromBigInt(baseFee)
 
@@ -94,6 +111,20 @@ func FeeChecker(
 		},
 	}
 
+	// charge the effective fee in the accepted fee denom, capped to the provided fees
+	if feeDenom != denom {
+		feeCoin, err := fmk.ConvertToFeeDenom(ctx, effectiveFee[0].Amount, feeDenom)
+		if err != nil {
+			return nil, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "failed to convert fees to %s: %s", feeDenom, err)
+		}
+		effectiveFee = sdk.Coins{
+			{
+				Denom:  feeDenom,
+				Amount: sdkmath.MinInt(feeCoin.Amount, feeCoins[0].Amount),
+			},
+		}
+	}
+
 	bigPriority := effectivePrice.Sub(baseFeeInt).Quo(types.DefaultPriorityReduction)
 	priority := int64(math.MaxInt64)
 
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/evmos/evmos/v16/encoding"
 	"github.com/evmos/evmos/v16/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 var _ DynamicFeeEVMKeeper = MockEVMKeeper{}
@@ -41,6 +43,41 @@ func (m MockEVMKeeper) ChainID() *big.Int {
 	return big.NewInt(5438)
 }
 
+var _ FeeDenomConverter = MockFeeDenomConverter{}
+
+// MockFeeDenomConverter converts the fees using a fixed conversion rate for each
+// accepted fee denom.
+type MockFeeDenomConverter struct {
+	Rates map[string]math.LegacyDec
+}
+
+func (m MockFeeDenomConverter) getRate(denom string) (math.LegacyDec, error) {
+	rate, ok := m.Rates[denom]
+	if !ok {
+		return math.LegacyDec{}, errorsmod.Wrapf(feemarkettypes.ErrInvalidFeeDenom, "denom %s", denom)
+	}
+	if !rate.IsPositive() {
+		return math.LegacyDec{}, feemarkettypes.ErrInvalidConversionRate
+	}
+	return rate, nil
+}
+
+func (m MockFeeDenomConverter) ConvertToFeeDenom(_ sdk.Context, amount math.Int, denom string) (sdk.Coin, error) {
+	rate, err := m.getRate(denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.Coin{Denom: denom, Amount: math.LegacyNewDecFromInt(amount).Mul(rate).Ceil().TruncateInt()}, nil
+}
+
+func (m MockFeeDenomConverter) ConvertFromFeeDenom(_ sdk.Context, coin sdk.Coin) (math.Int, error) {
+	rate, err := m.getRate(coin.Denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	return math.LegacyNewDecFromInt(coin.Amount).Quo(rate).TruncateInt(), nil
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -57,6 +94,12 @@ func TestSDKTxFeeChecker(t *testing.T) {
 	genesisCtx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
 	checkTxCtx := sdk.NewContext(nil, tmproto.Header{Height: 1}, true, log.NewNopLogger()).WithMinGasPrices(minGasPrices)
 	deliverTxCtx := sdk.NewContext(nil, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+	feeDenomConverter := MockFeeDenomConverter{
+		Rates: map[string]math.LegacyDec{
+			"uusdc": math.LegacyNewDec(2),
+			"uatom": math.LegacyZeroDec(),
+		},
+	}
 
 	testCases := []struct {
 		name        string
@@ -203,6 +246,54 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			5,
 			true,
 		},
+		{
+			"success, dynamic fee in accepted fee denom",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(20))))
+				return txBuilder.GetTx()
+			},
+			"20uusdc",
+			0,
+			true,
+		},
+		{
+			"fail, dynamic fee in accepted fee denom lower than base fee",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(19))))
+				return txBuilder.GetTx()
+			},
+			"",
+			0,
+			false,
+		},
+		{
+			"fail, dynamic fee in accepted fee denom without conversion rate",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(20))))
+				return txBuilder.GetTx()
+			},
+			"",
+			0,
+			false,
+		},
 		{
 			"fail, negative dynamic fee tipFeeCap",
 			deliverTxCtx,
@@ -230,7 +321,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			fees, priority, err := NewDynamicFeeChecker(tc.keeper)(tc.ctx, tc.buildTx())
+			fees, priority, err := NewDynamicFeeChecker(tc.keeper, feeDenomConverter)(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
@@ -5,6 +5,7 @@ package evm
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/ethereum/go-ethereum/common"
@@ -23,10 +24,13 @@ type EVMKeeper interface { //nolint: revive
 	DynamicFeeEVMKeeper
 
 	NewEVM(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) *vm.EVM
-	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address, to *common.Address) error
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
+	GetBlockGasUsedTransient(ctx sdk.Context) uint64
+	GetTxFeeDenomTransient(ctx sdk.Context, from common.Address, nonce uint64) string
+	SetTxFeeDenomTransient(ctx sdk.Context, from common.Address, nonce uint64, denom string)
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
 
@@ -34,6 +38,24 @@ type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
+	GetGasPriceMultiplier(ctx sdk.Context, contract *common.Address) sdkmath.LegacyDec
+	GetMaxEVMBlockGas(ctx sdk.Context) uint64
+	FeeDenomConverter
+}
+
+// FeeDenomConverter defines the fee market keeper methods used to charge the
+// transaction fees in the accepted fee denoms other than the EVM denom.
+type FeeDenomConverter interface {
+	ConvertToFeeDenom(ctx sdk.Context, amount sdkmath.Int, denom string) (sdk.Coin, error)
+	ConvertFromFeeDenom(ctx sdk.Context, coin sdk.Coin) (sdkmath.Int, error)
+}
+
+// TxReplacer defines the app-side mempool methods used to replace a pending
+// transaction by a new one with the same sender and nonce, and to drop the
+// replaced and evicted transactions on ReCheckTx.
+type TxReplacer interface {
+	CheckReplacement(tx sdk.Tx) (sdk.Tx, error)
+	CheckPending(tx sdk.Tx) error
 }
 
 // DynamicFeeEVMKeeper is a subset of EVMKeeper interface that supports dynamic fee checker
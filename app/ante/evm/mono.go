@@ -30,6 +30,7 @@ type MonoDecorator struct {
 	evmKeeper          EVMKeeper
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	txReplacer         TxReplacer
 	maxGasWanted       uint64
 }
 
@@ -57,6 +58,7 @@ func NewMonoDecorator(
 	evmKeeper EVMKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
+	txReplacer TxReplacer,
 	maxGasWanted uint64,
 ) MonoDecorator {
 	return MonoDecorator{
@@ -66,6 +68,7 @@ func NewMonoDecorator(
 		evmKeeper:          evmKeeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
+		txReplacer:         txReplacer,
 		maxGasWanted:       maxGasWanted,
 	}
 }
@@ -139,10 +142,11 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		gas := txData.GetGas()
 		fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
 		gasLimit := sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
+		gasPriceMultiplier := md.feeMarketKeeper.GetGasPriceMultiplier(ctx, txData.GetTo())
 
 		// 2. mempool inclusion fee
 		if ctx.IsCheckTx() && !simulate {
-			if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
+			if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, gasPriceMultiplier, decUtils.Rules.IsLondon); err != nil {
 				return ctx, err
 			}
 		}
@@ -153,7 +157,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			fee = sdkmath.LegacyNewDecFromBigInt(feeAmt)
 		}
 
-		if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice, gasLimit); err != nil {
+		if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice, gasLimit, gasPriceMultiplier); err != nil {
 			return ctx, err
 		}
 
@@ -196,18 +200,51 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 
 		// NOTE: sender address has been verified and cached
 		from = ethMsg.GetFrom()
+		fromAddr := common.HexToAddress(ethMsg.From)
+
+		acc := md.accountKeeper.GetAccount(ctx, from)
+		if acc == nil {
+			// safety check: shouldn't happen
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownAddress,
+				"account %s does not exist", acc)
+		}
+
+		// check if the tx replaces a pending one with the same nonce, in which case
+		// the fees charged for the pending tx are refunded before checking the balance
+		replacedMsg, err := CheckTxReplacement(ctx, md.txReplacer, ethMsg, acc, txData.GetNonce())
+		if err != nil {
+			return ctx, err
+		}
+
+		replaced := replacedMsg != nil
+		if replaced {
+			if err := RefundReplacedTxFees(
+				ctx,
+				md.bankKeeper,
+				md.evmKeeper,
+				md.feeMarketKeeper,
+				replacedMsg,
+				decUtils.EvmDenom,
+				decUtils.BaseFee,
+			); err != nil {
+				return ctx, err
+			}
+		}
 
 		// 6. account balance verification
-		fromAddr := common.HexToAddress(ethMsg.From)
 		// TODO: Use account from AccountKeeper instead
 		account := md.evmKeeper.GetAccount(ctx, fromAddr)
-		if err := VerifyAccountBalance(
+		feeDenom, err := SelectFeeDenom(
 			ctx,
 			md.accountKeeper,
+			md.bankKeeper,
+			md.feeMarketKeeper,
 			account,
 			fromAddr,
 			txData,
-		); err != nil {
+			decUtils.EvmDenom,
+		)
+		if err != nil {
 			return ctx, err
 		}
 
@@ -234,13 +271,6 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 
 		// 8. vesting
 		value := txData.GetValue()
-		acc := md.accountKeeper.GetAccount(ctx, from)
-		if acc == nil {
-			// safety check: shouldn't happen
-			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownAddress,
-				"account %s does not exist", acc)
-		}
-
 		if err := CheckVesting(
 			ctx,
 			md.bankKeeper,
@@ -265,6 +295,17 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		// charge the fees in the selected accepted fee denom
+		paidDenom := ""
+		if feeDenom != decUtils.EvmDenom && !msgFees.IsZero() {
+			feeCoin, err := md.feeMarketKeeper.ConvertToFeeDenom(ctx, msgFees.AmountOf(decUtils.EvmDenom), feeDenom)
+			if err != nil {
+				return ctx, err
+			}
+			msgFees = sdk.Coins{feeCoin}
+			paidDenom = feeDenom
+		}
+
 		err = ConsumeFeesAndEmitEvent(
 			ctx,
 			&ConsumeGasKeepers{
@@ -275,11 +316,19 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			},
 			msgFees,
 			from,
+			txData.GetTo(),
 		)
 		if err != nil {
 			return ctx, err
 		}
 
+		// keep track of the accepted fee denom used to pay the fees, so that the
+		// leftover gas is refunded in the same denom, and so are the fees if the tx
+		// is replaced. The denom of a replaced tx is overwritten, even if empty.
+		if paidDenom != "" || replaced {
+			md.evmKeeper.SetTxFeeDenomTransient(ctx, fromAddr, txData.GetNonce(), paidDenom)
+		}
+
 		gasWanted := UpdateComulativeGasWanted(
 			ctx,
 			txData.GetGas(),
@@ -303,9 +352,11 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		decUtils.TxFee = txFee
 		decUtils.TxGasLimit += gas
 
-		// 10. increment sequence
-		if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
-			return ctx, err
+		// 10. increment sequence, unless the tx replaces a pending one with the same nonce
+		if !replaced {
+			if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
+				return ctx, err
+			}
 		}
 
 		// 11. gas wanted
@@ -322,6 +373,14 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	if err := CheckMaxEVMBlockGas(
+		decUtils.TxGasLimit,
+		md.evmKeeper.GetBlockGasUsedTransient(ctx),
+		md.feeMarketKeeper.GetMaxEVMBlockGas(ctx),
+	); err != nil {
+		return ctx, err
+	}
+
 	ctx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, decUtils.MinPriority)
 	if err != nil {
 		return ctx, err
@@ -18,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v16/app"
 	ante "github.com/evmos/evmos/v16/app/ante"
+	"github.com/evmos/evmos/v16/app/mempool"
 	"github.com/evmos/evmos/v16/encoding"
 	"github.com/evmos/evmos/v16/ethereum/eip712"
 	"github.com/evmos/evmos/v16/utils"
@@ -32,6 +33,7 @@ type AnteTestSuite struct {
 	app                      *app.Evmos
 	clientCtx                client.Context
 	anteHandler              sdk.AnteHandler
+	mempool                  *mempool.Mempool
 	ethSigner                types.Signer
 	enableFeemarket          bool
 	enableLondonHF           bool
@@ -96,6 +98,8 @@ func (suite *AnteTestSuite) SetupTest() {
 
 	suite.Require().NotNil(suite.app.AppCodec())
 
+	suite.mempool = mempool.NewMempool(0, 10)
+
 	anteHandler := ante.NewAnteHandler(ante.HandlerOptions{
 		Cdc:                suite.app.AppCodec(),
 		AccountKeeper:      suite.app.AccountKeeper,
@@ -108,6 +112,7 @@ func (suite *AnteTestSuite) SetupTest() {
 		FeeMarketKeeper:    suite.app.FeeMarketKeeper,
 		SignModeHandler:    encodingConfig.TxConfig.SignModeHandler(),
 		SigGasConsumer:     ante.SigVerificationGasConsumer,
+		TxReplacer:         suite.mempool,
 	})
 
 	suite.anteHandler = anteHandler
@@ -36,6 +36,9 @@ type HandlerOptions struct {
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
 	TxFeeChecker           anteutils.TxFeeChecker
+	// TxReplacer is the optional app-side mempool used to replace pending
+	// Ethereum transactions with the same sender and nonce
+	TxReplacer evmante.TxReplacer
 }
 
 // Validate checks if the keepers are defined
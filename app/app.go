@@ -45,7 +45,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/mempool"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -123,12 +123,14 @@ import (
 
 	"github.com/evmos/evmos/v16/app/ante"
 	ethante "github.com/evmos/evmos/v16/app/ante/evm"
+	evmosmempool "github.com/evmos/evmos/v16/app/mempool"
 	"github.com/evmos/evmos/v16/app/post"
 	v16 "github.com/evmos/evmos/v16/app/upgrades/v16"
 	v17 "github.com/evmos/evmos/v16/app/upgrades/v17"
 	"github.com/evmos/evmos/v16/encoding"
 	"github.com/evmos/evmos/v16/ethereum/eip712"
 	"github.com/evmos/evmos/v16/precompiles/common"
+	evmosconfig "github.com/evmos/evmos/v16/server/config"
 	srvflags "github.com/evmos/evmos/v16/server/flags"
 	evmostypes "github.com/evmos/evmos/v16/types"
 	"github.com/evmos/evmos/v16/x/epochs"
@@ -151,6 +153,14 @@ import (
 	revenue "github.com/evmos/evmos/v16/x/revenue/v1"
 	revenuekeeper "github.com/evmos/evmos/v16/x/revenue/v1/keeper"
 	revenuetypes "github.com/evmos/evmos/v16/x/revenue/v1/types"
+
+	"github.com/evmos/evmos/v16/x/outposts"
+	outpostskeeper "github.com/evmos/evmos/v16/x/outposts/keeper"
+	outpoststypes "github.com/evmos/evmos/v16/x/outposts/types"
+
+	"github.com/evmos/evmos/v16/x/randomness"
+	randomnesskeeper "github.com/evmos/evmos/v16/x/randomness/keeper"
+	randomnesstypes "github.com/evmos/evmos/v16/x/randomness/types"
 	"github.com/evmos/evmos/v16/x/vesting"
 	vestingclient "github.com/evmos/evmos/v16/x/vesting/client"
 	vestingkeeper "github.com/evmos/evmos/v16/x/vesting/keeper"
@@ -229,6 +239,8 @@ var (
 		revenue.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 		incentives.AppModuleBasic{},
+		randomness.AppModuleBasic{},
+		outposts.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -243,6 +255,7 @@ var (
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
 		inflationtypes.ModuleName:      {authtypes.Minter},
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		randomnesstypes.ModuleName:     nil,
 	}
 )
 
@@ -265,6 +278,9 @@ type Evmos struct {
 
 	invCheckPeriod uint
 
+	// mempool is the app-side mempool, which is nil if disabled
+	mempool *evmosmempool.Mempool
+
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
 	tkeys   map[string]*storetypes.TransientStoreKey
@@ -303,6 +319,9 @@ type Evmos struct {
 	VestingKeeper   vestingkeeper.Keeper
 	RevenueKeeper   revenuekeeper.Keeper
 
+	RandomnessKeeper randomnesskeeper.Keeper
+	OutpostsKeeper   outpostskeeper.Keeper
+
 	// the module manager
 	mm *module.Manager
 
@@ -343,8 +362,27 @@ func NewEvmos(
 	baseAppOptions = memiavlstore.SetupMemIAVL(logger, homePath, appOpts, false, false, baseAppOptions)
 
 	// Setup Mempool and Proposal Handlers
+	// NOTE: the app-side mempool is disabled if the max number of transactions is negative.
+	// The default values are used when the options are not set (e.g. by the test apps).
+	maxTxs := evmosconfig.DefaultMempoolMaxTxs
+	if opt := appOpts.Get(srvflags.EVMMempoolMaxTxs); opt != nil {
+		maxTxs = cast.ToInt(opt)
+	}
+
+	var (
+		mempool      sdkmempool.Mempool = sdkmempool.NoOpMempool{}
+		evmosMempool *evmosmempool.Mempool
+	)
+	if maxTxs >= 0 {
+		priceBump := evmosconfig.DefaultMempoolPriceBump
+		if opt := appOpts.Get(srvflags.EVMMempoolPriceBump); opt != nil {
+			priceBump = cast.ToUint64(opt)
+		}
+		evmosMempool = evmosmempool.NewMempool(maxTxs, priceBump)
+		mempool = evmosMempool
+	}
+
 	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		mempool := mempool.NoOpMempool{}
 		app.SetMempool(mempool)
 		handler := baseapp.NewDefaultProposalHandler(mempool, app)
 		app.SetPrepareProposal(handler.PrepareProposalHandler())
@@ -374,6 +412,7 @@ func NewEvmos(
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		mempool:           evmosMempool,
 	}
 
 	// init params keeper and subspaces
@@ -430,6 +469,7 @@ func NewEvmos(
 		keys[feemarkettypes.StoreKey],
 		tkeys[feemarkettypes.TransientKey],
 		app.GetSubspace(feemarkettypes.ModuleName),
+		app.BankKeeper, app.DistrKeeper, authtypes.FeeCollectorName,
 	)
 
 	evmKeeper := evmkeeper.NewKeeper(
@@ -496,7 +536,7 @@ func NewEvmos(
 
 	app.RevenueKeeper = revenuekeeper.NewKeeper(
 		keys[revenuetypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.BankKeeper, app.DistrKeeper, app.AccountKeeper, app.EvmKeeper,
+		app.BankKeeper, app.DistrKeeper, app.AccountKeeper, app.EvmKeeper, app.FeeMarketKeeper,
 		authtypes.FeeCollectorName,
 	)
 
@@ -507,11 +547,21 @@ func NewEvmos(
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 		app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
 	)
+	app.RandomnessKeeper = randomnesskeeper.NewKeeper(
+		keys[randomnesstypes.StoreKey],
+		app.AccountKeeper, app.StakingKeeper, app.SlashingKeeper, app.EvmKeeper,
+	)
+
+	app.OutpostsKeeper = outpostskeeper.NewKeeper(
+		keys[outpoststypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+	)
+
 	chainID := bApp.ChainID()
 	// We call this after setting the hooks to ensure that the hooks are set on the keeper
 	evmKeeper.WithPrecompiles(
 		evmkeeper.AvailablePrecompiles(
 			chainID,
+			appCodec,
 			*stakingKeeper,
 			app.DistrKeeper,
 			app.BankKeeper,
@@ -519,7 +569,13 @@ func NewEvmos(
 			app.VestingKeeper,
 			app.AuthzKeeper,
 			app.TransferKeeper,
+			app.IBCKeeper.ClientKeeper,
+			app.IBCKeeper.ConnectionKeeper,
 			app.IBCKeeper.ChannelKeeper,
+			app.RevenueKeeper,
+			app.FeeGrantKeeper,
+			app.RandomnessKeeper,
+			app.OutpostsKeeper,
 		),
 	)
 
@@ -567,6 +623,7 @@ func NewEvmos(
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
+			- IBC Callbacks Middleware
 			- ERC-20 Middleware
 		 	- Recovery Middleware
 		 	- Airdrop Claims Middleware
@@ -577,6 +634,9 @@ func NewEvmos(
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
 			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+
+		AcknowledgePacket and TimeoutPacket follow the same flow as RecvPacket, so the source
+		contract callbacks are executed once the ERC-20 middleware has refunded the tokens
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -584,6 +644,7 @@ func NewEvmos(
 
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = erc20.NewIBCCallbacksMiddleware(app.Erc20Keeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -630,7 +691,7 @@ func NewEvmos(
 		transferModule,
 		// Ethermint app modules
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmtypes.ModuleName)),
-		feemarket.NewAppModule(app.FeeMarketKeeper, app.GetSubspace(feemarkettypes.ModuleName)),
+		feemarket.NewAppModule(app.FeeMarketKeeper, app.GetSubspace(feemarkettypes.ModuleName), app.RevenueKeeper),
 		// Evmos app modules
 		inflation.NewAppModule(app.InflationKeeper, app.AccountKeeper, app.StakingKeeper,
 			app.GetSubspace(inflationtypes.ModuleName)),
@@ -640,6 +701,8 @@ func NewEvmos(
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		revenue.NewAppModule(app.RevenueKeeper, app.AccountKeeper,
 			app.GetSubspace(revenuetypes.ModuleName)),
+		randomness.NewAppModule(app.RandomnessKeeper),
+		outposts.NewAppModule(app.OutpostsKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -660,6 +723,8 @@ func NewEvmos(
 		evidencetypes.ModuleName,
 		stakingtypes.ModuleName,
 		ibcexported.ModuleName,
+		// NOTE: randomness callbacks execute EVM calls, so it must run after the evm begin block
+		randomnesstypes.ModuleName,
 		// no-op modules
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
@@ -674,6 +739,7 @@ func NewEvmos(
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
 		revenuetypes.ModuleName,
+		outpoststypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -705,6 +771,8 @@ func NewEvmos(
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
 		revenuetypes.ModuleName,
+		randomnesstypes.ModuleName,
+		outpoststypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -742,10 +810,47 @@ func NewEvmos(
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
 		epochstypes.ModuleName,
+		// NOTE: revenue module needs to be initialized after feemarket module:
+		// the deprecated developer shares are converted to the feemarket EVM fee split
 		revenuetypes.ModuleName,
+		randomnesstypes.ModuleName,
+		outpoststypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
+	// NOTE: The module migrations run in the default alphabetical order, with the auth
+	// module last, as the migrations may depend on each other:
+	// the feemarket migration builds the EVM fee split from the revenue developer shares,
+	// so it must occur before the revenue migration, which zeroes them.
+	app.mm.SetOrderMigrations(
+		authz.ModuleName,
+		banktypes.ModuleName,
+		capabilitytypes.ModuleName,
+		consensusparamtypes.ModuleName,
+		distrtypes.ModuleName,
+		epochstypes.ModuleName,
+		erc20types.ModuleName,
+		evidencetypes.ModuleName,
+		evmtypes.ModuleName,
+		feegrant.ModuleName,
+		feemarkettypes.ModuleName,
+		genutiltypes.ModuleName,
+		govtypes.ModuleName,
+		ibcexported.ModuleName,
+		inflationtypes.ModuleName,
+		icatypes.ModuleName,
+		outpoststypes.ModuleName,
+		paramstypes.ModuleName,
+		randomnesstypes.ModuleName,
+		revenuetypes.ModuleName,
+		slashingtypes.ModuleName,
+		stakingtypes.ModuleName,
+		ibctransfertypes.ModuleName,
+		upgradetypes.ModuleName,
+		vestingtypes.ModuleName,
+		authtypes.ModuleName,
+	)
+
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
@@ -799,7 +904,11 @@ func NewEvmos(
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 
-	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
+	// the app-side mempool replaces the pending EVM transactions with the same
+	// sender and nonce, unless it's disabled
+	txReplacer, _ := mempool.(ethante.TxReplacer)
+
+	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted, txReplacer)
 	app.setPostHandler()
 	app.SetEndBlocker(app.EndBlocker)
 	app.setupUpgradeHandlers()
@@ -845,7 +954,16 @@ func NewEvmos(
 // Name returns the name of the App
 func (app *Evmos) Name() string { return app.BaseApp.Name() }
 
-func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+// SetCometMempool registers the CometBFT mempool of the node in the app-side
+// mempool, if enabled, so that the replaced and evicted transactions are
+// dropped from both.
+func (app *Evmos) SetCometMempool(cometMempool evmosmempool.CometMempool) {
+	if app.mempool != nil {
+		app.mempool.SetCometMempool(cometMempool)
+	}
+}
+
+func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, txReplacer ethante.TxReplacer) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -860,7 +978,8 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
-		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
+		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper, app.FeeMarketKeeper),
+		TxReplacer:             txReplacer,
 	}
 
 	if err := options.Validate(); err != nil {
@@ -874,6 +993,7 @@ func (app *Evmos) setPostHandler() {
 	options := post.HandlerOptions{
 		FeeCollectorName: authtypes.FeeCollectorName,
 		BankKeeper:       app.BankKeeper,
+		FeeMarketKeeper:  app.FeeMarketKeeper,
 	}
 
 	if err := options.Validate(); err != nil {
@@ -1179,7 +1299,10 @@ func (app *Evmos) setupUpgradeHandlers() {
 			Deleted: []string{"recoveryv1", "incentives", "claims"},
 		}
 	case v17.UpgradeName:
-		// no store upgrades
+		// randomness and outposts modules are added in v17
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{randomnesstypes.StoreKey, outpoststypes.StoreKey},
+		}
 	default:
 		// no-op
 	}
@@ -27,6 +27,8 @@ import (
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 	inflationtypes "github.com/evmos/evmos/v16/x/inflation/v1/types"
+	outpoststypes "github.com/evmos/evmos/v16/x/outposts/types"
+	randomnesstypes "github.com/evmos/evmos/v16/x/randomness/types"
 	revenuetypes "github.com/evmos/evmos/v16/x/revenue/v1/types"
 	vestingtypes "github.com/evmos/evmos/v16/x/vesting/types"
 )
@@ -54,7 +56,8 @@ func StoreKeys() (
 		// evmos keys
 		inflationtypes.StoreKey, erc20types.StoreKey,
 		epochstypes.StoreKey, vestingtypes.StoreKey,
-		revenuetypes.StoreKey,
+		revenuetypes.StoreKey, randomnesstypes.StoreKey,
+		outpoststypes.StoreKey,
 	}
 
 	keys := sdk.NewKVStoreKeys(storeKeys...)
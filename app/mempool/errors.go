@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// codespace is the codespace of the app-side mempool errors
+const codespace = "mempool"
+
+// errors
+var (
+	// ErrReplacementUnderpriced returns an error if a transaction is attempted to be
+	// replaced by a new one with the same sender and nonce but without a sufficient
+	// fee increase.
+	ErrReplacementUnderpriced = errorsmod.Register(codespace, 2, "replacement transaction underpriced")
+	// ErrTxReplaced returns an error if a transaction has been replaced by a new one
+	// with the same sender and nonce.
+	ErrTxReplaced = errorsmod.Register(codespace, 3, "transaction replaced")
+	// ErrTxEvicted returns an error if a transaction has been evicted from the mempool
+	// in favour of a higher priority one.
+	ErrTxEvicted = errorsmod.Register(codespace, 4, "transaction evicted")
+)
@@ -0,0 +1,398 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"sort"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ sdkmempool.Mempool = (*Mempool)(nil)
+
+// Mempool defines the app-side mempool, which orders the transactions by the
+// priority set by the ante handler (i.e. the effective tip of the Ethereum
+// transactions) while keeping the nonce order of the transactions of each
+// sender.
+//
+// A pending transaction can be replaced by a new one with the same sender and
+// nonce if its fee and tip caps are increased by at least the price bump
+// percentage. Once the mempool is full, the lowest priority transaction is
+// evicted in favour of a higher priority one. The replaced and evicted
+// transactions are dropped from the CometBFT mempool as well, if registered
+// with SetCometMempool. Otherwise, they are rejected on ReCheckTx (see
+// CheckPending).
+type Mempool struct {
+	mtx sync.RWMutex
+
+	// cometMempool is the CometBFT mempool of the node, which is nil if not
+	// registered
+	cometMempool CometMempool
+
+	// maxTxs is the maximum number of transactions, where 0 means unbounded
+	maxTxs int
+	// priceBump is the minimum fee increase percentage to replace a transaction
+	priceBump uint64
+
+	// senders maps each sender to its transactions sorted by nonce
+	senders map[string][]*mempoolTx
+	count   int
+	seq     uint64
+}
+
+// CometMempool defines the CometBFT mempool method used to drop the replaced
+// and evicted transactions.
+type CometMempool interface {
+	RemoveTxByKey(txKey cmttypes.TxKey) error
+}
+
+// NewMempool creates a new app-side mempool instance.
+func NewMempool(maxTxs int, priceBump uint64) *Mempool {
+	return &Mempool{
+		maxTxs:    maxTxs,
+		priceBump: priceBump,
+		senders:   make(map[string][]*mempoolTx),
+	}
+}
+
+// SetCometMempool registers the CometBFT mempool of the node, from which the
+// replaced and evicted transactions are dropped as soon as they are removed
+// from the app-side mempool.
+func (mp *Mempool) SetCometMempool(cometMempool CometMempool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.cometMempool = cometMempool
+}
+
+// Insert adds a transaction to the mempool, replacing the pending transaction
+// with the same sender and nonce if any. It returns ErrReplacementUnderpriced
+// if the replacement doesn't increase the fees enough and
+// ErrMempoolTxMaxCapacity if the mempool is full of higher priority
+// transactions.
+func (mp *Mempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	memTx, err := newMempoolTx(tx, ctx.Priority())
+	if err != nil {
+		return err
+	}
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		memTx.txKey = cmttypes.Tx(txBytes).Key()
+	}
+
+	dropped, err := mp.insert(memTx)
+	if err != nil {
+		return err
+	}
+
+	mp.dropFromComet(ctx, dropped)
+	return nil
+}
+
+// insert adds the transaction to the mempool and returns the transaction it
+// replaced or evicted, if any.
+func (mp *Mempool) insert(memTx *mempoolTx) (*mempoolTx, error) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.seq++
+	memTx.seq = mp.seq
+
+	txs := mp.senders[memTx.sender]
+	i := searchNonce(txs, memTx.nonce)
+	if i < len(txs) && txs[i].nonce == memTx.nonce {
+		if err := mp.checkReplacement(txs[i], memTx); err != nil {
+			return nil, err
+		}
+
+		replaced := txs[i]
+		txs[i] = memTx
+		return replaced, nil
+	}
+
+	var evicted *mempoolTx
+	if mp.maxTxs > 0 && mp.count >= mp.maxTxs {
+		if evicted = mp.evict(memTx); evicted == nil {
+			return nil, sdkmempool.ErrMempoolTxMaxCapacity
+		}
+	}
+
+	txs = mp.senders[memTx.sender]
+	i = searchNonce(txs, memTx.nonce)
+	txs = append(txs, nil)
+	copy(txs[i+1:], txs[i:])
+	txs[i] = memTx
+
+	mp.senders[memTx.sender] = txs
+	mp.count++
+	return evicted, nil
+}
+
+// dropFromComet removes the given transaction from the CometBFT mempool, if
+// registered. It is called on CheckTx, while the CometBFT mempool is locked for
+// updates, and without holding the mempool lock.
+func (mp *Mempool) dropFromComet(ctx sdk.Context, memTx *mempoolTx) {
+	mp.mtx.RLock()
+	cometMempool := mp.cometMempool
+	mp.mtx.RUnlock()
+
+	if cometMempool == nil || memTx == nil || memTx.txKey == (cmttypes.TxKey{}) {
+		return
+	}
+
+	if err := cometMempool.RemoveTxByKey(memTx.txKey); err != nil {
+		ctx.Logger().Debug(
+			"failed to drop the transaction from the CometBFT mempool",
+			"sender", memTx.sender, "nonce", memTx.nonce, "error", err.Error(),
+		)
+	}
+}
+
+// Select returns an iterator over the mempool transactions, ordered by
+// priority across senders and by nonce for each sender. The transactions with
+// the same priority are ordered by their insertion time.
+func (mp *Mempool) Select(_ context.Context, _ [][]byte) sdkmempool.Iterator {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	if mp.count == 0 {
+		return nil
+	}
+
+	senderHeads := make(txHeap, 0, len(mp.senders))
+	for _, txs := range mp.senders {
+		senderHeads = append(senderHeads, txs)
+	}
+	heap.Init(&senderHeads)
+
+	selected := make([]sdk.Tx, 0, mp.count)
+	for senderHeads.Len() > 0 {
+		txs := senderHeads[0]
+		selected = append(selected, txs[0].tx)
+
+		if len(txs) == 1 {
+			heap.Pop(&senderHeads)
+			continue
+		}
+
+		senderHeads[0] = txs[1:]
+		heap.Fix(&senderHeads, 0)
+	}
+
+	return &iterator{txs: selected}
+}
+
+// CheckReplacement checks if the given transaction can replace the pending
+// transaction with the same sender and nonce, and returns the pending one. It
+// returns nil if there is no such transaction and ErrReplacementUnderpriced if
+// the fees of the given transaction are not increased by at least the price
+// bump percentage.
+func (mp *Mempool) CheckReplacement(tx sdk.Tx) (sdk.Tx, error) {
+	memTx, err := newMempoolTx(tx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	txs := mp.senders[memTx.sender]
+	i := searchNonce(txs, memTx.nonce)
+	if i == len(txs) || txs[i].nonce != memTx.nonce {
+		return nil, nil
+	}
+
+	if err := mp.checkReplacement(txs[i], memTx); err != nil {
+		return txs[i].tx, err
+	}
+
+	return txs[i].tx, nil
+}
+
+// CheckPending checks that the given Ethereum transaction is still pending in
+// the mempool. It returns ErrTxEvicted if there is no pending transaction with
+// the same sender and nonce and ErrTxReplaced if the pending one is a different
+// transaction. It is used on ReCheckTx to drop the evicted and replaced
+// transactions from the CometBFT mempool, as every transaction accepted on
+// CheckTx is inserted into the mempool.
+func (mp *Mempool) CheckPending(tx sdk.Tx) error {
+	memTx, err := newMempoolTx(tx, 0)
+	if err != nil {
+		return err
+	}
+
+	if memTx.hash == "" {
+		return nil
+	}
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	txs := mp.senders[memTx.sender]
+	i := searchNonce(txs, memTx.nonce)
+	if i == len(txs) || txs[i].nonce != memTx.nonce {
+		return errorsmod.Wrapf(ErrTxEvicted, "sender %s, nonce %d", memTx.sender, memTx.nonce)
+	}
+
+	if txs[i].hash != memTx.hash {
+		return errorsmod.Wrapf(
+			ErrTxReplaced,
+			"sender %s, nonce %d: replaced by transaction %s",
+			memTx.sender, memTx.nonce, txs[i].hash,
+		)
+	}
+
+	return nil
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *Mempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.count
+}
+
+// Remove removes the given transaction from the mempool. Ethereum transactions
+// are matched by their hash, so that a pending transaction that replaced the
+// given one is kept, while Cosmos transactions are matched by their sender and
+// sequence. It returns ErrTxNotFound if there is no such transaction.
+func (mp *Mempool) Remove(tx sdk.Tx) error {
+	memTx, err := newMempoolTx(tx, 0)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txs := mp.senders[memTx.sender]
+	i := searchNonce(txs, memTx.nonce)
+	if i == len(txs) || txs[i].nonce != memTx.nonce || txs[i].hash != memTx.hash {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	mp.removeAt(memTx.sender, i)
+	return nil
+}
+
+// evict removes the lowest priority transaction if its priority is lower than
+// the one of the given transaction. Only the last transaction of each sender
+// can be evicted, so that no nonce gaps are created, and the transactions of
+// the sender of the given transaction are never evicted. It returns the
+// evicted transaction or nil if no transaction could be evicted.
+func (mp *Mempool) evict(memTx *mempoolTx) *mempoolTx {
+	var lowest *mempoolTx
+	for sender, txs := range mp.senders {
+		if sender == memTx.sender {
+			continue
+		}
+
+		last := txs[len(txs)-1]
+		if lowest == nil ||
+			last.priority < lowest.priority ||
+			(last.priority == lowest.priority && last.seq > lowest.seq) {
+			lowest = last
+		}
+	}
+
+	if lowest == nil || lowest.priority >= memTx.priority {
+		return nil
+	}
+
+	mp.removeAt(lowest.sender, len(mp.senders[lowest.sender])-1)
+	return lowest
+}
+
+// checkReplacement returns ErrReplacementUnderpriced if the pending transaction
+// can't be replaced by the given one.
+func (mp *Mempool) checkReplacement(pendingTx, memTx *mempoolTx) error {
+	if pendingTx.canReplace(memTx, mp.priceBump) {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		ErrReplacementUnderpriced,
+		"sender %s, nonce %d: fee cap and tip cap must be increased by at least %d%%",
+		memTx.sender, memTx.nonce, mp.priceBump,
+	)
+}
+
+// removeAt removes the transaction at the given index of the sender
+// transactions.
+func (mp *Mempool) removeAt(sender string, i int) {
+	txs := mp.senders[sender]
+	txs = append(txs[:i], txs[i+1:]...)
+	if len(txs) == 0 {
+		delete(mp.senders, sender)
+	} else {
+		mp.senders[sender] = txs
+	}
+
+	mp.count--
+}
+
+// searchNonce returns the index of the first transaction with a nonce greater
+// than or equal to the given one.
+func searchNonce(txs []*mempoolTx, nonce uint64) int {
+	return sort.Search(len(txs), func(i int) bool {
+		return txs[i].nonce >= nonce
+	})
+}
+
+// txHeap is a max-heap of the pending transactions of each sender, ordered by
+// the priority of their lowest nonce transaction.
+type txHeap [][]*mempoolTx
+
+func (h txHeap) Len() int { return len(h) }
+
+func (h txHeap) Less(i, j int) bool {
+	if h[i][0].priority != h[j][0].priority {
+		return h[i][0].priority > h[j][0].priority
+	}
+	return h[i][0].seq < h[j][0].seq
+}
+
+func (h txHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *txHeap) Push(x interface{}) {
+	*h = append(*h, x.([]*mempoolTx))
+}
+
+func (h *txHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+var _ sdkmempool.Iterator = (*iterator)(nil)
+
+// iterator iterates over a snapshot of the ordered mempool transactions.
+type iterator struct {
+	txs []sdk.Tx
+	idx int
+}
+
+// Next returns the next transaction iterator or nil if there are no more
+// transactions.
+func (it *iterator) Next() sdkmempool.Iterator {
+	if it.idx+1 >= len(it.txs) {
+		return nil
+	}
+
+	return &iterator{txs: it.txs, idx: it.idx + 1}
+}
+
+// Tx returns the current transaction.
+func (it *iterator) Tx() sdk.Tx {
+	return it.txs[it.idx]
+}
@@ -0,0 +1,212 @@
+package mempool
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// newEthTx returns an Ethereum transaction from the given sender,
+// with the given nonce and fee caps.
+func newEthTx(from common.Address, nonce uint64, feeCap, tipCap int64) *evmtypes.MsgEthereumTx {
+	to := common.BytesToAddress([]byte("recipient"))
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   big.NewInt(9000),
+		Nonce:     nonce,
+		To:        &to,
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(feeCap),
+		GasTipCap: big.NewInt(tipCap),
+	})
+	msg.From = from.Hex()
+	return msg
+}
+
+func withPriority(priority int64) context.Context {
+	return sdk.Context{}.WithContext(context.Background()).WithPriority(priority)
+}
+
+// selectTxs returns the transactions of the mempool in the selection order.
+func selectTxs(mp sdkmempool.Mempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(context.Background(), nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestMempoolSelect(t *testing.T) {
+	mp := NewMempool(0, 10)
+	require.Nil(t, mp.Select(context.Background(), nil))
+
+	alice, bob := common.BytesToAddress([]byte("alice")), common.BytesToAddress([]byte("bob"))
+
+	aliceTx0 := newEthTx(alice, 0, 100, 10)
+	aliceTx1 := newEthTx(alice, 1, 100, 50)
+	bobTx0 := newEthTx(bob, 0, 100, 20)
+	bobTx1 := newEthTx(bob, 1, 100, 20)
+
+	// insert out of nonce order
+	require.NoError(t, mp.Insert(withPriority(50), aliceTx1))
+	require.NoError(t, mp.Insert(withPriority(20), bobTx1))
+	require.NoError(t, mp.Insert(withPriority(10), aliceTx0))
+	require.NoError(t, mp.Insert(withPriority(20), bobTx0))
+	require.Equal(t, 4, mp.CountTx())
+
+	// bob's transactions have a higher priority than alice's first one, which
+	// has to be included before her higher priority transaction
+	require.Equal(t, []sdk.Tx{bobTx0, bobTx1, aliceTx0, aliceTx1}, selectTxs(mp))
+
+	require.NoError(t, mp.Remove(bobTx0))
+	require.ErrorIs(t, mp.Remove(bobTx0), sdkmempool.ErrTxNotFound)
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []sdk.Tx{bobTx1, aliceTx0, aliceTx1}, selectTxs(mp))
+
+	// a different transaction with the same sender and nonce is not removed
+	require.ErrorIs(t, mp.Remove(newEthTx(bob, 1, 200, 40)), sdkmempool.ErrTxNotFound)
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestMempoolReplacement(t *testing.T) {
+	sender := common.BytesToAddress([]byte("sender"))
+
+	testCases := []struct {
+		name       string
+		feeCap     int64
+		tipCap     int64
+		expReplace bool
+	}{
+		{"same fees - underpriced", 100, 10, false},
+		{"fee cap bumped, tip cap not bumped - underpriced", 110, 10, false},
+		{"tip cap bumped, fee cap not bumped - underpriced", 109, 11, false},
+		{"fee cap and tip cap bumped by the exact price bump", 110, 11, true},
+		{"fee cap and tip cap bumped above the price bump", 200, 20, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := NewMempool(0, 10)
+
+			oldTx := newEthTx(sender, 0, 100, 10)
+			require.NoError(t, mp.Insert(withPriority(10), oldTx))
+
+			newTx := newEthTx(sender, 0, tc.feeCap, tc.tipCap)
+			err := mp.Insert(withPriority(tc.tipCap), newTx)
+			require.Equal(t, 1, mp.CountTx())
+
+			if tc.expReplace {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.Tx{newTx}, selectTxs(mp))
+			} else {
+				require.ErrorIs(t, err, ErrReplacementUnderpriced)
+				require.Equal(t, []sdk.Tx{oldTx}, selectTxs(mp))
+			}
+		})
+	}
+}
+
+func TestMempoolEviction(t *testing.T) {
+	mp := NewMempool(3, 10)
+
+	alice := common.BytesToAddress([]byte("alice"))
+	bob := common.BytesToAddress([]byte("bob"))
+	carol := common.BytesToAddress([]byte("carol"))
+
+	aliceTx0 := newEthTx(alice, 0, 100, 30)
+	aliceTx1 := newEthTx(alice, 1, 100, 5)
+	bobTx0 := newEthTx(bob, 0, 100, 10)
+
+	require.NoError(t, mp.Insert(withPriority(30), aliceTx0))
+	require.NoError(t, mp.Insert(withPriority(5), aliceTx1))
+	require.NoError(t, mp.Insert(withPriority(10), bobTx0))
+
+	// the mempool is full of higher priority transactions
+	err := mp.Insert(withPriority(5), newEthTx(carol, 0, 100, 5))
+	require.ErrorIs(t, err, sdkmempool.ErrMempoolTxMaxCapacity)
+
+	// the transactions of the sender are not evicted in favour of its own
+	// transactions
+	aliceTx2 := newEthTx(alice, 2, 100, 20)
+	require.NoError(t, mp.Insert(withPriority(20), aliceTx2))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []sdk.Tx{aliceTx0, aliceTx1, aliceTx2}, selectTxs(mp))
+
+	// only the last transaction of a sender is evicted, to avoid nonce gaps
+	carolTx0 := newEthTx(carol, 0, 100, 50)
+	require.NoError(t, mp.Insert(withPriority(50), carolTx0))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []sdk.Tx{carolTx0, aliceTx0, aliceTx1}, selectTxs(mp))
+
+	// the evicted transaction is rejected on ReCheckTx so that it's dropped
+	// from the CometBFT mempool
+	require.ErrorIs(t, mp.CheckPending(aliceTx2), ErrTxEvicted)
+	require.NoError(t, mp.CheckPending(carolTx0))
+}
+
+func TestMempoolCheckPending(t *testing.T) {
+	mp := NewMempool(0, 10)
+	sender := common.BytesToAddress([]byte("sender"))
+
+	oldTx := newEthTx(sender, 0, 100, 10)
+	require.NoError(t, mp.Insert(withPriority(10), oldTx))
+	require.NoError(t, mp.CheckPending(oldTx))
+
+	// the transactions that are not in the mempool are rejected
+	require.ErrorIs(t, mp.CheckPending(newEthTx(sender, 1, 100, 10)), ErrTxEvicted)
+
+	// once replaced, the old transaction is rejected on ReCheckTx so that it's
+	// dropped from the CometBFT mempool, while the new one is kept
+	newTx := newEthTx(sender, 0, 200, 20)
+	require.NoError(t, mp.Insert(withPriority(20), newTx))
+	require.ErrorIs(t, mp.CheckPending(oldTx), ErrTxReplaced)
+	require.NoError(t, mp.CheckPending(newTx))
+}
+
+// cometMempool records the keys of the transactions dropped from the CometBFT
+// mempool.
+type cometMempool struct {
+	removed []cmttypes.TxKey
+}
+
+func (cm *cometMempool) RemoveTxByKey(txKey cmttypes.TxKey) error {
+	cm.removed = append(cm.removed, txKey)
+	return nil
+}
+
+func TestMempoolDropFromComet(t *testing.T) {
+	mp := NewMempool(2, 10)
+	cm := &cometMempool{}
+	mp.SetCometMempool(cm)
+
+	alice := common.BytesToAddress([]byte("alice"))
+	bob := common.BytesToAddress([]byte("bob"))
+
+	insert := func(tx sdk.Tx, priority int64, txBytes string) error {
+		ctx := sdk.Context{}.WithContext(context.Background()).WithPriority(priority).WithTxBytes([]byte(txBytes))
+		return mp.Insert(ctx, tx)
+	}
+
+	require.NoError(t, insert(newEthTx(alice, 0, 100, 10), 10, "aliceTx0"))
+	require.NoError(t, insert(newEthTx(bob, 0, 100, 20), 20, "bobTx0"))
+	require.Empty(t, cm.removed)
+
+	// the underpriced replacement doesn't drop the pending transaction
+	require.ErrorIs(t, insert(newEthTx(alice, 0, 100, 10), 10, "underpriced"), ErrReplacementUnderpriced)
+	require.Empty(t, cm.removed)
+
+	// the replaced transaction is dropped
+	require.NoError(t, insert(newEthTx(alice, 0, 200, 20), 20, "aliceTx0Replacement"))
+	require.Equal(t, []cmttypes.TxKey{cmttypes.Tx("aliceTx0").Key()}, cm.removed)
+
+	// the evicted transaction is dropped
+	require.NoError(t, insert(newEthTx(bob, 1, 100, 30), 30, "bobTx1"))
+	require.Equal(t, []cmttypes.TxKey{cmttypes.Tx("aliceTx0").Key(), cmttypes.Tx("aliceTx0Replacement").Key()}, cm.removed)
+}
@@ -0,0 +1,120 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// mempoolTx wraps a transaction of the mempool with the metadata used to order
+// and replace it.
+type mempoolTx struct {
+	tx sdk.Tx
+	// txKey is the CometBFT mempool key of the transaction, which is zero if
+	// the transaction bytes are not available
+	txKey cmttypes.TxKey
+	// hash is the Ethereum transaction hash, which is empty for Cosmos
+	// transactions
+	hash string
+	// sender is the address of the transaction sender
+	sender string
+	// nonce is the sender's sequence number
+	nonce uint64
+	// priority is the transaction priority set by the ante handler, which is
+	// the effective tip for Ethereum transactions
+	priority int64
+	// feeDenom is the denomination of the fees, which is empty for Ethereum
+	// transactions as their fees are defined in the EVM denom
+	feeDenom string
+	// feeCap is the maximum price per gas unit that the sender is willing to pay
+	feeCap sdkmath.LegacyDec
+	// tipCap is the maximum price per gas unit that the sender is willing to
+	// pay on top of the base fee
+	tipCap sdkmath.LegacyDec
+	// seq is the insertion sequence, used as a tiebreaker for transactions with
+	// the same priority
+	seq uint64
+}
+
+// newMempoolTx returns the mempool metadata of the given transaction. Ethereum
+// transactions are identified by the sender and nonce of their first message,
+// while Cosmos transactions are identified by their first signer and its
+// sequence.
+func newMempoolTx(tx sdk.Tx, priority int64) (*mempoolTx, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, errors.New("transaction has no messages")
+	}
+
+	if ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+		from := ethMsg.GetFrom()
+		if from.Empty() {
+			return nil, errors.New("ethereum transaction sender is not set")
+		}
+
+		ethTx := ethMsg.AsTransaction()
+		return &mempoolTx{
+			tx:       tx,
+			hash:     ethTx.Hash().Hex(),
+			sender:   from.String(),
+			nonce:    ethTx.Nonce(),
+			priority: priority,
+			feeCap:   sdkmath.LegacyNewDecFromBigInt(ethTx.GasFeeCap()),
+			tipCap:   sdkmath.LegacyNewDecFromBigInt(ethTx.GasTipCap()),
+		}, nil
+	}
+
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return nil, fmt.Errorf("invalid transaction type %T, expected signing.SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return nil, errors.New("transaction must have at least one signer")
+	}
+
+	memTx := &mempoolTx{
+		tx:       tx,
+		sender:   signers[0].String(),
+		nonce:    sigs[0].Sequence,
+		priority: priority,
+		feeCap:   sdkmath.LegacyZeroDec(),
+		tipCap:   sdkmath.LegacyZeroDec(),
+	}
+
+	// the gas price of Cosmos transactions is defined by the first fee coin
+	if feeTx, ok := tx.(sdk.FeeTx); ok && len(feeTx.GetFee()) > 0 && feeTx.GetGas() > 0 {
+		fee := feeTx.GetFee()[0]
+		memTx.feeDenom = fee.Denom
+		memTx.feeCap = sdkmath.LegacyNewDecFromInt(fee.Amount).QuoInt64(int64(feeTx.GetGas())) // #nosec G701 -- gas limit is lower than MaxInt64
+		memTx.tipCap = memTx.feeCap
+	}
+
+	return memTx, nil
+}
+
+// canReplace returns true if the transaction can be replaced by the given one,
+// which requires both its fee cap and tip cap to be increased by at least the
+// price bump percentage.
+func (memTx *mempoolTx) canReplace(newTx *mempoolTx, priceBump uint64) bool {
+	if memTx.feeDenom != newTx.feeDenom {
+		return false
+	}
+
+	bump := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(100 + priceBump)).QuoInt64(100)
+	return newTx.feeCap.GTE(memTx.feeCap.Mul(bump)) && newTx.tipCap.GTE(memTx.tipCap.Mul(bump))
+}
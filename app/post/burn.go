@@ -7,33 +7,36 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
-	"github.com/armon/go-metrics"
-	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 var _ sdk.PostDecorator = &BurnDecorator{}
 
-// BurnDecorator is the decorator that burns all the transaction fees from Cosmos transactions.
+// BurnDecorator is the decorator that burns and distributes the transaction fees from Cosmos transactions
+// according to the Cosmos fee split of the fee market.
 type BurnDecorator struct {
 	feeCollectorName string
 	bankKeeper       bankkeeper.Keeper
+	feeMarketKeeper  FeeMarketKeeper
 }
 
 // NewBurnDecorator creates a new instance of the BurnDecorator.
-func NewBurnDecorator(feeCollector string, bankKeeper bankkeeper.Keeper) sdk.PostDecorator {
+func NewBurnDecorator(feeCollector string, bankKeeper bankkeeper.Keeper, feeMarketKeeper FeeMarketKeeper) sdk.PostDecorator {
 	return &BurnDecorator{
 		feeCollectorName: feeCollector,
 		bankKeeper:       bankKeeper,
+		feeMarketKeeper:  feeMarketKeeper,
 	}
 }
 
-// PostHandle burns all the transaction fees from Cosmos transactions. If an Ethereum transaction is present, this logic
-// is skipped.
+// PostHandle burns and distributes the transaction fees from Cosmos transactions according to the Cosmos fee split.
+// If an Ethereum transaction is present, this logic is skipped, as the fees of Ethereum transactions are distributed
+// by the EVM module after the leftover gas is refunded.
 func (bd BurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -55,8 +58,8 @@ func (bd BurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success
 		return next(ctx, tx, simulate, success)
 	}
 
-	// burn min(balance, fee)
-	var burnedCoins sdk.Coins
+	// distribute min(balance, fee)
+	var distributedCoins sdk.Coins
 	for _, fee := range fees {
 		balance := bd.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(bd.feeCollectorName), fee.Denom)
 		if !balance.IsPositive() {
@@ -65,33 +68,14 @@ func (bd BurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success
 
 		amount := sdkmath.MinInt(fee.Amount, balance.Amount)
 
-		burnedCoins = append(burnedCoins, sdk.Coin{Denom: fee.Denom, Amount: amount})
+		distributedCoins = append(distributedCoins, sdk.Coin{Denom: fee.Denom, Amount: amount})
 	}
 
 	// NOTE: since all Cosmos tx fees are pooled by the fee collector module account,
-	// we burn them directly from it
-	if err := bd.bankKeeper.BurnCoins(ctx, bd.feeCollectorName, burnedCoins); err != nil {
+	// they are burned and distributed directly from it
+	if err := bd.feeMarketKeeper.DistributeTxFees(ctx, feemarkettypes.TxClassCosmos, distributedCoins); err != nil {
 		return ctx, err
 	}
 
-	defer func() {
-		if ctx.IsCheckTx() || ctx.IsReCheckTx() {
-			return
-		}
-		for _, c := range burnedCoins {
-			// if fee amount is higher than uint64, skip the counter
-			if !c.Amount.IsUint64() {
-				continue
-			}
-			telemetry.IncrCounterWithLabels(
-				[]string{"burned", "tx", "fee", "amount"},
-				float32(c.Amount.Uint64()),
-				[]metrics.Label{
-					telemetry.NewLabel("denom", c.Denom),
-				},
-			)
-		}
-	}()
-
 	return next(ctx, tx, simulate, success)
 }
@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/evmos/evmos/v16/app/post"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 
 	// "github.com/evmos/evmos/v16/testutil/integration/evmos/factory"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -130,6 +131,35 @@ func (s *PostTestSuite) TestPostHandle() {
 				s.Require().Equal(expected, balance)
 			},
 		},
+		{
+			name: "pass - burn and distribute fees according to the cosmos fee split",
+			tx: func() sdk.Tx {
+				params := s.unitNetwork.App.FeeMarketKeeper.GetParams(s.unitNetwork.GetContext())
+				params.CosmosFeeSplit = feemarkettypes.NewFeeSplit(
+					sdkmath.LegacyNewDecWithPrec(5, 1),
+					sdkmath.LegacyNewDecWithPrec(3, 1),
+					sdkmath.LegacyNewDecWithPrec(2, 1),
+					sdkmath.LegacyZeroDec(),
+				)
+				err := s.unitNetwork.App.FeeMarketKeeper.SetParams(s.unitNetwork.GetContext(), params)
+				s.Require().NoError(err)
+
+				feeAmount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "dhives"}}
+				s.MintCoinsForFeeCollector(feeAmount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			expPass: true,
+			postChecks: func() {
+				// the validators portion is kept by the fee collector
+				expected := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(2), Denom: "dhives"}}
+				balance := s.GetFeeCollectorBalance()
+				s.Require().Equal(expected, balance)
+
+				communityPool := s.unitNetwork.App.DistrKeeper.GetFeePoolCommunityCoins(s.unitNetwork.GetContext())
+				s.Require().Equal(sdkmath.LegacyNewDec(3), communityPool.AmountOf("dhives"))
+			},
+		},
 		{
 			name: "pass - fees exceeds MaxUint64 (~18 EVMOS). Should not panic",
 			tx: func() sdk.Tx {
@@ -162,6 +192,7 @@ func (s *PostTestSuite) TestPostHandle() {
 			burnDecorator := post.NewBurnDecorator(
 				authtypes.FeeCollectorName,
 				s.unitNetwork.App.BankKeeper,
+				s.unitNetwork.App.FeeMarketKeeper,
 			)
 
 			// In the execution of the PostHandle method, simulate, success, and next have been
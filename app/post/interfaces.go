@@ -0,0 +1,14 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package post
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeMarketKeeper defines the expected interface needed to distribute the
+// transaction fees according to the fee split of the fee market.
+type FeeMarketKeeper interface {
+	DistributeTxFees(ctx sdk.Context, txClass string, fees sdk.Coins) error
+}
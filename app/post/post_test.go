@@ -11,34 +11,47 @@ import (
 
 func (s *PostTestSuite) TestPostHandlerOptions() {
 	validBankKeeper := s.unitNetwork.App.BankKeeper
+	validFeeMarketKeeper := s.unitNetwork.App.FeeMarketKeeper
 	validFeeCollector := authtypes.FeeCollectorName
 
 	testCases := []struct {
-		name         string
-		feeCollector string
-		bankKeeper   bankkeeper.Keeper
-		expPass      bool
-		errContains  string
+		name            string
+		feeCollector    string
+		bankKeeper      bankkeeper.Keeper
+		feeMarketKeeper post.FeeMarketKeeper
+		expPass         bool
+		errContains     string
 	}{
 		{
-			name:         "fail - empty fee collector name",
-			feeCollector: "",
-			bankKeeper:   validBankKeeper,
-			expPass:      false,
-			errContains:  "fee collector name cannot be empty",
+			name:            "fail - empty fee collector name",
+			feeCollector:    "",
+			bankKeeper:      validBankKeeper,
+			feeMarketKeeper: validFeeMarketKeeper,
+			expPass:         false,
+			errContains:     "fee collector name cannot be empty",
 		},
 		{
-			name:         "fail - nil bank keeper",
-			feeCollector: validFeeCollector,
-			bankKeeper:   nil,
-			expPass:      false,
-			errContains:  "bank keeper cannot be nil",
+			name:            "fail - nil bank keeper",
+			feeCollector:    validFeeCollector,
+			bankKeeper:      nil,
+			feeMarketKeeper: validFeeMarketKeeper,
+			expPass:         false,
+			errContains:     "bank keeper cannot be nil",
 		},
 		{
-			name:         "pass - correct inputs",
-			feeCollector: validFeeCollector,
-			bankKeeper:   validBankKeeper,
-			expPass:      true,
+			name:            "fail - nil fee market keeper",
+			feeCollector:    validFeeCollector,
+			bankKeeper:      validBankKeeper,
+			feeMarketKeeper: nil,
+			expPass:         false,
+			errContains:     "fee market keeper cannot be nil",
+		},
+		{
+			name:            "pass - correct inputs",
+			feeCollector:    validFeeCollector,
+			bankKeeper:      validBankKeeper,
+			feeMarketKeeper: validFeeMarketKeeper,
+			expPass:         true,
 		},
 	}
 
@@ -54,6 +67,7 @@ func (s *PostTestSuite) TestPostHandlerOptions() {
 			handlerOptions := post.HandlerOptions{
 				FeeCollectorName: tc.feeCollector,
 				BankKeeper:       tc.bankKeeper,
+				FeeMarketKeeper:  tc.feeMarketKeeper,
 			}
 
 			err = handlerOptions.Validate()
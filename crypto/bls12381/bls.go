@@ -0,0 +1,269 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bls12381
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	bls "github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+const (
+	// G1PointLength defines the length of a G1 point encoded as per EIP-2537 (128 bytes).
+	G1PointLength = 128
+	// G2PointLength defines the length of a G2 point encoded as per EIP-2537 (256 bytes).
+	G2PointLength = 256
+	// PairLength defines the length of a G1 and G2 point pair encoded as per EIP-2537 (384 bytes).
+	PairLength = G1PointLength + G2PointLength
+)
+
+// DST defines the domain separation tag of the proof of possession ciphersuite
+// with public keys in G1 and signatures in G2, as used by the Ethereum beacon chain.
+var DST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// fieldModulus is the modulus of the BLS12-381 base field.
+var fieldModulus, _ = new(big.Int).SetString(
+	"1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16,
+)
+
+var (
+	// ErrInvalidInputLength is returned when the pairing input is not a non-empty multiple of PairLength.
+	ErrInvalidInputLength = errors.New("invalid input length")
+	// ErrG1PointSubgroup is returned when a G1 point is not in the correct subgroup.
+	ErrG1PointSubgroup = errors.New("g1 point is not on correct subgroup")
+	// ErrG2PointSubgroup is returned when a G2 point is not in the correct subgroup.
+	ErrG2PointSubgroup = errors.New("g2 point is not on correct subgroup")
+	// ErrInfinityPublicKey is returned when a public key is the point at infinity.
+	ErrInfinityPublicKey = errors.New("public key is the point at infinity")
+	// ErrEmptyPublicKeys is returned when no public keys are provided.
+	ErrEmptyPublicKeys = errors.New("no public keys provided")
+	// ErrLengthMismatch is returned when the number of public keys and messages differ.
+	ErrLengthMismatch = errors.New("public keys and messages length mismatch")
+)
+
+// Pairing performs the pairing check of EIP-2537 on the given input, which is the
+// concatenation of k pairs of a G1 point (128 bytes) and a G2 point (256 bytes).
+// It returns true if the product of the pairings is equal to the identity.
+func Pairing(input []byte) (bool, error) {
+	if len(input) == 0 || len(input)%PairLength != 0 {
+		return false, ErrInvalidInputLength
+	}
+
+	e := bls.NewPairingEngine()
+	for i := 0; i < len(input)/PairLength; i++ {
+		off := PairLength * i
+
+		p1, err := DecodeG1(input[off : off+G1PointLength])
+		if err != nil {
+			return false, err
+		}
+
+		p2, err := DecodeG2(input[off+G1PointLength : off+PairLength])
+		if err != nil {
+			return false, err
+		}
+
+		e.AddPair(p1, p2)
+	}
+
+	return e.Check(), nil
+}
+
+// Verify verifies a signature in G2 over the given message for a public key in G1.
+func Verify(pubKey, msg, sig []byte) (bool, error) {
+	return AggregateVerify([][]byte{pubKey}, [][]byte{msg}, sig)
+}
+
+// AggregateVerify verifies an aggregate signature in G2 over the given messages, each of
+// them signed by the public key in G1 with the same index.
+func AggregateVerify(pubKeys, msgs [][]byte, sig []byte) (bool, error) {
+	if len(pubKeys) == 0 {
+		return false, ErrEmptyPublicKeys
+	}
+
+	if len(pubKeys) != len(msgs) {
+		return false, ErrLengthMismatch
+	}
+
+	signature, err := DecodeG2(sig)
+	if err != nil {
+		return false, err
+	}
+
+	e := bls.NewPairingEngine()
+	for i, pubKey := range pubKeys {
+		pk, err := decodePublicKey(pubKey)
+		if err != nil {
+			return false, err
+		}
+
+		h, err := HashToG2(msgs[i], DST)
+		if err != nil {
+			return false, err
+		}
+
+		e.AddPair(pk, h)
+	}
+
+	// e(pk_1, H(m_1)) * ... * e(pk_n, H(m_n)) * e(-g1, sig) == 1
+	e.AddPairInv(e.G1.One(), signature)
+	return e.Check(), nil
+}
+
+// FastAggregateVerify verifies an aggregate signature in G2 over a single message
+// signed by all the given public keys in G1.
+func FastAggregateVerify(pubKeys [][]byte, msg, sig []byte) (bool, error) {
+	if len(pubKeys) == 0 {
+		return false, ErrEmptyPublicKeys
+	}
+
+	g1 := bls.NewG1()
+	aggregated := g1.Zero()
+	for _, pubKey := range pubKeys {
+		pk, err := decodePublicKey(pubKey)
+		if err != nil {
+			return false, err
+		}
+
+		g1.Add(aggregated, aggregated, pk)
+	}
+
+	return Verify(g1.EncodePoint(aggregated), msg, sig)
+}
+
+// DecodeG1 decodes a G1 point encoded as per EIP-2537 and checks that it belongs
+// to the correct subgroup.
+func DecodeG1(in []byte) (*bls.PointG1, error) {
+	g1 := bls.NewG1()
+	p, err := g1.DecodePoint(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if !g1.InCorrectSubgroup(p) {
+		return nil, ErrG1PointSubgroup
+	}
+
+	return p, nil
+}
+
+// DecodeG2 decodes a G2 point encoded as per EIP-2537 and checks that it belongs
+// to the correct subgroup.
+func DecodeG2(in []byte) (*bls.PointG2, error) {
+	g2 := bls.NewG2()
+	p, err := g2.DecodePoint(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if !g2.InCorrectSubgroup(p) {
+		return nil, ErrG2PointSubgroup
+	}
+
+	return p, nil
+}
+
+// HashToG2 hashes the message to a G2 point using the hash_to_curve random oracle
+// construction of RFC 9380 with expand_message_xmd over SHA-256.
+func HashToG2(msg, dst []byte) (*bls.PointG2, error) {
+	// two field elements of Fp2, each made of two 64 byte chunks
+	uniformBytes, err := expandMessageXMD(msg, dst, 256)
+	if err != nil {
+		return nil, err
+	}
+
+	g2 := bls.NewG2()
+	q := g2.Zero()
+	for i := 0; i < 2; i++ {
+		off := 128 * i
+
+		// MapToCurve expects the c1 coefficient followed by the c0 coefficient
+		fe := make([]byte, 96)
+		copy(fe[48:], reduceFieldElement(uniformBytes[off:off+64]))
+		copy(fe[:48], reduceFieldElement(uniformBytes[off+64:off+128]))
+
+		// NOTE: MapToCurve clears the cofactor, which is a group homomorphism, so
+		// the sum of the mapped points matches clear_cofactor(Q0 + Q1).
+		p, err := g2.MapToCurve(fe)
+		if err != nil {
+			return nil, err
+		}
+
+		g2.Add(q, q, p)
+	}
+
+	return q, nil
+}
+
+// decodePublicKey decodes a public key in G1 and validates it as per the KeyValidate
+// procedure of the BLS signature scheme.
+func decodePublicKey(in []byte) (*bls.PointG1, error) {
+	pk, err := DecodeG1(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if bls.NewG1().IsZero(pk) {
+		return nil, ErrInfinityPublicKey
+	}
+
+	return pk, nil
+}
+
+// reduceFieldElement interprets the input as a big-endian integer and returns
+// its 48 byte encoding modulo the field modulus.
+func reduceFieldElement(in []byte) []byte {
+	e := new(big.Int).SetBytes(in)
+	e.Mod(e, fieldModulus)
+
+	out := make([]byte, 48)
+	return e.FillBytes(out)
+}
+
+// expandMessageXMD implements expand_message_xmd of RFC 9380 with SHA-256.
+func expandMessageXMD(msg, dst []byte, length int) ([]byte, error) {
+	h := sha256.New()
+	ell := (length + h.Size() - 1) / h.Size()
+	if ell > 255 || len(dst) > 255 {
+		return nil, errors.New("invalid expand message length")
+	}
+
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	// b_0 = H(Z_pad || msg || l_i_b_str || I2OSP(0, 1) || DST_prime)
+	h.Write(make([]byte, h.BlockSize()))
+	h.Write(msg)
+	h.Write([]byte{byte(length >> 8), byte(length), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	// b_1 = H(b_0 || I2OSP(1, 1) || DST_prime)
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dstPrime)
+	bi := h.Sum(nil)
+
+	out := make([]byte, 0, ell*h.Size())
+	out = append(out, bi...)
+
+	// b_i = H(strxor(b_0, b_(i - 1)) || I2OSP(i, 1) || DST_prime)
+	for i := 2; i <= ell; i++ {
+		tmp := make([]byte, h.Size())
+		for j := range tmp {
+			tmp[j] = b0[j] ^ bi[j]
+		}
+
+		h.Reset()
+		h.Write(tmp)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		out = append(out, bi...)
+	}
+
+	return out[:length], nil
+}
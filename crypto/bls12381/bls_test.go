@@ -0,0 +1,144 @@
+package bls12381
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	bls "github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+// sign returns the EIP-2537 encoded public key and signature of the given secret key over the message.
+func sign(t *testing.T, sk int64, msg []byte) (pubKey, sig []byte) {
+	g1, g2 := bls.NewG1(), bls.NewG2()
+
+	pk := g1.New()
+	g1.MulScalar(pk, g1.One(), big.NewInt(sk))
+
+	h, err := HashToG2(msg, DST)
+	require.NoError(t, err)
+
+	s := g2.New()
+	g2.MulScalar(s, h, big.NewInt(sk))
+
+	return g1.EncodePoint(pk), g2.EncodePoint(s)
+}
+
+// aggregate returns the EIP-2537 encoded sum of the given G2 points.
+func aggregate(t *testing.T, sigs ...[]byte) []byte {
+	g2 := bls.NewG2()
+	sum := g2.Zero()
+	for _, sig := range sigs {
+		p, err := g2.DecodePoint(sig)
+		require.NoError(t, err)
+		g2.Add(sum, sum, p)
+	}
+	return g2.EncodePoint(sum)
+}
+
+func TestExpandMessageXMD(t *testing.T) {
+	// test vectors from RFC 9380, appendix K.1
+	dst := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+	testCases := []struct {
+		msg string
+		exp string
+	}{
+		{"", "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235"},
+		{"abc", "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+	}
+
+	for _, tc := range testCases {
+		out, err := expandMessageXMD([]byte(tc.msg), dst, 32)
+		require.NoError(t, err)
+		require.Equal(t, tc.exp, hex.EncodeToString(out))
+	}
+}
+
+func TestHashToG2(t *testing.T) {
+	// test vector from RFC 9380, appendix J.10.1
+	p, err := HashToG2([]byte(""), []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_"))
+	require.NoError(t, err)
+
+	encoded := bls.NewG2().EncodePoint(p)
+	require.Equal(t, "0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a", hex.EncodeToString(encoded[16:64]))
+	require.Equal(t, "05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d", hex.EncodeToString(encoded[80:128]))
+}
+
+func TestVerify(t *testing.T) {
+	msg := []byte("hello world")
+	pubKey, sig := sign(t, 42, msg)
+
+	valid, err := Verify(pubKey, msg, sig)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	valid, err = Verify(pubKey, []byte("hello"), sig)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	_, err = Verify(make([]byte, G1PointLength), msg, sig)
+	require.ErrorIs(t, err, ErrInfinityPublicKey)
+
+	_, err = Verify(pubKey[1:], msg, sig)
+	require.Error(t, err)
+}
+
+func TestAggregateVerify(t *testing.T) {
+	msg1, msg2 := []byte("message 1"), []byte("message 2")
+	pubKey1, sig1 := sign(t, 1, msg1)
+	pubKey2, sig2 := sign(t, 2, msg2)
+	sig := aggregate(t, sig1, sig2)
+
+	valid, err := AggregateVerify([][]byte{pubKey1, pubKey2}, [][]byte{msg1, msg2}, sig)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	valid, err = AggregateVerify([][]byte{pubKey1, pubKey2}, [][]byte{msg2, msg1}, sig)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	_, err = AggregateVerify([][]byte{pubKey1}, [][]byte{msg1, msg2}, sig)
+	require.ErrorIs(t, err, ErrLengthMismatch)
+
+	_, err = AggregateVerify(nil, nil, sig)
+	require.ErrorIs(t, err, ErrEmptyPublicKeys)
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	msg := []byte("beacon block root")
+	pubKey1, sig1 := sign(t, 3, msg)
+	pubKey2, sig2 := sign(t, 4, msg)
+	sig := aggregate(t, sig1, sig2)
+
+	valid, err := FastAggregateVerify([][]byte{pubKey1, pubKey2}, msg, sig)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	valid, err = FastAggregateVerify([][]byte{pubKey1}, msg, sig)
+	require.NoError(t, err)
+	require.False(t, valid)
+}
+
+func TestPairing(t *testing.T) {
+	g1, g2 := bls.NewG1(), bls.NewG2()
+
+	// e(g1, g2) * e(-g1, g2) == 1
+	negG1 := g1.New()
+	g1.Neg(negG1, g1.One())
+
+	input := append(g1.EncodePoint(g1.One()), g2.EncodePoint(g2.One())...)
+	input = append(input, g1.EncodePoint(negG1)...)
+	input = append(input, g2.EncodePoint(g2.One())...)
+
+	success, err := Pairing(input)
+	require.NoError(t, err)
+	require.True(t, success)
+
+	success, err = Pairing(input[:PairLength])
+	require.NoError(t, err)
+	require.False(t, success)
+
+	_, err = Pairing(input[:PairLength-1])
+	require.ErrorIs(t, err, ErrInvalidInputLength)
+}
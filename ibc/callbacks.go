@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ibc
+
+import (
+	"encoding/json"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/utils"
+)
+
+// SourceCallbackMemoKey defines the memo key of the source callback data, as
+// defined in ADR-008.
+const SourceCallbackMemoKey = "src_callback"
+
+// CallbackData defines the contract to be called back on the packet lifecycle
+// events and the gas limit of the call.
+type CallbackData struct {
+	// ContractAddress is the hex address of the contract to be called
+	ContractAddress common.Address
+	// SenderAddress is the hex address of the packet sender
+	SenderAddress common.Address
+	// GasLimit is the gas limit of the callback execution
+	GasLimit uint64
+}
+
+// GetSourceCallbackData returns the source callback data of an ICS20 packet,
+// given the maximum gas limit allowed for the callback. The memo is expected
+// to have the following format:
+//
+//	{"src_callback": {"address": "0x...", "gas_limit": "100000"}}
+//
+// The gas limit is optional and it is capped at the maximum gas limit. It
+// returns false if the packet memo doesn't contain the source callback key.
+func GetSourceCallbackData(packet channeltypes.Packet, maxGasLimit uint64) (CallbackData, bool, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return CallbackData{}, false, nil
+	}
+
+	if data.Memo == "" {
+		return CallbackData{}, false, nil
+	}
+
+	memo := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(data.Memo), &memo); err != nil {
+		// the memo is not a JSON object, so it doesn't contain callback data
+		return CallbackData{}, false, nil
+	}
+
+	rawCallback, found := memo[SourceCallbackMemoKey]
+	if !found {
+		return CallbackData{}, false, nil
+	}
+
+	var callback struct {
+		Address  string      `json:"address"`
+		GasLimit json.Number `json:"gas_limit,omitempty"`
+	}
+	if err := json.Unmarshal(rawCallback, &callback); err != nil {
+		return CallbackData{}, true, errorsmod.Wrap(ErrInvalidCallback, err.Error())
+	}
+
+	if !common.IsHexAddress(callback.Address) {
+		return CallbackData{}, true, errorsmod.Wrapf(ErrInvalidCallback, "invalid contract address %s", callback.Address)
+	}
+
+	gasLimit := maxGasLimit
+	if callback.GasLimit != "" {
+		userGasLimit, err := strconv.ParseUint(callback.GasLimit.String(), 10, 64)
+		if err != nil {
+			return CallbackData{}, true, errorsmod.Wrapf(ErrInvalidCallback, "invalid gas limit %s", callback.GasLimit)
+		}
+
+		if userGasLimit < gasLimit {
+			gasLimit = userGasLimit
+		}
+	}
+
+	sender, err := utils.GetEvmosAddressFromBech32(data.Sender)
+	if err != nil {
+		return CallbackData{}, true, errorsmod.Wrapf(ErrInvalidCallback, "invalid sender %s", data.Sender)
+	}
+
+	return CallbackData{
+		ContractAddress: common.HexToAddress(callback.Address),
+		SenderAddress:   common.BytesToAddress(sender),
+		GasLimit:        gasLimit,
+	}, true, nil
+}
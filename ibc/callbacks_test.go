@@ -0,0 +1,135 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestGetSourceCallbackData(t *testing.T) {
+	contract := common.HexToAddress("0x1E0DEbB1A4c1a0B8D0b1Ca1BcBbcD1f4a8bFa9E1")
+	sender := sdk.AccAddress(contract.Bytes()).String()
+	maxGasLimit := uint64(1_000_000)
+
+	packetWithMemo := func(memo string) channeltypes.Packet {
+		return channeltypes.Packet{
+			Data: transfertypes.ModuleCdc.MustMarshalJSON(
+				&transfertypes.FungibleTokenPacketData{
+					Denom:    "aevmos",
+					Amount:   "1000",
+					Sender:   sender,
+					Receiver: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+					Memo:     memo,
+				},
+			),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		packet      channeltypes.Packet
+		expFound    bool
+		expError    bool
+		expCallback CallbackData
+	}{
+		{
+			name:     "not found - invalid packet data",
+			packet:   channeltypes.Packet{Data: ibctesting.MockFailPacketData},
+			expFound: false,
+		},
+		{
+			name:     "not found - empty memo",
+			packet:   packetWithMemo(""),
+			expFound: false,
+		},
+		{
+			name:     "not found - memo is not a JSON object",
+			packet:   packetWithMemo("hello"),
+			expFound: false,
+		},
+		{
+			name:     "not found - memo without callback key",
+			packet:   packetWithMemo(`{"wasm":{"contract":"cosmos1"}}`),
+			expFound: false,
+		},
+		{
+			name:     "fail - callback is not an object",
+			packet:   packetWithMemo(`{"src_callback":"0x1E0DEbB1A4c1a0B8D0b1Ca1BcBbcD1f4a8bFa9E1"}`),
+			expFound: true,
+			expError: true,
+		},
+		{
+			name:     "fail - invalid contract address",
+			packet:   packetWithMemo(`{"src_callback":{"address":"evmos1"}}`),
+			expFound: true,
+			expError: true,
+		},
+		{
+			name:     "fail - invalid gas limit",
+			packet:   packetWithMemo(`{"src_callback":{"address":"0x1E0DEbB1A4c1a0B8D0b1Ca1BcBbcD1f4a8bFa9E1","gas_limit":"-1"}}`),
+			expFound: true,
+			expError: true,
+		},
+		{
+			name:     "pass - default gas limit",
+			packet:   packetWithMemo(`{"src_callback":{"address":"0x1E0DEbB1A4c1a0B8D0b1Ca1BcBbcD1f4a8bFa9E1"}}`),
+			expFound: true,
+			expCallback: CallbackData{
+				ContractAddress: contract,
+				SenderAddress:   contract,
+				GasLimit:        maxGasLimit,
+			},
+		},
+		{
+			name:     "pass - gas limit as string",
+			packet:   packetWithMemo(`{"src_callback":{"address":"0x1E0DEbB1A4c1a0B8D0b1Ca1BcBbcD1f4a8bFa9E1","gas_limit":"100000"}}`),
+			expFound: true,
+			expCallback: CallbackData{
+				ContractAddress: contract,
+				SenderAddress:   contract,
+				GasLimit:        100_000,
+			},
+		},
+		{
+			name:     "pass - gas limit as number",
+			packet:   packetWithMemo(`{"src_callback":{"address":"0x1E0DEbB1A4c1a0B8D0b1Ca1BcBbcD1f4a8bFa9E1","gas_limit":200000}}`),
+			expFound: true,
+			expCallback: CallbackData{
+				ContractAddress: contract,
+				SenderAddress:   contract,
+				GasLimit:        200_000,
+			},
+		},
+		{
+			name:     "pass - gas limit capped at the maximum",
+			packet:   packetWithMemo(`{"src_callback":{"address":"0x1E0DEbB1A4c1a0B8D0b1Ca1BcBbcD1f4a8bFa9E1","gas_limit":"5000000"}}`),
+			expFound: true,
+			expCallback: CallbackData{
+				ContractAddress: contract,
+				SenderAddress:   contract,
+				GasLimit:        maxGasLimit,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			callback, found, err := GetSourceCallbackData(tc.packet, maxGasLimit)
+			require.Equal(t, tc.expFound, found)
+			if tc.expError {
+				require.ErrorIs(t, err, ErrInvalidCallback)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expCallback, callback)
+		})
+	}
+}
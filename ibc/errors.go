@@ -8,4 +8,5 @@ var (
 	ErrNoIBCVoucherDenom  = errors.New("denom is not an IBC voucher")
 	ErrDenomTraceNotFound = errors.New("denom trace not found")
 	ErrInvalidBaseDenom   = errors.New("invalid base denomination")
+	ErrInvalidCallback    = errors.New("invalid callback memo")
 )
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev Define the authorization types that can be granted.
+string constant GENERIC_AUTHORIZATION = "/cosmos.authz.v1beta1.GenericAuthorization";
+string constant SEND_AUTHORIZATION = "/cosmos.bank.v1beta1.SendAuthorization";
+
+/// @dev GrantAuthorization defines an authorization granted by a granter to a grantee.
+struct GrantAuthorization {
+    /// granter is the address of the account that granted the authorization
+    address granter;
+    /// grantee is the address of the account that received the authorization
+    address grantee;
+    /// msgTypeUrl is the Cosmos SDK message type URL that the grantee is allowed to execute
+    string msgTypeUrl;
+    /// authorizationType is the type URL of the authorization (generic or send)
+    string authorizationType;
+    /// spendLimit is the spend limit of a send authorization. Empty for generic authorizations.
+    Coin[] spendLimit;
+    /// allowList is the list of allowed recipients of a send authorization. Empty for generic authorizations.
+    address[] allowList;
+    /// expiration is the unix timestamp in seconds at which the grant expires. Zero if it never expires.
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the Cosmos SDK x/authz module.
+/// The caller of each transaction acts as the granter on grants and revocations and as
+/// the grantee on executions.
+/// @custom:address 0x0000000000000000000000000000000000000806
+interface IAuthz {
+    /// @dev Grant defines an Event emitted when an authorization is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the message type URL that is authorized
+    event Grant(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Revoke defines an Event emitted when an authorization is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the message type URL that is revoked
+    event Revoke(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Exec defines an Event emitted when messages are executed on behalf of granters.
+    /// @param grantee the address of the grantee that executed the messages
+    /// @param msgTypeUrls the type URLs of the executed messages
+    event Exec(
+        address indexed grantee,
+        string[] msgTypeUrls
+    );
+
+    /// TRANSACTIONS
+
+    /// @dev Grants a generic authorization to execute messages of the given type
+    /// on behalf of the caller.
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The message type URL to authorize
+    /// @param expiration The unix timestamp in seconds at which the grant expires. Zero for no expiration.
+    /// @return success Whether the transaction was successful or not
+    function grant(
+        address grantee,
+        string memory msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Grants a send authorization to spend the caller's funds through bank MsgSend.
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of coins that can be spent
+    /// @param allowList The list of allowed recipients. Empty to allow any recipient.
+    /// @param expiration The unix timestamp in seconds at which the grant expires. Zero for no expiration.
+    /// @return success Whether the transaction was successful or not
+    function grantSend(
+        address grantee,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes an authorization previously granted by the caller.
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The message type URL of the authorization to revoke
+    /// @return success Whether the transaction was successful or not
+    function revoke(
+        address grantee,
+        string memory msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Executes the given Cosmos SDK messages on behalf of their granters, using the
+    /// authorizations granted to the caller. Each message is encoded as the proto JSON
+    /// representation of a google.protobuf.Any (i.e. including the "@type" field).
+    /// Only allow-listed message types can be executed.
+    /// @param msgs The JSON encoded messages to execute
+    /// @return success Whether the transaction was successful or not
+    function exec(
+        string[] calldata msgs
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Returns the authorizations granted by a granter to a grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The message type URL to filter by. Empty to return all the authorizations.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return grants The authorizations granted by the granter to the grantee
+    /// @return pageResponse The pagination response for the query
+    function grants(
+        address granter,
+        address grantee,
+        string memory msgTypeUrl,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            GrantAuthorization[] memory grants,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Returns the authorizations granted by a granter.
+    /// @param granter The address of the granter
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return grants The authorizations granted by the granter
+    /// @return pageResponse The pagination response for the query
+    function granterGrants(
+        address granter,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            GrantAuthorization[] memory grants,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Returns the authorizations granted to a grantee.
+    /// @param grantee The address of the grantee
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return grants The authorizations granted to the grantee
+    /// @return pageResponse The pagination response for the query
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            GrantAuthorization[] memory grants,
+            PageResponse memory pageResponse
+        );
+}
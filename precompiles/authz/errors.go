@@ -0,0 +1,25 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidExpiration is raised when the expiration timestamp is negative.
+	ErrInvalidExpiration = "invalid expiration: %d"
+	// ErrInvalidSpendLimit is raised when the spend limit of a send authorization is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidAuthorization is raised when a stored authorization cannot be decoded.
+	ErrInvalidAuthorization = "invalid authorization type: %s"
+	// ErrDisabledMsgType is raised when the message type is disabled in the authz limiter.
+	ErrDisabledMsgType = "message type %s is disabled for authorizations"
+	// ErrMsgTypeNotAllowed is raised when the message type cannot be executed through the precompile.
+	ErrMsgTypeNotAllowed = "message type %s is not allowed to be executed through the authz precompile"
+	// ErrEmptyMsgs is raised when no messages are provided for execution.
+	ErrEmptyMsgs = "no messages provided for execution"
+	// ErrInvalidMsg is raised when a message for execution cannot be decoded.
+	ErrInvalidMsg = "invalid message at index %d: %v"
+)
@@ -0,0 +1,111 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants returns the authorizations granted by a granter to a grantee,
+// optionally filtered by message type.
+func (p Precompile) Grants(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	grants := make([]*authz.GrantAuthorization, len(res.Grants))
+	for i, grant := range res.Grants {
+		grants[i] = &authz.GrantAuthorization{
+			Granter:       req.Granter,
+			Grantee:       req.Grantee,
+			Authorization: grant.Authorization,
+			Expiration:    grant.Expiration,
+		}
+	}
+
+	return p.packGrants(method, grants, res.Pagination)
+}
+
+// GranterGrants returns the authorizations granted by a granter.
+func (p Precompile) GranterGrants(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranterGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.packGrants(method, res.Grants, res.Pagination)
+}
+
+// GranteeGrants returns the authorizations granted to a grantee.
+func (p Precompile) GranteeGrants(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewGranteeGrantsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.packGrants(method, res.Grants, res.Pagination)
+}
+
+// packGrants converts the grants from a query response and packs them with the
+// pagination response into the method outputs.
+func (p Precompile) packGrants(method *abi.Method, grants []*authz.GrantAuthorization, pageRes *query.PageResponse) ([]byte, error) {
+	out := GrantsOutput{Grants: make([]GrantAuthorization, len(grants))}
+	for i, grant := range grants {
+		var err error
+		out.Grants[i], err = NewGrantAuthorization(grant.Granter, grant.Grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if pageRes != nil {
+		out.PageResponse = *pageRes
+	}
+
+	return out.Pack(method.Outputs)
+}
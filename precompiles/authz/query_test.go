@@ -0,0 +1,147 @@
+package authz_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/precompiles/authz"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestGrantsQueries() {
+	granteeAddr := evmosutiltx.GenerateAddress()
+	delegateMsgTypeURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	testCases := []struct {
+		name        string
+		methodName  string
+		malleate    func() []interface{}
+		expGrants   int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - grants with empty input args",
+			authz.GrantsMethod,
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - granterGrants with zero granter",
+			authz.GranterGrantsMethod,
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			0,
+			true,
+			fmt.Sprintf(authz.ErrInvalidGranter, common.Address{}),
+		},
+		{
+			"success - grants filtered by message type",
+			authz.GrantsMethod,
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), granteeAddr, sendMsgTypeURL, query.PageRequest{}}
+			},
+			1,
+			false,
+			"",
+		},
+		{
+			"success - all grants between granter and grantee",
+			authz.GrantsMethod,
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), granteeAddr, "", query.PageRequest{}}
+			},
+			2,
+			false,
+			"",
+		},
+		{
+			"success - granterGrants",
+			authz.GranterGrantsMethod,
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			1,
+			false,
+			"",
+		},
+		{
+			"success - granteeGrants",
+			authz.GranteeGrantsMethod,
+			func() []interface{} {
+				return []interface{}{granteeAddr, query.PageRequest{}}
+			},
+			2,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			spendLimit := sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 1000))
+			s.Require().NoError(s.network.App.AuthzKeeper.SaveGrant(
+				ctx, granteeAddr.Bytes(), s.keyring.GetAccAddr(0),
+				banktypes.NewSendAuthorization(spendLimit, nil), nil,
+			))
+			s.Require().NoError(s.network.App.AuthzKeeper.SaveGrant(
+				ctx, granteeAddr.Bytes(), s.keyring.GetAccAddr(0),
+				authztypes.NewGenericAuthorization(delegateMsgTypeURL), nil,
+			))
+
+			method := s.precompile.Methods[tc.methodName]
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			var (
+				bz  []byte
+				err error
+			)
+			switch tc.methodName {
+			case authz.GrantsMethod:
+				bz, err = s.precompile.Grants(ctx, contract, &method, tc.malleate())
+			case authz.GranterGrantsMethod:
+				bz, err = s.precompile.GranterGrants(ctx, contract, &method, tc.malleate())
+			case authz.GranteeGrantsMethod:
+				bz, err = s.precompile.GranteeGrants(ctx, contract, &method, tc.malleate())
+			}
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out authz.GrantsOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, tc.methodName, bz))
+			s.Require().Len(out.Grants, tc.expGrants)
+
+			for _, grant := range out.Grants {
+				s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+				s.Require().Equal(granteeAddr, grant.Grantee)
+				if grant.MsgTypeURL == sendMsgTypeURL {
+					s.Require().Equal("/cosmos.bank.v1beta1.SendAuthorization", grant.AuthorizationType)
+					s.Require().Equal(cmn.NewCoinsResponse(spendLimit), grant.SpendLimit)
+				} else {
+					s.Require().Equal(delegateMsgTypeURL, grant.MsgTypeURL)
+					s.Require().Empty(grant.SpendLimit)
+				}
+			}
+		})
+	}
+}
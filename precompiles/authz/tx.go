@@ -0,0 +1,176 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// GrantSendMethod defines the ABI method name for the authz GrantSend transaction.
+	GrantSendMethod = "grantSend"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant grants a generic authorization from the caller to the grantee for the
+// given message type.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, grantee, err := NewMsgGrant(contract.CallerAddress, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, grantee)
+}
+
+// GrantSend grants a send authorization from the caller to the grantee, allowing
+// the grantee to spend up to the given limit on behalf of the caller.
+func (p Precompile) GrantSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, grantee, err := NewMsgGrantSend(contract.CallerAddress, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, grantee)
+}
+
+// Revoke revokes an authorization previously granted by the caller to the grantee.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, grantee, err := NewMsgRevoke(contract.CallerAddress, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.AuthzKeeper.Revoke(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRevokeEvent(ctx, stateDB, contract.CallerAddress, grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes the given messages on behalf of their signers, using the
+// authorizations granted to the caller. Only the message types in AllowedExecMsgs
+// can be executed.
+//
+// NOTE: the messages are executed directly on the Cosmos state, so the changes to
+// the EVM denomination balances of the accounts involved are applied to the StateDB
+// afterwards. Otherwise, the StateDB would overwrite them when committing the EVM state.
+func (p Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB *statedb.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, msgTypeURLs, err := NewMsgExec(p.cdc, contract.CallerAddress, args)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := cmn.NewBalanceSnapshot(ctx, stateDB, p.execAccounts(ctx, msg)...)
+
+	if _, err := p.AuthzKeeper.Exec(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	balances.Sync(ctx)
+
+	if err := p.EmitExecEvent(ctx, stateDB, contract.CallerAddress, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// grant stores the authorization of the given MsgGrant and emits the Grant event.
+func (p Precompile) grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *authz.MsgGrant,
+	grantee common.Address,
+) ([]byte, error) {
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.AuthzKeeper.Grant(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantEvent(ctx, stateDB, contract.CallerAddress, grantee, authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// execAccounts returns the accounts whose balances can be changed by the messages
+// of the given MsgExec. These are the signers of the messages, the recipients of the
+// bank sends and the accounts that receive the delegation rewards, which are
+// withdrawn whenever a delegation changes.
+func (p Precompile) execAccounts(ctx sdk.Context, msg *authz.MsgExec) []common.Address {
+	var addrs []common.Address
+	for _, anyMsg := range msg.Msgs {
+		sdkMsg, ok := anyMsg.GetCachedValue().(sdk.Msg)
+		if !ok {
+			continue
+		}
+
+		switch m := sdkMsg.(type) {
+		case *banktypes.MsgSend:
+			if to, err := sdk.AccAddressFromBech32(m.ToAddress); err == nil {
+				addrs = append(addrs, common.BytesToAddress(to))
+			}
+		case *distributiontypes.MsgSetWithdrawAddress:
+			if withdrawAddr, err := sdk.AccAddressFromBech32(m.WithdrawAddress); err == nil {
+				addrs = append(addrs, common.BytesToAddress(withdrawAddr))
+			}
+		}
+
+		for _, signer := range sdkMsg.GetSigners() {
+			withdrawAddr := p.distributionKeeper.GetDelegatorWithdrawAddr(ctx, signer)
+			addrs = append(addrs, common.BytesToAddress(signer), common.BytesToAddress(withdrawAddr))
+		}
+	}
+
+	return addrs
+}
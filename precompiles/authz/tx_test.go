@@ -0,0 +1,416 @@
+package authz_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/precompiles/authz"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+var (
+	sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	// blockTime is the block time used to validate the grant expirations in the tests.
+	blockTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[authz.GrantMethod]
+	granteeAddr := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - zero grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, sendMsgTypeURL, int64(0)}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(authz.ErrInvalidGrantee, common.Address{}),
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{granteeAddr, sendMsgTypeURL, int64(-1)}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(authz.ErrInvalidExpiration, -1),
+		},
+		{
+			"fail - disabled message type",
+			func() []interface{} {
+				return []interface{}{granteeAddr, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0)}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(authz.ErrDisabledMsgType, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+		},
+		{
+			"fail - unknown message type",
+			func() []interface{} {
+				return []interface{}{granteeAddr, "/cosmos.unknown.v1.MsgUnknown", int64(0)}
+			},
+			func() {},
+			true,
+			"doesn't exist",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{granteeAddr, sendMsgTypeURL, blockTime.Add(-time.Hour).Unix()}
+			},
+			func() {},
+			true,
+			"expiration must be after the current block time",
+		},
+		{
+			"success - generic grant without expiration",
+			func() []interface{} {
+				return []interface{}{granteeAddr, sendMsgTypeURL, int64(0)}
+			},
+			func() {
+				auth, expiration := s.network.App.AuthzKeeper.GetAuthorization(
+					s.network.GetContext(), granteeAddr.Bytes(), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+				)
+				s.Require().NotNil(auth)
+				s.Require().IsType(&authztypes.GenericAuthorization{}, auth)
+				s.Require().Nil(expiration)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			ctx = ctx.WithBlockTime(blockTime)
+			bz, err := s.precompile.Grant(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				s.Require().Len(stateDB.Logs(), 1)
+				s.Require().Equal(s.precompile.Events[authz.EventTypeGrant].ID, stateDB.Logs()[0].Topics[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantSend() {
+	method := s.precompile.Methods[authz.GrantSendMethod]
+	granteeAddr := evmosutiltx.GenerateAddress()
+	allowedAddr := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - empty spend limit",
+			func() []interface{} {
+				return []interface{}{granteeAddr, []cmn.Coin{}, []common.Address{}, int64(0)}
+			},
+			func() {},
+			true,
+			"spend limit cannot be nil",
+		},
+		{
+			"success - send grant with allow list",
+			func() []interface{} {
+				return []interface{}{
+					granteeAddr,
+					[]cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(1e18)}},
+					[]common.Address{allowedAddr},
+					blockTime.Add(time.Hour).Unix(),
+				}
+			},
+			func() {
+				auth, expiration := s.network.App.AuthzKeeper.GetAuthorization(
+					s.network.GetContext(), granteeAddr.Bytes(), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+				)
+				s.Require().NotNil(expiration)
+				sendAuth, ok := auth.(*banktypes.SendAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 1e18)), sendAuth.SpendLimit)
+				s.Require().Equal([]string{sdk.AccAddress(allowedAddr.Bytes()).String()}, sendAuth.AllowList)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			ctx = ctx.WithBlockTime(blockTime)
+			bz, err := s.precompile.GrantSend(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				s.Require().Len(stateDB.Logs(), 1)
+				s.Require().Equal(s.precompile.Events[authz.EventTypeGrant].ID, stateDB.Logs()[0].Topics[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authz.RevokeMethod]
+	granteeAddr := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - grant not found",
+			func() []interface{} {
+				return []interface{}{granteeAddr, sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}
+			},
+			true,
+			"authorization not found",
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{granteeAddr, sendMsgTypeURL}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Require().NoError(s.network.App.AuthzKeeper.SaveGrant(
+				s.network.GetContext(), granteeAddr.Bytes(), s.keyring.GetAccAddr(0),
+				authztypes.NewGenericAuthorization(sendMsgTypeURL), nil,
+			))
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Revoke(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				s.Require().Equal(s.precompile.Events[authz.EventTypeRevoke].ID, stateDB.Logs()[0].Topics[0])
+				auth, _ := s.network.App.AuthzKeeper.GetAuthorization(
+					s.network.GetContext(), granteeAddr.Bytes(), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+				)
+				s.Require().Nil(auth)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	method := s.precompile.Methods[authz.ExecMethod]
+	granteeAddr := evmosutiltx.GenerateAddress()
+	receiverAddr := evmosutiltx.GenerateAddress()
+
+	sendMsg := func(amount int64) string {
+		return fmt.Sprintf(
+			`{"@type":"%s","from_address":"%s","to_address":"%s","amount":[{"denom":"%s","amount":"%d"}]}`,
+			sendMsgTypeURL, s.keyring.GetAccAddr(0), sdk.AccAddress(receiverAddr.Bytes()), s.network.GetDenom(), amount,
+		)
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - no messages",
+			func() []interface{} {
+				return []interface{}{[]string{}}
+			},
+			true,
+			authz.ErrEmptyMsgs,
+		},
+		{
+			"fail - invalid message",
+			func() []interface{} {
+				return []interface{}{[]string{"invalid"}}
+			},
+			true,
+			"invalid message at index 0",
+		},
+		{
+			"fail - message type not allowed",
+			func() []interface{} {
+				return []interface{}{[]string{fmt.Sprintf(
+					`{"@type":"%s","authority":"%s","params":{"send_enabled":[],"default_send_enabled":true}}`,
+					sdk.MsgTypeURL(&banktypes.MsgUpdateParams{}), s.keyring.GetAccAddr(0),
+				)}}
+			},
+			true,
+			fmt.Sprintf(authz.ErrMsgTypeNotAllowed, sdk.MsgTypeURL(&banktypes.MsgUpdateParams{})),
+		},
+		{
+			"fail - spend limit exceeded",
+			func() []interface{} {
+				return []interface{}{[]string{sendMsg(100), sendMsg(1000)}}
+			},
+			true,
+			"requested amount is more than spend limit",
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{[]string{sendMsg(100), sendMsg(200)}}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			spendLimit := sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 1000))
+			s.Require().NoError(s.network.App.AuthzKeeper.SaveGrant(
+				s.network.GetContext(), granteeAddr.Bytes(), s.keyring.GetAccAddr(0),
+				banktypes.NewSendAuthorization(spendLimit, nil), nil,
+			))
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granteeAddr, s.precompile, 200_000)
+
+			bz, err := s.precompile.Exec(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				s.Require().Equal(s.precompile.Events[authz.EventTypeExec].ID, stateDB.Logs()[0].Topics[0])
+				balance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), receiverAddr.Bytes(), s.network.GetDenom())
+				s.Require().Equal(int64(300), balance.Amount.Int64())
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExecSyncsEVMBalances() {
+	s.SetupTest()
+	method := s.precompile.Methods[authz.ExecMethod]
+	granteeAddr := evmosutiltx.GenerateAddress()
+	receiverAddr := evmosutiltx.GenerateAddress()
+	denom := s.network.GetDenom()
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	s.Require().NoError(s.network.App.AuthzKeeper.SaveGrant(
+		s.network.GetContext(), granteeAddr.Bytes(), s.keyring.GetAccAddr(0),
+		banktypes.NewSendAuthorization(spendLimit, nil), nil,
+	))
+
+	bankKeeper := s.network.App.BankKeeper
+	granterBalance := bankKeeper.GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(0), denom)
+	senderBalance := bankKeeper.GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(1), denom)
+
+	// transfer 1 unit to the granter in the EVM before executing the messages
+	stateDB := s.network.GetStateDB()
+	stateDB.SubBalance(s.keyring.GetAddr(1), big.NewInt(1))
+	stateDB.AddBalance(s.keyring.GetAddr(0), big.NewInt(1))
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), granteeAddr, s.precompile, 200_000)
+	sendMsg := fmt.Sprintf(
+		`{"@type":"%s","from_address":"%s","to_address":"%s","amount":[{"denom":"%s","amount":"100"}]}`,
+		sendMsgTypeURL, s.keyring.GetAccAddr(0), sdk.AccAddress(receiverAddr.Bytes()), denom,
+	)
+	_, err := s.precompile.Exec(ctx, contract, stateDB, &method, []interface{}{[]string{sendMsg}})
+	s.Require().NoError(err)
+	s.Require().NoError(stateDB.Commit())
+
+	ctx = s.network.GetContext()
+	s.Require().Equal(
+		granterBalance.Amount.AddRaw(1).SubRaw(100).String(),
+		bankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(0), denom).Amount.String(),
+	)
+	s.Require().Equal(
+		senderBalance.Amount.SubRaw(1).String(),
+		bankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(1), denom).Amount.String(),
+	)
+	s.Require().Equal(int64(100), bankKeeper.GetBalance(ctx, receiverAddr.Bytes(), denom).Amount.Int64())
+}
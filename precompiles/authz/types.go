@@ -0,0 +1,445 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	anteutils "github.com/evmos/evmos/v16/app/ante/utils"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"golang.org/x/exp/slices"
+)
+
+// AllowedExecMsgs defines the Msg type URLs that can be executed through the
+// exec method of the authz precompile.
+var AllowedExecMsgs = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgSetWithdrawAddress{}),
+	sdk.MsgTypeURL(&govv1.MsgVote{}),
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+}
+
+// EventGrant defines the event data for the Grant and GrantSend transactions.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive,stylecheck // name must match the ABI
+}
+
+// EventRevoke defines the event data for the Revoke transaction.
+type EventRevoke struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive,stylecheck // name must match the ABI
+}
+
+// EventExec defines the event data for the Exec transaction.
+type EventExec struct {
+	Grantee     common.Address
+	MsgTypeUrls []string //nolint:revive,stylecheck // name must match the ABI
+}
+
+// GrantAuthorization is a struct to represent the key information from an
+// authorization granted by a granter to a grantee.
+type GrantAuthorization struct {
+	Granter           common.Address   `abi:"granter"`
+	Grantee           common.Address   `abi:"grantee"`
+	MsgTypeURL        string           `abi:"msgTypeUrl"`
+	AuthorizationType string           `abi:"authorizationType"`
+	SpendLimit        []cmn.Coin       `abi:"spendLimit"`
+	AllowList         []common.Address `abi:"allowList"`
+	Expiration        int64            `abi:"expiration"`
+}
+
+// NewGrantAuthorization creates a new GrantAuthorization from a granter,
+// a grantee and the authorization stored in the authz module.
+func NewGrantAuthorization(granter, grantee string, authorization *codectypes.Any, expiration *time.Time) (GrantAuthorization, error) {
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return GrantAuthorization{}, err
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return GrantAuthorization{}, err
+	}
+
+	auth, ok := authorization.GetCachedValue().(authz.Authorization)
+	if !ok {
+		return GrantAuthorization{}, fmt.Errorf(ErrInvalidAuthorization, authorization.TypeUrl)
+	}
+
+	grant := GrantAuthorization{
+		Granter:           common.BytesToAddress(granterAddr),
+		Grantee:           common.BytesToAddress(granteeAddr),
+		MsgTypeURL:        auth.MsgTypeURL(),
+		AuthorizationType: authorization.TypeUrl,
+		SpendLimit:        []cmn.Coin{},
+		AllowList:         []common.Address{},
+	}
+
+	if sendAuth, ok := auth.(*banktypes.SendAuthorization); ok {
+		grant.SpendLimit = cmn.NewCoinsResponse(sendAuth.SpendLimit)
+		for _, allowed := range sendAuth.AllowList {
+			allowedAddr, err := sdk.AccAddressFromBech32(allowed)
+			if err != nil {
+				return GrantAuthorization{}, err
+			}
+			grant.AllowList = append(grant.AllowList, common.BytesToAddress(allowedAddr))
+		}
+	}
+
+	if expiration != nil {
+		grant.Expiration = expiration.Unix()
+	}
+
+	return grant, nil
+}
+
+// GrantSendInput is a struct to represent the input information for the grantSend transaction.
+// Needed to unpack arguments into the Coin struct.
+type GrantSendInput struct {
+	Grantee    common.Address
+	SpendLimit []cmn.Coin
+	AllowList  []common.Address
+	Expiration int64
+}
+
+// GrantsInput is a struct to represent the input information for the grants query.
+// Needed to unpack arguments into the PageRequest struct.
+type GrantsInput struct {
+	Granter     common.Address
+	Grantee     common.Address
+	MsgTypeUrl  string //nolint:revive,stylecheck // name must match the ABI
+	PageRequest query.PageRequest
+}
+
+// GranterGrantsInput is a struct to represent the input information for the granterGrants query.
+// Needed to unpack arguments into the PageRequest struct.
+type GranterGrantsInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// GranteeGrantsInput is a struct to represent the input information for the granteeGrants query.
+// Needed to unpack arguments into the PageRequest struct.
+type GranteeGrantsInput struct {
+	Grantee     common.Address
+	PageRequest query.PageRequest
+}
+
+// GrantsOutput is a struct to represent the key information from
+// a grants, granterGrants or granteeGrants response.
+type GrantsOutput struct {
+	Grants       []GrantAuthorization
+	PageResponse query.PageResponse
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (o *GrantsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(o.Grants, o.PageResponse)
+}
+
+// NewMsgGrant creates a new MsgGrant instance with a generic authorization for
+// the given granter and does sanity checks on the provided arguments.
+func NewMsgGrant(granter common.Address, args []interface{}) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgTypeURL, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "msgTypeUrl", "", args[1])
+	}
+
+	expiration, err := parseExpiration(args[2])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := newMsgGrant(granter, grantee, authz.NewGenericAuthorization(msgTypeURL), expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, grantee, nil
+}
+
+// NewMsgGrantSend creates a new MsgGrant instance with a send authorization for
+// the given granter and does sanity checks on the provided arguments.
+func NewMsgGrantSend(granter common.Address, method *abi.Method, args []interface{}) (*authz.MsgGrant, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantSendInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	spendLimit := sdk.Coins{}
+	for _, coin := range input.SpendLimit {
+		if coin.Amount == nil {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, input.SpendLimit)
+		}
+		spendLimit = spendLimit.Add(coin.ToSDKType())
+	}
+
+	allowList := make([]sdk.AccAddress, len(input.AllowList))
+	for i, addr := range input.AllowList {
+		allowList[i] = addr.Bytes()
+	}
+
+	expiration, err := parseExpiration(input.Expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg, err := newMsgGrant(granter, input.Grantee, banktypes.NewSendAuthorization(spendLimit, allowList), expiration)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance for the given granter and
+// does sanity checks on the provided arguments.
+func NewMsgRevoke(granter common.Address, args []interface{}) (*authz.MsgRevoke, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgTypeURL, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "msgTypeUrl", "", args[1])
+	}
+
+	msg := authz.NewMsgRevoke(granter.Bytes(), grantee.Bytes(), msgTypeURL)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return &msg, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec instance for the given grantee and does sanity
+// checks on the provided arguments. Each message is decoded from its proto JSON
+// representation and must be of an allow-listed type.
+func NewMsgExec(cdc codec.Codec, grantee common.Address, args []interface{}) (*authz.MsgExec, []string, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	jsonMsgs, ok := args[0].([]string)
+	if !ok {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidType, "msgs", []string{}, args[0])
+	}
+
+	if len(jsonMsgs) == 0 {
+		return nil, nil, fmt.Errorf(ErrEmptyMsgs)
+	}
+
+	msgs := make([]sdk.Msg, len(jsonMsgs))
+	typeURLs := make([]string, len(jsonMsgs))
+	for i, jsonMsg := range jsonMsgs {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON([]byte(jsonMsg), &msg); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidMsg, i, err)
+		}
+
+		typeURL := sdk.MsgTypeURL(msg)
+		if err := ValidateExecMsgType(typeURL); err != nil {
+			return nil, nil, err
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, nil, err
+		}
+
+		msgs[i] = msg
+		typeURLs[i] = typeURL
+	}
+
+	msg := authz.NewMsgExec(grantee.Bytes(), msgs)
+	return &msg, typeURLs, nil
+}
+
+// ValidateGrantMsgType returns an error if the given Msg type URL cannot be granted
+// as per the authz limiter rules of the ante handler.
+func ValidateGrantMsgType(msgTypeURL string) error {
+	if slices.Contains(anteutils.DisabledAuthzMsgs, msgTypeURL) {
+		return fmt.Errorf(ErrDisabledMsgType, msgTypeURL)
+	}
+
+	return nil
+}
+
+// ValidateExecMsgType returns an error if the given Msg type URL cannot be executed
+// through the precompile, either because it is disabled in the authz limiter of the
+// ante handler or because it is not allow-listed.
+func ValidateExecMsgType(msgTypeURL string) error {
+	if err := ValidateGrantMsgType(msgTypeURL); err != nil {
+		return err
+	}
+
+	if !slices.Contains(AllowedExecMsgs, msgTypeURL) {
+		return fmt.Errorf(ErrMsgTypeNotAllowed, msgTypeURL)
+	}
+
+	return nil
+}
+
+// NewGrantsRequest creates a new QueryGrantsRequest instance and does sanity
+// checks on the provided arguments.
+func NewGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return &authz.QueryGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: pageRequest(input.PageRequest),
+	}, nil
+}
+
+// NewGranterGrantsRequest creates a new QueryGranterGrantsRequest instance and does sanity
+// checks on the provided arguments.
+func NewGranterGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: pageRequest(input.PageRequest),
+	}, nil
+}
+
+// NewGranteeGrantsRequest creates a new QueryGranteeGrantsRequest instance and does sanity
+// checks on the provided arguments.
+func NewGranteeGrantsRequest(method *abi.Method, args []interface{}) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: pageRequest(input.PageRequest),
+	}, nil
+}
+
+// newMsgGrant creates a new MsgGrant for the given authorization and performs the
+// stateless validation of the message.
+func newMsgGrant(granter, grantee common.Address, authorization authz.Authorization, expiration *time.Time) (*authz.MsgGrant, error) {
+	if err := ValidateGrantMsgType(authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	msg, err := authz.NewMsgGrant(granter.Bytes(), grantee.Bytes(), authorization, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// parseExpiration parses the expiration argument as a unix timestamp in seconds.
+// A zero value is interpreted as no expiration.
+func parseExpiration(arg interface{}) (*time.Time, error) {
+	expiration, ok := arg.(int64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "expiration", int64(0), arg)
+	}
+
+	if expiration == 0 {
+		return nil, nil
+	}
+
+	if expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	}
+
+	t := time.Unix(expiration, 0).UTC()
+	return &t, nil
+}
+
+// pageRequest returns the pagination of a query, removing the empty key
+// placeholder that is used by Solidity callers.
+func pageRequest(req query.PageRequest) *query.PageRequest {
+	if bytes.Equal(req.Key, []byte{0}) {
+		req.Key = nil
+	}
+
+	return &req
+}
@@ -15,12 +15,44 @@ struct Balance {
   uint256 amount;
 }
 
+/// @dev Output specifies the recipient, denomination and amount of a native token transfer.
+struct Output {
+  /// to defines the recipient address.
+  address to;
+  /// denom defines the native token denomination.
+  string denom;
+  /// amount of tokens
+  uint256 amount;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances and supply from the Bank module and for
+ * transferring native tokens.
  */
 interface IBank {
+  /// @dev Emitted for each transfer of a native token that is registered as an ERC20
+  /// token pair. The event is emitted by the ERC20 contract of the token pair.
+  /// @param from the address of the sender
+  /// @param to the address of the recipient
+  /// @param value the amount of tokens transferred
+  event Transfer(address indexed from, address indexed to, uint256 value);
+
+  /// @dev send defines a method for transferring native tokens of the given denomination
+  /// from the caller to the recipient.
+  /// @param to the address of the recipient
+  /// @param denom the native token denomination
+  /// @param amount the amount of tokens to transfer
+  /// @return success true if the transfer was successful
+  function send(address to, string memory denom, uint256 amount) external returns (bool success);
+
+  /// @dev multiSend defines a method for transferring native tokens from the caller to
+  /// multiple recipients in a single transaction.
+  /// @param outputs the list of recipients, denominations and amounts
+  /// @return success true if all the transfers were successful
+  function multiSend(Output[] calldata outputs) external returns (bool success);
+
   /// @dev Balances defines a method for retrieving all the native token balances
   /// for a given account.
   /// @param account the address of the account to query balances for
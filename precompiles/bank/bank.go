@@ -27,6 +27,9 @@ const (
 
 	// GasSupplyOf defines the gas cost for a single ERC-20 supplyOf query, taken from totalSupply of ERC20
 	GasSupplyOf = 2_477
+
+	// GasSend defines the gas cost for a single native token transfer, taken from transfer of ERC20
+	GasSend = 35_000
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -73,6 +76,16 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress(PrecompileAddress)
 }
 
+// PrecompileName returns the name of the bank precompile contract.
+func (Precompile) PrecompileName() string {
+	return "bank"
+}
+
+// PrecompileVersion returns the interface version of the bank precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v2"
+}
+
 // RequiredGas calculates the precompiled contract's base gas rate.
 func (p Precompile) RequiredGas(input []byte) uint64 {
 	methodID := input[:4]
@@ -84,7 +97,7 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 	}
 
 	// NOTE: Charge the amount of gas required for a single ERC-20
-	// balanceOf or totalSupply query
+	// balanceOf or totalSupply query, or for a single transfer
 	switch method.Name {
 	case BalancesMethod:
 		return GasBalanceOf
@@ -92,23 +105,30 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasTotalSupply
 	case SupplyOfMethod:
 		return GasSupplyOf
+	case SendMethod, MultiSendMethod:
+		return GasSend
 	}
 
 	return 0
 }
 
-// Run executes the precompiled contract bank query methods defined in the ABI.
+// Run executes the precompiled contract bank methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
-	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
 	}
 
-	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
 	switch method.Name {
+	// Bank transactions
+	case SendMethod:
+		bz, err = p.Send(ctx, contract, stateDB, method, args)
+	case MultiSendMethod:
+		bz, err = p.MultiSend(ctx, contract, stateDB, method, args)
 	// Bank queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, contract, method, args)
@@ -134,7 +154,11 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// It returns false since all bank methods are queries.
-func (Precompile) IsTransaction(_ string) bool {
-	return false
+func (Precompile) IsTransaction(methodName string) bool {
+	switch methodName {
+	case SendMethod, MultiSendMethod:
+		return true
+	default:
+		return false
+	}
 }
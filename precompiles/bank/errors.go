@@ -0,0 +1,9 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+const (
+	// ErrEmptyOutputs is raised when the multiSend outputs are empty.
+	ErrEmptyOutputs = "outputs cannot be empty"
+)
@@ -0,0 +1,102 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bank
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+const (
+	// SendMethod defines the ABI method name for the bank Send
+	// transaction.
+	SendMethod = "send"
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// Send transfers the given amount of native tokens from the caller to the
+// recipient. It emits an ERC-20 Transfer event if the denomination is
+// registered as a token pair.
+//
+// NOTE: the transfer is executed directly on the Cosmos state, so the changes to
+// the EVM denomination balances are applied to the StateDB afterwards. Otherwise,
+// the StateDB would overwrite them when committing the EVM state.
+func (p Precompile) Send(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB *statedb.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, to, err := NewMsgSend(contract.CallerAddress, args)
+	if err != nil {
+		return nil, err
+	}
+
+	coin := msg.Amount[0]
+
+	balances := cmn.NewBalanceSnapshot(ctx, stateDB, contract.CallerAddress, to)
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err := msgSrv.Send(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	balances.Sync(ctx)
+
+	if err := p.EmitTransferEvent(ctx, stateDB, contract.CallerAddress, to, coin); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// MultiSend transfers native tokens from the caller to multiple recipients.
+// The gas of a single transfer is charged for each output beyond the first one.
+// It emits an ERC-20 Transfer event for each output whose denomination is
+// registered as a token pair.
+func (p Precompile) MultiSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB *statedb.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, outputs, err := NewMsgMultiSend(method, contract.CallerAddress, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: we already charged for a single send so we don't need to charge
+	// for the first output
+	ctx.GasMeter().ConsumeGas(GasSend*uint64(len(outputs)-1), "bank extension multiSend method")
+
+	balances := cmn.NewBalanceSnapshot(ctx, stateDB, contract.CallerAddress)
+	for _, output := range outputs {
+		balances.Add(ctx, output.To)
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err := msgSrv.MultiSend(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	balances.Sync(ctx)
+
+	for _, output := range outputs {
+		coin := sdk.Coin{Denom: output.Denom, Amount: math.NewIntFromBigInt(output.Amount)}
+		if err := p.EmitTransferEvent(ctx, stateDB, contract.CallerAddress, output.To, coin); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
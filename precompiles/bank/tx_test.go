@@ -0,0 +1,205 @@
+package bank_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/precompiles/bank"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+func (s *PrecompileTestSuite) TestSend() {
+	method := s.precompile.Methods[bank.SendMethod]
+	var sender testkeyring.Key
+	receiver := evmosutiltx.GenerateAddress()
+	amount := big.NewInt(1e18)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(stateDB *statedb.StateDB)
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{receiver, s.bondDenom}
+			},
+			func(*statedb.StateDB) {},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - invalid recipient address",
+			func() []interface{} {
+				return []interface{}{"random text", s.bondDenom, amount}
+			},
+			func(*statedb.StateDB) {},
+			false,
+			"invalid type for to",
+		},
+		{
+			"fail - invalid denom",
+			func() []interface{} {
+				return []interface{}{receiver, "", amount}
+			},
+			func(*statedb.StateDB) {},
+			false,
+			"invalid coins",
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{receiver, s.tokenDenom, amount}
+			},
+			func(*statedb.StateDB) {},
+			false,
+			"insufficient funds",
+		},
+		{
+			"pass - send XMPL and emit transfer event",
+			func() []interface{} {
+				s.mintAndSendXMPLCoin(sender.AccAddr, math.NewIntFromBigInt(amount))
+				return []interface{}{receiver, s.tokenDenom, amount}
+			},
+			func(stateDB *statedb.StateDB) {
+				balance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), receiver.Bytes(), s.tokenDenom)
+				s.Require().Equal(amount, balance.Amount.BigInt())
+
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 1)
+				s.Require().Equal(s.xmplAddr, logs[0].Address)
+				s.Require().Equal(s.precompile.ABI.Events[bank.EventTypeTransfer].ID, logs[0].Topics[0])
+				s.Require().Equal(common.BytesToHash(sender.Addr.Bytes()), logs[0].Topics[1])
+				s.Require().Equal(common.BytesToHash(receiver.Bytes()), logs[0].Topics[2])
+				s.Require().Equal(amount, new(big.Int).SetBytes(logs[0].Data))
+			},
+			true,
+			"",
+		},
+		{
+			"pass - send EVM denom and mirror the balances in the StateDB",
+			func() []interface{} {
+				return []interface{}{receiver, s.bondDenom, amount}
+			},
+			func(stateDB *statedb.StateDB) {
+				s.Require().Equal(amount, stateDB.GetBalance(receiver))
+				s.Require().NoError(stateDB.Commit())
+
+				balance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), receiver.Bytes(), s.bondDenom)
+				s.Require().Equal(amount, balance.Amount.BigInt())
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender = s.keyring.GetKey(0)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), sender.Addr, s.precompile, 0)
+
+			bz, err := s.precompile.Send(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expPass {
+				s.Require().NoError(err)
+				success, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+				tc.postCheck(stateDB)
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	method := s.precompile.Methods[bank.MultiSendMethod]
+	var sender testkeyring.Key
+	receivers := []common.Address{evmosutiltx.GenerateAddress(), evmosutiltx.GenerateAddress()}
+	amount := big.NewInt(1e17)
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			"invalid number of arguments",
+		},
+		{
+			"fail - empty outputs",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{}}
+			},
+			false,
+			bank.ErrEmptyOutputs,
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{[]bank.Output{
+					{To: receivers[0], Denom: s.tokenDenom, Amount: amount},
+				}}
+			},
+			false,
+			"insufficient funds",
+		},
+		{
+			"pass - send to multiple recipients",
+			func() []interface{} {
+				s.mintAndSendXMPLCoin(sender.AccAddr, math.NewIntFromBigInt(amount))
+				return []interface{}{[]bank.Output{
+					{To: receivers[0], Denom: s.tokenDenom, Amount: amount},
+					{To: receivers[1], Denom: s.bondDenom, Amount: amount},
+				}}
+			},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender = s.keyring.GetKey(0)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), sender.Addr, s.precompile, 0)
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			_, err := s.precompile.MultiSend(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, uint64(bank.GasSend))
+
+				balance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), receivers[0].Bytes(), s.tokenDenom)
+				s.Require().Equal(amount, balance.Amount.BigInt())
+				s.Require().Len(stateDB.Logs(), 2)
+				s.Require().Equal(amount, stateDB.GetBalance(receivers[1]))
+			} else {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			}
+		})
+	}
+}
@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"math/big"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 )
@@ -18,6 +21,26 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// Output defines the recipient, denomination and amount of a native token
+// transfer of the multiSend method.
+type Output struct {
+	To     common.Address `abi:"to"`
+	Denom  string         `abi:"denom"`
+	Amount *big.Int       `abi:"amount"`
+}
+
+// MultiSendInput defines the input of the multiSend method.
+type MultiSendInput struct {
+	Outputs []Output
+}
+
+// EventTransfer defines the event data for the ERC-20 compatible Transfer events.
+type EventTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -45,3 +68,79 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// NewMsgSend creates a new bank MsgSend instance from the send call arguments,
+// with the given sender address. It also returns the recipient address.
+func NewMsgSend(sender common.Address, args []interface{}) (*banktypes.MsgSend, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	to, ok := args[0].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "to", common.Address{}, args[0])
+	}
+
+	denom, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[1])
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok || amount == nil {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, args[2])
+	}
+
+	coins := sdk.Coins{{Denom: denom, Amount: math.NewIntFromBigInt(amount)}}
+	msg := banktypes.NewMsgSend(sender.Bytes(), to.Bytes(), coins)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, to, nil
+}
+
+// NewMsgMultiSend creates a new bank MsgMultiSend instance from the multiSend
+// call arguments, with the given sender address as the single input. It also
+// returns the parsed outputs.
+func NewMsgMultiSend(method *abi.Method, sender common.Address, args []interface{}) (*banktypes.MsgMultiSend, []Output, error) {
+	if len(args) != 1 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input MultiSendInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to MultiSendInput struct: %s", err)
+	}
+
+	if len(input.Outputs) == 0 {
+		return nil, nil, fmt.Errorf(ErrEmptyOutputs)
+	}
+
+	total := sdk.NewCoins()
+	outputs := make([]banktypes.Output, len(input.Outputs))
+	for i, output := range input.Outputs {
+		if output.Amount == nil {
+			return nil, nil, fmt.Errorf(cmn.ErrInvalidAmount, output.Amount)
+		}
+
+		coin := sdk.Coin{Denom: output.Denom, Amount: math.NewIntFromBigInt(output.Amount)}
+		if err := coin.Validate(); err != nil {
+			return nil, nil, err
+		}
+
+		outputs[i] = banktypes.NewOutput(output.To.Bytes(), sdk.Coins{coin})
+		total = total.Add(coin)
+	}
+
+	msg := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(sender.Bytes(), total)},
+		Outputs: outputs,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+
+	return msg, input.Outputs, nil
+}
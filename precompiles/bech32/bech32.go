@@ -55,6 +55,21 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress(PrecompileAddress)
 }
 
+// PrecompileName returns the name of the bech32 precompile contract.
+func (Precompile) PrecompileName() string {
+	return "bech32"
+}
+
+// PrecompileVersion returns the interface version of the bech32 precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
+// GetABI returns the ABI of the precompiled contract.
+func (p Precompile) GetABI() abi.ABI {
+	return p.ABI
+}
+
 // RequiredGas calculates the contract gas use.
 func (p Precompile) RequiredGas(_ []byte) uint64 {
 	return p.baseGas
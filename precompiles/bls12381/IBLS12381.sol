@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IBLS12381 contract's address.
+address constant BLS12381_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000102;
+
+/// @dev The IBLS12381 contract's instance.
+IBLS12381 constant BLS12381_CONTRACT = IBLS12381(BLS12381_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title BLS12-381 Precompiled Contract
+/// @dev The interface through which solidity contracts can verify BLS12-381 signatures
+/// and perform pairing checks. Points are encoded as per EIP-2537, i.e. 128 bytes for G1
+/// points and 256 bytes for G2 points. Signatures follow the proof of possession scheme
+/// used by the Ethereum beacon chain, with public keys in G1 and signatures in G2.
+/// @custom:address 0x0000000000000000000000000000000000000102
+interface IBLS12381 {
+    /// @dev Verifies a signature over a message.
+    /// @param pubKey The G1 public key of the signer.
+    /// @param message The signed message.
+    /// @param signature The G2 signature.
+    /// @return valid Whether the signature is valid or not.
+    function verify(
+        bytes memory pubKey,
+        bytes memory message,
+        bytes memory signature
+    ) external view returns (bool valid);
+
+    /// @dev Verifies an aggregate signature over multiple messages, each of them
+    /// signed by the public key with the same index.
+    /// @param pubKeys The G1 public keys of the signers.
+    /// @param messages The signed messages.
+    /// @param signature The aggregate G2 signature.
+    /// @return valid Whether the signature is valid or not.
+    function aggregateVerify(
+        bytes[] memory pubKeys,
+        bytes[] memory messages,
+        bytes memory signature
+    ) external view returns (bool valid);
+
+    /// @dev Verifies an aggregate signature over a single message signed by all
+    /// the public keys.
+    /// @param pubKeys The G1 public keys of the signers.
+    /// @param message The signed message.
+    /// @param signature The aggregate G2 signature.
+    /// @return valid Whether the signature is valid or not.
+    function fastAggregateVerify(
+        bytes[] memory pubKeys,
+        bytes memory message,
+        bytes memory signature
+    ) external view returns (bool valid);
+
+    /// @dev Performs the pairing check of EIP-2537.
+    /// @param input The concatenation of the G1 and G2 point pairs (384 bytes each).
+    /// @return success Whether the product of the pairings is equal to the identity.
+    function pairing(
+        bytes memory input
+    ) external view returns (bool success);
+}
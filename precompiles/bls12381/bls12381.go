@@ -0,0 +1,137 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bls12381
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+const (
+	// PrecompileAddress defines the address of the BLS12-381 precompile contract.
+	PrecompileAddress = "0x0000000000000000000000000000000000000102"
+)
+
+// Gas prices of the BLS12-381 operations, as per the EIP-2537 pricing.
+const (
+	// PairingBaseGas is the base gas price of a pairing check.
+	PairingBaseGas uint64 = 37700
+	// PairingPerPairGas is the gas price per point pair of a pairing check.
+	PairingPerPairGas uint64 = 32600
+	// HashToG2Gas is the gas price to hash a message to a G2 point, i.e. two
+	// Fp2 to G2 mappings and one G2 point addition.
+	HashToG2Gas uint64 = 2*23800 + 600
+	// G1AddGas is the gas price of a G1 point addition.
+	G1AddGas uint64 = 375
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for BLS12-381 signature
+// verification and pairing checks.
+type Precompile struct {
+	cmn.Precompile
+}
+
+// NewPrecompile creates a new BLS12-381 Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile() (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI: newABI,
+		},
+	}, nil
+}
+
+// Address defines the address of the BLS12-381 compile contract.
+// address: 0x0000000000000000000000000000000000000102
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// PrecompileName returns the name of the BLS12-381 precompile contract.
+func (Precompile) PrecompileName() string {
+	return "bls12381"
+}
+
+// PrecompileVersion returns the interface version of the BLS12-381 precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
+// RequiredGas calculates the contract gas use, which depends on the number of
+// pairings and hashes to G2 required by the called method.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return PairingBaseGas
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return PairingBaseGas
+	}
+
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		// Run will fail with the same error
+		return PairingBaseGas
+	}
+
+	return requiredGas(method.Name, args)
+}
+
+// Run executes the precompiled contract BLS12-381 methods defined in the ABI.
+func (p Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
+	if len(contract.Input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	methodID := contract.Input[:4]
+	// NOTE: this function iterates over the method map and returns
+	// the method with the given ID
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return nil, err
+	}
+
+	argsBz := contract.Input[4:]
+	args, err := method.Inputs.Unpack(argsBz)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case VerifyMethod:
+		bz, err = p.Verify(method, args)
+	case AggregateVerifyMethod:
+		bz, err = p.AggregateVerify(method, args)
+	case FastAggregateVerifyMethod:
+		bz, err = p.FastAggregateVerify(method, args)
+	case PairingMethod:
+		bz, err = p.Pairing(method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,132 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bls12381_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	bls "github.com/ethereum/go-ethereum/crypto/bls12381"
+	cryptobls "github.com/evmos/evmos/v16/crypto/bls12381"
+	"github.com/evmos/evmos/v16/precompiles/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+// sign returns the EIP-2537 encoded public key and signature of the given secret key over the message.
+func sign(t *testing.T, sk int64, msg []byte) (pubKey, sig []byte) {
+	g1, g2 := bls.NewG1(), bls.NewG2()
+
+	pk := g1.New()
+	g1.MulScalar(pk, g1.One(), big.NewInt(sk))
+
+	h, err := cryptobls.HashToG2(msg, cryptobls.DST)
+	require.NoError(t, err)
+
+	s := g2.New()
+	g2.MulScalar(s, h, big.NewInt(sk))
+
+	return g1.EncodePoint(pk), g2.EncodePoint(s)
+}
+
+func TestRun(t *testing.T) {
+	precompile, err := bls12381.NewPrecompile()
+	require.NoError(t, err)
+	require.Equal(t, bls12381.PrecompileAddress, precompile.Address().String())
+
+	msg := []byte("hello world")
+	pubKey, sig := sign(t, 7, msg)
+	otherPubKey, _ := sign(t, 8, msg)
+
+	g1, g2 := bls.NewG1(), bls.NewG2()
+	pair := append(g1.EncodePoint(g1.One()), g2.EncodePoint(g2.One())...)
+
+	testCases := []struct {
+		name      string
+		method    string
+		args      []interface{}
+		expGas    uint64
+		expResult bool
+		expError  bool
+	}{
+		{
+			"pass - verify valid signature",
+			bls12381.VerifyMethod,
+			[]interface{}{pubKey, msg, sig},
+			bls12381.PairingBaseGas + 2*bls12381.PairingPerPairGas + bls12381.HashToG2Gas,
+			true,
+			false,
+		},
+		{
+			"pass - verify invalid signature",
+			bls12381.VerifyMethod,
+			[]interface{}{otherPubKey, msg, sig},
+			bls12381.PairingBaseGas + 2*bls12381.PairingPerPairGas + bls12381.HashToG2Gas,
+			false,
+			false,
+		},
+		{
+			"pass - fast aggregate verify with single key",
+			bls12381.FastAggregateVerifyMethod,
+			[]interface{}{[][]byte{pubKey}, msg, sig},
+			bls12381.PairingBaseGas + 3*bls12381.PairingPerPairGas + bls12381.HashToG2Gas + bls12381.G1AddGas,
+			true,
+			false,
+		},
+		{
+			"pass - aggregate verify with single key",
+			bls12381.AggregateVerifyMethod,
+			[]interface{}{[][]byte{pubKey}, [][]byte{msg}, sig},
+			bls12381.PairingBaseGas + 2*bls12381.PairingPerPairGas + bls12381.HashToG2Gas,
+			true,
+			false,
+		},
+		{
+			"pass - pairing check not equal to identity",
+			bls12381.PairingMethod,
+			[]interface{}{pair},
+			bls12381.PairingBaseGas + bls12381.PairingPerPairGas,
+			false,
+			false,
+		},
+		{
+			"fail - invalid signature encoding",
+			bls12381.VerifyMethod,
+			[]interface{}{pubKey, msg, sig[1:]},
+			bls12381.PairingBaseGas + 2*bls12381.PairingPerPairGas + bls12381.HashToG2Gas,
+			false,
+			true,
+		},
+		{
+			"fail - invalid pairing input length",
+			bls12381.PairingMethod,
+			[]interface{}{pair[1:]},
+			bls12381.PairingBaseGas,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input, err := precompile.Pack(tc.method, tc.args...)
+			require.NoError(t, err)
+			require.Equal(t, tc.expGas, precompile.RequiredGas(input))
+
+			contract := vm.NewContract(vm.AccountRef(common.Address{}), precompile, common.Big0, tc.expGas)
+			contract.Input = input
+
+			bz, err := precompile.Run(nil, contract, true)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			out, err := precompile.Unpack(tc.method, bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.expResult, out[0])
+		})
+	}
+}
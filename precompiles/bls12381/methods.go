@@ -0,0 +1,179 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package bls12381
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/evmos/evmos/v16/crypto/bls12381"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// VerifyMethod defines the ABI method name to verify a signature.
+	VerifyMethod = "verify"
+	// AggregateVerifyMethod defines the ABI method name to verify an aggregate
+	// signature over multiple messages.
+	AggregateVerifyMethod = "aggregateVerify"
+	// FastAggregateVerifyMethod defines the ABI method name to verify an aggregate
+	// signature over a single message.
+	FastAggregateVerifyMethod = "fastAggregateVerify"
+	// PairingMethod defines the ABI method name to perform a pairing check.
+	PairingMethod = "pairing"
+)
+
+// Verify verifies a G2 signature over a message for a G1 public key.
+func (p Precompile) Verify(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	pubKey, msg, sig, err := parseBytesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := bls12381.Verify(pubKey, msg, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(valid)
+}
+
+// AggregateVerify verifies an aggregate G2 signature over multiple messages, each
+// of them signed by the G1 public key with the same index.
+func (p Precompile) AggregateVerify(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	pubKeys, ok := args[0].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "pubKeys", [][]byte{}, args[0])
+	}
+
+	msgs, ok := args[1].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "messages", [][]byte{}, args[1])
+	}
+
+	sig, ok := args[2].([]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "signature", []byte{}, args[2])
+	}
+
+	valid, err := bls12381.AggregateVerify(pubKeys, msgs, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(valid)
+}
+
+// FastAggregateVerify verifies an aggregate G2 signature over a single message
+// signed by all the G1 public keys.
+func (p Precompile) FastAggregateVerify(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	pubKeys, ok := args[0].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "pubKeys", [][]byte{}, args[0])
+	}
+
+	msg, ok := args[1].([]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "message", []byte{}, args[1])
+	}
+
+	sig, ok := args[2].([]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "signature", []byte{}, args[2])
+	}
+
+	valid, err := bls12381.FastAggregateVerify(pubKeys, msg, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(valid)
+}
+
+// Pairing performs the EIP-2537 pairing check over the concatenation of G1 and G2
+// point pairs.
+func (p Precompile) Pairing(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	input, ok := args[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "input", []byte{}, args[0])
+	}
+
+	success, err := bls12381.Pairing(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(success)
+}
+
+// requiredGas returns the gas required by the given method, based on the number
+// of pairings and hashes to G2 performed on the given arguments.
+func requiredGas(methodName string, args []interface{}) uint64 {
+	switch methodName {
+	case VerifyMethod:
+		return PairingBaseGas + 2*PairingPerPairGas + HashToG2Gas
+	case AggregateVerifyMethod:
+		msgs, _ := args[1].([][]byte)
+		n := uint64(len(msgs))
+		return PairingBaseGas + (n+1)*PairingPerPairGas + n*HashToG2Gas
+	case FastAggregateVerifyMethod:
+		pubKeys, _ := args[0].([][]byte)
+		n := uint64(len(pubKeys))
+		// NOTE: the public keys are subgroup checked, which is priced as a pairing
+		return PairingBaseGas + (n+2)*PairingPerPairGas + HashToG2Gas + n*G1AddGas
+	case PairingMethod:
+		input, _ := args[0].([]byte)
+		return PairingBaseGas + uint64(len(input)/bls12381.PairLength)*PairingPerPairGas
+	default:
+		return PairingBaseGas
+	}
+}
+
+// parseBytesArgs parses the public key, message and signature arguments.
+func parseBytesArgs(args []interface{}) (pubKey, msg, sig []byte, err error) {
+	pubKey, ok := args[0].([]byte)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "pubKey", []byte{}, args[0])
+	}
+
+	msg, ok = args[1].([]byte)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "message", []byte{}, args[1])
+	}
+
+	sig, ok = args[2].([]byte)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "signature", []byte{}, args[2])
+	}
+
+	return pubKey, msg, sig, nil
+}
@@ -0,0 +1,84 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package common
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/x/evm/statedb"
+)
+
+// evmBalance defines the EVM denomination balance of an account in the Cosmos state.
+type evmBalance struct {
+	address common.Address
+	amount  *big.Int
+}
+
+// BalanceSnapshot holds the EVM denomination balances in the Cosmos state of a set
+// of accounts loaded into the StateDB. It is used by the precompiles that change
+// balances directly on the Cosmos state, so that the changes can be mirrored to the
+// StateDB. Otherwise, the StateDB would overwrite them when committing the EVM state.
+type BalanceSnapshot struct {
+	stateDB  *statedb.StateDB
+	balances []evmBalance
+}
+
+// NewBalanceSnapshot loads the given accounts into the StateDB and records their EVM
+// denomination balances in the Cosmos state. The accounts that don't exist yet are
+// skipped: they're loaded later with the balances that result from the execution.
+//
+// NOTE: it must be called before changing the balances, so that the accounts are
+// loaded into the StateDB with the balances prior to the changes.
+func NewBalanceSnapshot(ctx sdk.Context, stateDB *statedb.StateDB, addrs ...common.Address) *BalanceSnapshot {
+	snapshot := &BalanceSnapshot{stateDB: stateDB}
+	snapshot.Add(ctx, addrs...)
+	return snapshot
+}
+
+// Add loads the given accounts into the StateDB and records their EVM denomination
+// balances in the Cosmos state, skipping the ones already recorded.
+func (s *BalanceSnapshot) Add(ctx sdk.Context, addrs ...common.Address) {
+	for _, addr := range addrs {
+		if s.contains(addr) || !s.stateDB.Exist(addr) {
+			continue
+		}
+
+		s.balances = append(s.balances, evmBalance{address: addr, amount: s.cosmosBalance(ctx, addr)})
+	}
+}
+
+// Sync applies to the StateDB the changes of the EVM denomination balances in the
+// Cosmos state since the accounts were recorded.
+func (s *BalanceSnapshot) Sync(ctx sdk.Context) {
+	for _, balance := range s.balances {
+		diff := new(big.Int).Sub(s.cosmosBalance(ctx, balance.address), balance.amount)
+		switch diff.Sign() {
+		case 1:
+			s.stateDB.AddBalance(balance.address, diff)
+		case -1:
+			s.stateDB.SubBalance(balance.address, diff.Neg(diff))
+		}
+	}
+}
+
+// contains returns true if the balance of the given account is recorded.
+func (s *BalanceSnapshot) contains(addr common.Address) bool {
+	for _, balance := range s.balances {
+		if balance.address == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// cosmosBalance returns the EVM denomination balance of the given account in the
+// Cosmos state, which can differ from its balance in the StateDB.
+func (s *BalanceSnapshot) cosmosBalance(ctx sdk.Context, addr common.Address) *big.Int {
+	account := s.stateDB.Keeper().GetAccount(ctx, addr)
+	if account == nil {
+		return new(big.Int)
+	}
+	return account.Balance
+}
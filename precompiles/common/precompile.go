@@ -24,6 +24,11 @@ type Precompile struct {
 	TransientKVGasConfig storetypes.GasConfig
 }
 
+// GetABI returns the ABI of the precompiled contract.
+func (p Precompile) GetABI() abi.ABI {
+	return p.ABI
+}
+
 // RequiredGas calculates the base minimum required gas for a transaction or a query.
 // It uses the method ID to determine if the input is a transaction or a query and
 // uses the Cosmos SDK gas config flat cost and the flat per byte cost * len(argBz) to calculate the gas.
@@ -91,6 +96,12 @@ func (p Precompile) RunSetup(
 		}
 	}
 
+	// branch the context for transactions, so that the state changes of the
+	// precompile are discarded if the EVM execution reverts
+	if isTransaction(method.Name) {
+		ctx = stateDB.BranchContext()
+	}
+
 	initialGas := ctx.GasMeter().GasConsumed()
 
 	defer HandleGasError(ctx, contract, initialGas, &err)()
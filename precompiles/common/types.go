@@ -24,12 +24,19 @@ var (
 	// DefaultPrecompilesBech32 is the standard bech32 address for the precompiles
 	DefaultPrecompilesBech32 = []string{
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqn2svlxe", // secp256r1 curve precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqgp02xt27", // ed25519 curve precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqgzpenayp", // bls12381 curve precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqpqqnqcxyd", // bech32 precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqq4xrkxv", // Staking precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqpgshrm7", // Distribution precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqzxrz44p", // ICS20 transfer precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqrm4kqgn", // Vesting precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqy6vpsfk", // Bank precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzq986495y", // Revenue precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqxffqn6m", // Authz precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzq85l5x8f", // Feegrant precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqgtj86c3", // Randomness precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqfkyn09r", // IBC precompile
 	}
 )
 
@@ -5,8 +5,10 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/evmos/evmos/v16/precompiles/common"
 	"github.com/evmos/evmos/v16/utils"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,3 +47,19 @@ func TestNewDecCoinsResponse(t *testing.T) {
 		require.Equal(t, tc.amount.BigInt(), res[0].Amount)
 	}
 }
+
+func TestDefaultPrecompilesBech32(t *testing.T) {
+	// the stateful precompiles are deployed in the [0x0800, 0x0900) address range
+	start := hexutil.MustDecode("0x0000000000000000000000000000000000000800")
+	end := hexutil.MustDecode("0x0000000000000000000000000000000000000900")
+
+	for _, hexAddr := range evmtypes.AvailableEVMExtensions {
+		addr := hexutil.MustDecode(hexAddr)
+		if string(addr) < string(start) || string(addr) >= string(end) {
+			continue
+		}
+
+		bech32Addr := sdk.MustBech32ifyAddressBytes("evmos", addr)
+		require.Contains(t, common.DefaultPrecompilesBech32, bech32Addr, "precompile %s is not blocked", hexAddr)
+	}
+}
@@ -71,6 +71,25 @@ interface DistributionI {
         uint256 commission
     );
 
+    /// @dev FundCommunityPool defines an Event emitted when an account funds the community pool
+    /// @param depositor the address of the depositor
+    /// @param amount the amount being deposited into the community pool
+    event FundCommunityPool(
+        address indexed depositor,
+        uint256 amount
+    );
+
+    /// @dev DepositValidatorRewardsPool defines an Event emitted when an account deposits
+    /// rewards into the rewards pool of a validator
+    /// @param depositor the address of the depositor
+    /// @param validatorAddress the address of the validator
+    /// @param amount the amount being deposited into the validator rewards pool
+    event DepositValidatorRewardsPool(
+        address indexed depositor,
+        address indexed validatorAddress,
+        uint256 amount
+    );
+
     /// TRANSACTIONS
 
     /// @dev Claims all rewards from a select set of validators or all of them for a delegator.
@@ -82,6 +101,37 @@ interface DistributionI {
         uint32 maxRetrieve
     ) external returns (bool success);
 
+    /// @dev Claims all rewards from a select set of validators or all of them for multiple delegators.
+    /// The caller must be granted an authorization by each delegator to withdraw their rewards.
+    /// @param delegatorAddresses The addresses of the delegators
+    /// @param maxRetrieve The maximum number of validators to claim rewards from for each delegator
+    /// @return success Whether the transaction was successful or not
+    function claimRewardsFor(
+        address[] memory delegatorAddresses,
+        uint32 maxRetrieve
+    ) external returns (bool success);
+
+    /// @dev Deposits the given amount of the bond denomination into the community pool.
+    /// @param depositor The address of the depositor
+    /// @param amount The amount to deposit into the community pool
+    /// @return success Whether the transaction was successful or not
+    function fundCommunityPool(
+        address depositor,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Deposits the given amount of the bond denomination into the rewards pool of a validator.
+    /// The deposit is distributed between the validator and its delegators according to the commission.
+    /// @param depositor The address of the depositor
+    /// @param validatorAddress The address of the validator
+    /// @param amount The amount to deposit into the validator rewards pool
+    /// @return success Whether the transaction was successful or not
+    function depositValidatorRewardsPool(
+        address depositor,
+        string memory validatorAddress,
+        uint256 amount
+    ) external returns (bool success);
+
     /// @dev Change the address, that can withdraw the rewards of a delegator.
     /// Note that this address cannot be a module account.
     /// @param delegatorAddress The address of the delegator
@@ -194,4 +244,7 @@ interface DistributionI {
         address delegatorAddress
     ) external view returns (string memory withdrawAddress);
 
+    /// @dev Queries the amount of coins in the community pool.
+    /// @return coins The coins in the community pool
+    function communityPool() external view returns (DecCoin[] calldata coins);
 }
@@ -12,6 +12,7 @@ import (
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -31,6 +32,7 @@ type Precompile struct {
 	cmn.Precompile
 	distributionKeeper distributionkeeper.Keeper
 	stakingKeeper      stakingkeeper.Keeper
+	bankKeeper         bankkeeper.Keeper
 }
 
 // NewPrecompile creates a new distribution Precompile instance as a
@@ -38,6 +40,7 @@ type Precompile struct {
 func NewPrecompile(
 	distributionKeeper distributionkeeper.Keeper,
 	stakingKeeper stakingkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 ) (*Precompile, error) {
 	abiBz, err := f.ReadFile("abi.json")
@@ -60,6 +63,7 @@ func NewPrecompile(
 		},
 		stakingKeeper:      stakingKeeper,
 		distributionKeeper: distributionKeeper,
+		bankKeeper:         bankKeeper,
 	}, nil
 }
 
@@ -69,6 +73,16 @@ func (p Precompile) Address() common.Address {
 	return common.HexToAddress("0x0000000000000000000000000000000000000801")
 }
 
+// PrecompileName returns the name of the distribution precompile contract.
+func (Precompile) PrecompileName() string {
+	return "distribution"
+}
+
+// PrecompileVersion returns the interface version of the distribution precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v2"
+}
+
 // RequiredGas calculates the precompiled contract's base gas rate.
 func (p Precompile) RequiredGas(input []byte) uint64 {
 	methodID := input[:4]
@@ -97,6 +111,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// Custom transactions
 	case ClaimRewardsMethod:
 		bz, err = p.ClaimRewards(ctx, evm.Origin, contract, stateDB, method, args)
+	case ClaimRewardsForMethod:
+		bz, err = p.ClaimRewardsFor(ctx, evm.Origin, contract, stateDB, method, args)
 	// Distribution transactions
 	case SetWithdrawAddressMethod:
 		bz, err = p.SetWithdrawAddress(ctx, evm.Origin, contract, stateDB, method, args)
@@ -104,6 +120,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.WithdrawDelegatorRewards(ctx, evm.Origin, contract, stateDB, method, args)
 	case WithdrawValidatorCommissionMethod:
 		bz, err = p.WithdrawValidatorCommission(ctx, evm.Origin, contract, stateDB, method, args)
+	case FundCommunityPoolMethod:
+		bz, err = p.FundCommunityPool(ctx, evm.Origin, contract, stateDB, method, args)
+	case DepositValidatorRewardsPoolMethod:
+		bz, err = p.DepositValidatorRewardsPool(ctx, evm.Origin, contract, stateDB, method, args)
 	// Distribution queries
 	case ValidatorDistributionInfoMethod:
 		bz, err = p.ValidatorDistributionInfo(ctx, contract, method, args)
@@ -121,6 +141,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.DelegatorValidators(ctx, contract, method, args)
 	case DelegatorWithdrawAddressMethod:
 		bz, err = p.DelegatorWithdrawAddress(ctx, contract, method, args)
+	case CommunityPoolMethod:
+		bz, err = p.CommunityPool(ctx, contract, method, args)
 	}
 
 	if err != nil {
@@ -140,15 +162,21 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available distribution transactions are:
 //   - ClaimRewards
+//   - ClaimRewardsFor
 //   - SetWithdrawAddress
 //   - WithdrawDelegatorRewards
 //   - WithdrawValidatorCommission
+//   - FundCommunityPool
+//   - DepositValidatorRewardsPool
 func (Precompile) IsTransaction(methodName string) bool {
 	switch methodName {
 	case ClaimRewardsMethod,
+		ClaimRewardsForMethod,
 		SetWithdrawAddressMethod,
 		WithdrawDelegatorRewardsMethod,
-		WithdrawValidatorCommissionMethod:
+		WithdrawValidatorCommissionMethod,
+		FundCommunityPoolMethod,
+		DepositValidatorRewardsPoolMethod:
 		return true
 	default:
 		return false
@@ -11,4 +11,10 @@ const (
 	ErrWithdrawValCommissionAuth = "withdraw validator commission authorization for address %s does not exist"
 	// ErrDifferentValidator is raised when the origin address is not the same as the validator address.
 	ErrDifferentValidator = "origin address %s is not the same as validator address %s"
+	// ErrInvalidDelegators is raised when the given delegator addresses are invalid or empty.
+	ErrInvalidDelegators = "invalid delegator addresses: %v"
+	// ErrInvalidDepositor is raised when the given depositor address is invalid.
+	ErrInvalidDepositor = "invalid depositor address: %v"
+	// ErrValidatorNotFound is raised when the given validator does not exist.
+	ErrValidatorNotFound = "validator %s not found"
 )
@@ -843,3 +843,53 @@ func (s *PrecompileTestSuite) TestDelegatorWithdrawAddress() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestCommunityPool() {
+	method := s.precompile.Methods[distribution.CommunityPoolMethod]
+
+	testCases := []distrTestCases{
+		{
+			"success - community pool funded",
+			func() []interface{} {
+				coins := sdk.NewCoins(sdk.NewCoin(s.bondDenom, math.NewInt(1e18)))
+				err := s.app.DistrKeeper.FundCommunityPool(s.ctx, coins, s.address.Bytes())
+				s.Require().NoError(err)
+				return []interface{}{}
+			},
+			func(bz []byte) {
+				var out []cmn.DecCoin
+				err := s.precompile.UnpackIntoInterface(&out, distribution.CommunityPoolMethod, bz)
+				s.Require().NoError(err, "failed to unpack output", err)
+
+				expPool := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+				s.Require().Len(out, len(expPool))
+				for i, coin := range expPool {
+					s.Require().Equal(coin.Denom, out[i].Denom)
+					s.Require().Equal(coin.Amount.TruncateInt().BigInt(), out[i].Amount)
+				}
+				s.Require().True(expPool.AmountOf(s.bondDenom).GTE(math.LegacyNewDec(1e18)))
+			},
+			100000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), tc.gas)
+
+			bz, err := s.precompile.CommunityPool(s.ctx, contract, &method, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
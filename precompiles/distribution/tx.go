@@ -14,6 +14,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/vm"
 )
@@ -30,8 +31,20 @@ const (
 	WithdrawValidatorCommissionMethod = "withdrawValidatorCommission"
 	// ClaimRewardsMethod defines the ABI method name for the custom ClaimRewards transaction
 	ClaimRewardsMethod = "claimRewards"
+	// ClaimRewardsForMethod defines the ABI method name for the custom ClaimRewardsFor transaction
+	ClaimRewardsForMethod = "claimRewardsFor"
+	// FundCommunityPoolMethod defines the ABI method name for the distribution
+	// FundCommunityPool transaction.
+	FundCommunityPoolMethod = "fundCommunityPool"
+	// DepositValidatorRewardsPoolMethod defines the ABI method name for the distribution
+	// DepositValidatorRewardsPool transaction.
+	DepositValidatorRewardsPoolMethod = "depositValidatorRewardsPool"
 )
 
+// WithdrawDelegatorRewardsMsgURL defines the authorization type for the distribution
+// WithdrawDelegatorReward message, required to claim rewards on behalf of a delegator.
+var WithdrawDelegatorRewardsMsgURL = sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{})
+
 // ClaimRewards claims the rewards accumulated by a delegator from multiple or all validators.
 func (p Precompile) ClaimRewards(
 	ctx sdk.Context,
@@ -53,25 +66,137 @@ func (p Precompile) ClaimRewards(
 		return nil, fmt.Errorf(cmn.ErrDifferentOrigin, origin.String(), delegatorAddr.String())
 	}
 
-	validators := p.stakingKeeper.GetDelegatorValidators(ctx, delegatorAddr.Bytes(), maxRetrieve)
-	totalCoins := sdk.Coins{}
-	for _, validator := range validators {
-		// Convert the validator operator address into an ValAddress
-		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+	totalCoins, err := p.withdrawAllRewards(ctx, stateDB.(*statedb.StateDB), delegatorAddr, maxRetrieve)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitClaimRewardsEvent(ctx, stateDB, delegatorAddr, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ClaimRewardsFor claims the rewards accumulated by multiple delegators from multiple or all validators.
+// The contract caller must have been granted an authorization to withdraw the delegation rewards
+// by every delegator, unless the delegator is the caller or the origin.
+func (p Precompile) ClaimRewardsFor(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddrs, maxRetrieve, err := parseClaimRewardsForArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delegatorAddr := range delegatorAddrs {
+		if delegatorAddr != contract.CallerAddress && delegatorAddr != origin {
+			msgAuthz, _ := p.AuthzKeeper.GetAuthorization(ctx, contract.CallerAddress.Bytes(), delegatorAddr.Bytes(), WithdrawDelegatorRewardsMsgURL)
+			if msgAuthz == nil {
+				return nil, fmt.Errorf(ErrWithdrawDelRewardsAuth, delegatorAddr)
+			}
+		}
+
+		totalCoins, err := p.withdrawAllRewards(ctx, stateDB.(*statedb.StateDB), delegatorAddr, maxRetrieve)
 		if err != nil {
 			return nil, err
 		}
 
-		// Withdraw the rewards for each validator address
-		coins, err := p.distributionKeeper.WithdrawDelegationRewards(ctx, delegatorAddr.Bytes(), valAddr)
-		if err != nil {
+		if err := p.EmitClaimRewardsEvent(ctx, stateDB, delegatorAddr, totalCoins); err != nil {
 			return nil, err
 		}
+	}
 
-		totalCoins = totalCoins.Add(coins...)
+	return method.Outputs.Pack(true)
+}
+
+// FundCommunityPool deposits the given amount of the bond denomination into the community pool.
+func (p Precompile) FundCommunityPool(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositorHexAddr, err := NewMsgFundCommunityPool(args, p.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
 	}
 
-	if err := p.EmitClaimRewardsEvent(ctx, stateDB, delegatorAddr, totalCoins); err != nil {
+	// If the contract is the depositor, we don't need an origin check
+	// Otherwise check if the origin matches the depositor address
+	isContractDepositor := contract.CallerAddress == depositorHexAddr
+	if !isContractDepositor && origin != depositorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrDifferentOrigin, origin.String(), depositorHexAddr.String())
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// The depositor account is loaded before the transfer so that it's loaded with its balance prior
+	// to the transfer. Only the EVM denomination is mirrored, which can differ from the bond denomination.
+	balances := cmn.NewBalanceSnapshot(ctx, stateDB.(*statedb.StateDB), depositorHexAddr)
+
+	msgSrv := distributionkeeper.NewMsgServerImpl(p.distributionKeeper)
+	if _, err = msgSrv.FundCommunityPool(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	balances.Sync(ctx)
+
+	if err = p.EmitFundCommunityPoolEvent(ctx, stateDB, depositorHexAddr, msg.Amount[0]); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// DepositValidatorRewardsPool deposits the given amount of the bond denomination into the
+// rewards pool of a validator. The deposit is split between the validator commission and
+// the delegator rewards.
+func (p Precompile) DepositValidatorRewardsPool(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	depositorHexAddr, valAddr, coin, err := parseDepositValidatorRewardsPoolArgs(args, p.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the depositor, we don't need an origin check
+	// Otherwise check if the origin matches the depositor address
+	isContractDepositor := contract.CallerAddress == depositorHexAddr
+	if !isContractDepositor && origin != depositorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrDifferentOrigin, origin.String(), depositorHexAddr.String())
+	}
+
+	validator := p.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil, fmt.Errorf(ErrValidatorNotFound, valAddr)
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// The depositor account is loaded before the transfer so that it's loaded with its balance prior
+	// to the transfer. Only the EVM denomination is mirrored, which can differ from the bond denomination.
+	balances := cmn.NewBalanceSnapshot(ctx, stateDB.(*statedb.StateDB), depositorHexAddr)
+
+	if err := p.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorHexAddr.Bytes(), distributiontypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return nil, err
+	}
+
+	balances.Sync(ctx)
+
+	p.distributionKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(coin))
+
+	if err = p.EmitDepositValidatorRewardsPoolEvent(ctx, stateDB, depositorHexAddr, valAddr, coin); err != nil {
 		return nil, err
 	}
 
@@ -184,3 +309,41 @@ func (p Precompile) WithdrawValidatorCommission(
 
 	return method.Outputs.Pack(cmn.NewCoinsResponse(res.Amount))
 }
+
+// withdrawAllRewards withdraws the rewards of a delegator from up to maxRetrieve of its validators
+// and returns the total amount withdrawn.
+//
+// NOTE: the rewards are paid to the withdraw address of the delegator in the bank keeper, so the
+// change of its balance is mirrored to the EVM stateDB. This prevents the stateDB from overwriting
+// it when committing the EVM state if the account is already loaded.
+func (p Precompile) withdrawAllRewards(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	delegatorAddr common.Address,
+	maxRetrieve uint32,
+) (sdk.Coins, error) {
+	withdrawAddr := p.distributionKeeper.GetDelegatorWithdrawAddr(ctx, delegatorAddr.Bytes())
+	balances := cmn.NewBalanceSnapshot(ctx, stateDB, delegatorAddr, common.BytesToAddress(withdrawAddr))
+
+	validators := p.stakingKeeper.GetDelegatorValidators(ctx, delegatorAddr.Bytes(), maxRetrieve)
+	totalCoins := sdk.Coins{}
+	for _, validator := range validators {
+		// Convert the validator operator address into an ValAddress
+		valAddr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		// Withdraw the rewards for each validator address
+		coins, err := p.distributionKeeper.WithdrawDelegationRewards(ctx, delegatorAddr.Bytes(), valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		totalCoins = totalCoins.Add(coins...)
+	}
+
+	balances.Sync(ctx)
+
+	return totalCoins, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 	"github.com/evmos/evmos/v16/precompiles/distribution"
@@ -416,3 +417,376 @@ func (s *PrecompileTestSuite) TestClaimRewards() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestClaimRewardsFor() {
+	method := s.precompile.Methods[distribution.ClaimRewardsForMethod]
+	grantee := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() (common.Address, []interface{})
+		postCheck   func(data []byte)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() (common.Address, []interface{}) {
+				return s.address, []interface{}{}
+			},
+			func(data []byte) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty delegators",
+			func() (common.Address, []interface{}) {
+				return s.address, []interface{}{
+					[]common.Address{},
+					uint32(2),
+				}
+			},
+			func(data []byte) {},
+			200000,
+			true,
+			"invalid delegator addresses",
+		},
+		{
+			"fail - caller without authorization from the delegator",
+			func() (common.Address, []interface{}) {
+				return grantee, []interface{}{
+					[]common.Address{s.address},
+					uint32(2),
+				}
+			},
+			func(data []byte) {},
+			200000,
+			true,
+			"withdraw delegation rewards authorization for address",
+		},
+		{
+			"success - caller is the delegator",
+			func() (common.Address, []interface{}) {
+				return s.address, []interface{}{
+					[]common.Address{s.address},
+					uint32(2),
+				}
+			},
+			func(data []byte) {
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(balance.Amount.BigInt(), big.NewInt(7e18))
+			},
+			20000,
+			false,
+			"",
+		},
+		{
+			"success - caller authorized by the delegator",
+			func() (common.Address, []interface{}) {
+				err := s.app.AuthzKeeper.SaveGrant(
+					s.ctx,
+					grantee.Bytes(),
+					s.address.Bytes(),
+					authz.NewGenericAuthorization(distribution.WithdrawDelegatorRewardsMsgURL),
+					nil,
+				)
+				s.Require().NoError(err)
+
+				return grantee, []interface{}{
+					[]common.Address{s.address},
+					uint32(2),
+				}
+			},
+			func(data []byte) {
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(balance.Amount.BigInt(), big.NewInt(7e18))
+			},
+			20000,
+			false,
+			"",
+		},
+		{
+			"success - rewards are mirrored to the delegator loaded in the stateDB",
+			func() (common.Address, []interface{}) {
+				err := s.app.AuthzKeeper.SaveGrant(
+					s.ctx,
+					grantee.Bytes(),
+					s.address.Bytes(),
+					authz.NewGenericAuthorization(distribution.WithdrawDelegatorRewardsMsgURL),
+					nil,
+				)
+				s.Require().NoError(err)
+
+				// the delegator receives 1 unit in the EVM before the claim
+				s.stateDB.AddBalance(s.address, big.NewInt(1))
+
+				return grantee, []interface{}{
+					[]common.Address{s.address},
+					uint32(2),
+				}
+			},
+			func(data []byte) {
+				s.Require().Equal(big.NewInt(7e18+1), s.stateDB.GetBalance(s.address))
+				s.Require().NoError(s.stateDB.Commit())
+
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(big.NewInt(7e18+1), balance.Amount.BigInt())
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			caller, args := tc.malleate()
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, caller, s.precompile, tc.gas)
+
+			// Distribute rewards to the 2 validators, 1 EVMOS each
+			for _, val := range s.validators {
+				coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(1e18)))
+				s.app.DistrKeeper.AllocateTokensToValidator(s.ctx, val, sdk.NewDecCoinsFromCoins(coins...))
+			}
+
+			bz, err := s.precompile.ClaimRewardsFor(s.ctx, caller, contract, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestFundCommunityPool() {
+	method := s.precompile.Methods[distribution.FundCommunityPoolMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(data []byte) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid depositor address",
+			func() []interface{} {
+				return []interface{}{
+					"",
+					big.NewInt(1e18),
+				}
+			},
+			func(data []byte) {},
+			200000,
+			true,
+			fmt.Sprintf(distribution.ErrInvalidDepositor, ""),
+		},
+		{
+			"fail - depositor is not the origin",
+			func() []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(),
+					big.NewInt(1e18),
+				}
+			},
+			func(data []byte) {},
+			200000,
+			true,
+			"does not match the delegator address",
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+					big.NewInt(6e18),
+				}
+			},
+			func(data []byte) {},
+			200000,
+			true,
+			"insufficient funds",
+		},
+		{
+			"success - fund the community pool",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+					big.NewInt(1e18),
+				}
+			},
+			func(data []byte) {
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(balance.Amount.BigInt(), big.NewInt(4e18))
+				s.Require().Equal(s.stateDB.GetBalance(s.address), big.NewInt(4e18))
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, s.address, s.precompile, tc.gas)
+
+			poolBefore := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).AmountOf(utils.BaseDenom)
+
+			bz, err := s.precompile.FundCommunityPool(s.ctx, s.address, contract, s.stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				poolAfter := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).AmountOf(utils.BaseDenom)
+				s.Require().Equal(poolBefore.Add(math.LegacyNewDec(1e18)), poolAfter)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDepositValidatorRewardsPool() {
+	method := s.precompile.Methods[distribution.DepositValidatorRewardsPoolMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(operatorAddress string) []interface{}
+		postCheck   func(operatorAddress string)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(string) []interface{} {
+				return []interface{}{}
+			},
+			func(string) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid validator address",
+			func(string) []interface{} {
+				return []interface{}{
+					s.address,
+					"invalid",
+					big.NewInt(1e18),
+				}
+			},
+			func(string) {},
+			200000,
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"fail - zero amount",
+			func(operatorAddress string) []interface{} {
+				return []interface{}{
+					s.address,
+					operatorAddress,
+					big.NewInt(0),
+				}
+			},
+			func(string) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidAmount, big.NewInt(0)),
+		},
+		{
+			"fail - depositor is not the origin",
+			func(operatorAddress string) []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(),
+					operatorAddress,
+					big.NewInt(1e18),
+				}
+			},
+			func(string) {},
+			200000,
+			true,
+			"does not match the delegator address",
+		},
+		{
+			"fail - validator not found",
+			func(string) []interface{} {
+				return []interface{}{
+					s.address,
+					sdk.ValAddress(utiltx.GenerateAddress().Bytes()).String(),
+					big.NewInt(1e18),
+				}
+			},
+			func(string) {},
+			200000,
+			true,
+			"not found",
+		},
+		{
+			"success - deposit into the validator rewards pool",
+			func(operatorAddress string) []interface{} {
+				return []interface{}{
+					s.address,
+					operatorAddress,
+					big.NewInt(1e18),
+				}
+			},
+			func(operatorAddress string) {
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(balance.Amount.BigInt(), big.NewInt(4e18))
+				s.Require().Equal(s.stateDB.GetBalance(s.address), big.NewInt(4e18))
+
+				valAddr, err := sdk.ValAddressFromBech32(operatorAddress)
+				s.Require().NoError(err)
+				outstanding := s.app.DistrKeeper.GetValidatorOutstandingRewardsCoins(s.ctx, valAddr)
+				s.Require().Equal(math.LegacyNewDec(1e18), outstanding.AmountOf(utils.BaseDenom))
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, s.address, s.precompile, tc.gas)
+
+			operatorAddress := s.validators[0].OperatorAddress
+			_, err := s.precompile.DepositValidatorRewardsPool(s.ctx, s.address, contract, s.stateDB, &method, tc.malleate(operatorAddress))
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(operatorAddress)
+			}
+		})
+	}
+}
@@ -41,6 +41,19 @@ type EventClaimRewards struct {
 	Amount           *big.Int
 }
 
+// EventFundCommunityPool defines the event data for the FundCommunityPool transaction.
+type EventFundCommunityPool struct {
+	Depositor common.Address
+	Amount    *big.Int
+}
+
+// EventDepositValidatorRewardsPool defines the event data for the DepositValidatorRewardsPool transaction.
+type EventDepositValidatorRewardsPool struct {
+	Depositor        common.Address
+	ValidatorAddress common.Address
+	Amount           *big.Int
+}
+
 // parseClaimRewardsArgs parses the arguments for the ClaimRewards method.
 func parseClaimRewardsArgs(args []interface{}) (common.Address, uint32, error) {
 	if len(args) != 2 {
@@ -60,6 +73,88 @@ func parseClaimRewardsArgs(args []interface{}) (common.Address, uint32, error) {
 	return delegatorAddress, maxRetrieve, nil
 }
 
+// parseClaimRewardsForArgs parses the arguments for the ClaimRewardsFor method.
+func parseClaimRewardsForArgs(args []interface{}) ([]common.Address, uint32, error) {
+	if len(args) != 2 {
+		return nil, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegatorAddresses, ok := args[0].([]common.Address)
+	if !ok || len(delegatorAddresses) == 0 {
+		return nil, 0, fmt.Errorf(ErrInvalidDelegators, args[0])
+	}
+
+	for _, delegatorAddress := range delegatorAddresses {
+		if delegatorAddress == (common.Address{}) {
+			return nil, 0, fmt.Errorf(cmn.ErrInvalidDelegator, delegatorAddress)
+		}
+	}
+
+	maxRetrieve, ok := args[1].(uint32)
+	if !ok {
+		return nil, 0, fmt.Errorf(cmn.ErrInvalidType, "maxRetrieve", uint32(0), args[1])
+	}
+
+	return delegatorAddresses, maxRetrieve, nil
+}
+
+// NewMsgFundCommunityPool creates a new MsgFundCommunityPool instance.
+func NewMsgFundCommunityPool(args []interface{}, denom string) (*distributiontypes.MsgFundCommunityPool, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	depositorAddress, ok := args[0].(common.Address)
+	if !ok || depositorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDepositor, args[0])
+	}
+
+	amount, ok := args[1].(*big.Int)
+	if !ok || amount == nil {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, args[1])
+	}
+
+	msg := &distributiontypes.MsgFundCommunityPool{
+		Depositor: sdk.AccAddress(depositorAddress.Bytes()).String(),
+		Amount:    sdk.Coins{{Denom: denom, Amount: math.NewIntFromBigInt(amount)}},
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, depositorAddress, nil
+}
+
+// parseDepositValidatorRewardsPoolArgs parses the arguments for the DepositValidatorRewardsPool method.
+func parseDepositValidatorRewardsPoolArgs(args []interface{}, denom string) (common.Address, sdk.ValAddress, sdk.Coin, error) {
+	if len(args) != 3 {
+		return common.Address{}, nil, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	depositorAddress, ok := args[0].(common.Address)
+	if !ok || depositorAddress == (common.Address{}) {
+		return common.Address{}, nil, sdk.Coin{}, fmt.Errorf(ErrInvalidDepositor, args[0])
+	}
+
+	validatorAddress, ok := args[1].(string)
+	if !ok {
+		return common.Address{}, nil, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidType, "validatorAddress", "", args[1])
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return common.Address{}, nil, sdk.Coin{}, err
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return common.Address{}, nil, sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidAmount, args[2])
+	}
+
+	return depositorAddress, valAddr, sdk.Coin{Denom: denom, Amount: math.NewIntFromBigInt(amount)}, nil
+}
+
 // NewMsgSetWithdrawAddress creates a new MsgSetWithdrawAddress instance.
 func NewMsgSetWithdrawAddress(args []interface{}) (*distributiontypes.MsgSetWithdrawAddress, common.Address, error) {
 	if len(args) != 2 {
@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ed25519
+
+import (
+	"crypto/ed25519"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+const (
+	// VerifyBaseGas is the base gas price of the Ed25519 signature verification.
+	VerifyBaseGas uint64 = 2000
+	// VerifyPerWordGas is the gas price per 32 byte word of the signed message.
+	VerifyPerWordGas uint64 = 12
+	// MinInputLength defines the minimum input length (96 bytes), i.e. the
+	// length of the input for an empty message.
+	MinInputLength = ed25519.PublicKeySize + ed25519.SignatureSize
+)
+
+// PrecompileAddress defines the hex address of the ed25519 precompiled contract.
+const PrecompileAddress = "0x0000000000000000000000000000000000000101"
+
+// Precompile Ed25519 signature verification implemented as a native contract,
+// as used by Solana and NEAR among others. See https://eips.ethereum.org/EIPS/eip-665
+// for a similar proposal with a fixed message length.
+type Precompile struct{}
+
+// Address defines the address of the ed25519 precompiled contract.
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// PrecompileName returns the name of the ed25519 precompile contract.
+func (Precompile) PrecompileName() string {
+	return "ed25519"
+}
+
+// PrecompileVersion returns the interface version of the ed25519 precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
+// RequiredGas returns the gas required to execute the precompiled contract,
+// which depends on the length of the signed message.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	msgLen := 0
+	if len(input) > MinInputLength {
+		msgLen = len(input) - MinInputLength
+	}
+
+	return VerifyBaseGas + uint64((msgLen+31)/32)*VerifyPerWordGas
+}
+
+// Run executes the Ed25519 signature verification.
+//
+// Input data: at least 96 bytes of data including:
+//   - 32 bytes of the public key
+//   - 64 bytes of the signature
+//   - the signed message, of arbitrary length
+//
+// Output data: 32 bytes of result data and error
+//   - If the signature verification process succeeds, it returns 1 in 32 bytes format
+func (p *Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) (bz []byte, err error) {
+	input := contract.Input
+	// Check the input length
+	if len(input) < MinInputLength {
+		// Input length is invalid
+		return nil, nil
+	}
+
+	// Extract the public key, signature and message from the input
+	pubKey := ed25519.PublicKey(input[:ed25519.PublicKeySize])
+	sig := input[ed25519.PublicKeySize:MinInputLength]
+	msg := input[MinInputLength:]
+
+	// Verify the ed25519 signature
+	if ed25519.Verify(pubKey, msg, sig) {
+		// Signature is valid
+		return common.LeftPadBytes(common.Big1.Bytes(), 32), nil
+	}
+
+	// Signature is invalid
+	return nil, nil
+}
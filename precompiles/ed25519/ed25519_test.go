@@ -0,0 +1,84 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ed25519_test
+
+import (
+	stded25519 "crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v16/precompiles/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+var trueValue = common.LeftPadBytes(common.Big1.Bytes(), 32)
+
+func TestRequiredGas(t *testing.T) {
+	precompile := &ed25519.Precompile{}
+
+	require.Equal(t, ed25519.VerifyBaseGas, precompile.RequiredGas(nil))
+	require.Equal(t, ed25519.VerifyBaseGas, precompile.RequiredGas(make([]byte, ed25519.MinInputLength)))
+	require.Equal(t, ed25519.VerifyBaseGas+2*ed25519.VerifyPerWordGas, precompile.RequiredGas(make([]byte, ed25519.MinInputLength+33)))
+}
+
+func TestRun(t *testing.T) {
+	pubKey, privKey, err := stded25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	msg := []byte("hello world")
+	sig := stded25519.Sign(privKey, msg)
+
+	otherPubKey, _, err := stded25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		input   []byte
+		expPass bool
+	}{
+		{
+			"pass - valid signature",
+			append(append(append([]byte{}, pubKey...), sig...), msg...),
+			true,
+		},
+		{
+			"pass - valid signature over empty message",
+			append(append([]byte{}, pubKey...), stded25519.Sign(privKey, nil)...),
+			true,
+		},
+		{
+			"fail - invalid signature",
+			append(append(append([]byte{}, otherPubKey...), sig...), msg...),
+			false,
+		},
+		{
+			"fail - wrong message",
+			append(append(append([]byte{}, pubKey...), sig...), []byte("hello")...),
+			false,
+		},
+		{
+			"fail - invalid input length",
+			append([]byte{}, pubKey...),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			precompile := &ed25519.Precompile{}
+			contract := vm.NewContract(vm.AccountRef(common.Address{}), precompile, common.Big0, precompile.RequiredGas(tc.input))
+			contract.Input = tc.input
+
+			bz, err := precompile.Run(nil, contract, true)
+			require.NoError(t, err)
+
+			if tc.expPass {
+				require.Equal(t, trueValue, bz)
+			} else {
+				require.Empty(t, bz)
+			}
+		})
+	}
+}
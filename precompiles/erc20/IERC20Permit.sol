@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC20 Permit Interface
+ * @dev Interface for the ERC20 Permit extension allowing approvals to be made via signatures,
+ * as defined in https://eips.ethereum.org/EIPS/eip-2612.
+ */
+interface IERC20Permit {
+    /** @dev Sets value as the allowance of spender over owner's tokens, given owner's signed approval.
+      * The signature must use the owner's current nonce and follow the EIP-712 typed structured data
+      * format: Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline).
+      * @param owner The address which owns the funds.
+      * @param spender The address which will spend the funds.
+      * @param value The amount of tokens approved to be spent.
+      * @param deadline The timestamp in seconds until which the signature is valid.
+      * @param v The recovery byte of the signature.
+      * @param r Half of the ECDSA signature pair.
+      * @param s Half of the ECDSA signature pair.
+    */
+    function permit(
+        address owner,
+        address spender,
+        uint256 value,
+        uint256 deadline,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /** @dev Returns the current nonce for owner. This value must be included whenever a signature
+      * is generated for permit.
+      * @param owner The address of the account.
+      * @return nonce The current nonce of the account.
+    */
+    function nonces(address owner) external view returns (uint256 nonce);
+
+    /** @dev Returns the domain separator used in the encoding of the signature for permit,
+      * as defined by EIP-712.
+      * @return domainSeparator The EIP-712 domain separator of the token.
+    */
+    // solhint-disable-next-line func-name-mixedcase
+    function DOMAIN_SEPARATOR() external view returns (bytes32 domainSeparator);
+}
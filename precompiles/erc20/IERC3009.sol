@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC20 Transfer With Authorization Interface
+ * @dev Interface for transfers authorized via signatures, as defined in
+ * https://eips.ethereum.org/EIPS/eip-3009.
+ */
+interface IERC3009 {
+    /** @dev Emitted when an authorization is used.
+      * @param authorizer The address of the authorizer.
+      * @param nonce The unique nonce of the authorization.
+    */
+    event AuthorizationUsed(address indexed authorizer, bytes32 indexed nonce);
+
+    /** @dev Executes a transfer with a signed authorization. The signature must follow the
+      * EIP-712 typed structured data format: TransferWithAuthorization(address from,address to,
+      * uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce).
+      * @param from The address of the payer.
+      * @param to The address of the payee.
+      * @param value The amount of tokens to be transferred.
+      * @param validAfter The timestamp in seconds after which the authorization is valid.
+      * @param validBefore The timestamp in seconds before which the authorization is valid.
+      * @param nonce The unique nonce of the authorization.
+      * @param v The recovery byte of the signature.
+      * @param r Half of the ECDSA signature pair.
+      * @param s Half of the ECDSA signature pair.
+    */
+    function transferWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /** @dev Receives a transfer with a signed authorization from the payer. The caller must be
+      * the payee, which prevents front-running attacks. The signature must follow the EIP-712
+      * typed structured data format: ReceiveWithAuthorization(address from,address to,
+      * uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce).
+      * @param from The address of the payer.
+      * @param to The address of the payee.
+      * @param value The amount of tokens to be transferred.
+      * @param validAfter The timestamp in seconds after which the authorization is valid.
+      * @param validBefore The timestamp in seconds before which the authorization is valid.
+      * @param nonce The unique nonce of the authorization.
+      * @param v The recovery byte of the signature.
+      * @param r Half of the ECDSA signature pair.
+      * @param s Half of the ECDSA signature pair.
+    */
+    function receiveWithAuthorization(
+        address from,
+        address to,
+        uint256 value,
+        uint256 validAfter,
+        uint256 validBefore,
+        bytes32 nonce,
+        uint8 v,
+        bytes32 r,
+        bytes32 s
+    ) external;
+
+    /** @dev Returns the state of an authorization.
+      * @param authorizer The address of the authorizer.
+      * @param nonce The unique nonce of the authorization.
+      * @return used True if the nonce has already been used.
+    */
+    function authorizationState(address authorizer, bytes32 nonce) external view returns (bool used);
+}
@@ -44,41 +44,7 @@ func (p Precompile) Approve(
 		return nil, err
 	}
 
-	grantee := spender
-	granter := contract.CallerAddress
-
-	// NOTE: We do not support approvals if the grantee is the granter.
-	// This is different from the ERC20 standard but there is no reason to
-	// do so, since in that case the grantee can just transfer the tokens
-	// without authorization.
-	if bytes.Equal(grantee.Bytes(), granter.Bytes()) {
-		return nil, ErrSpenderIsOwner
-	}
-
-	// TODO: owner should be the owner of the contract
-	authorization, expiration, _ := auth.CheckAuthzExists(ctx, p.AuthzKeeper, grantee, granter, SendMsgURL) //#nosec:G703 -- we are handling the error case (authorization == nil) in the switch statement below
-
-	switch {
-	case authorization == nil && amount != nil && amount.Sign() < 0:
-		// case 1: no authorization, amount 0 or negative -> error
-		err = ErrNegativeAmount
-	case authorization == nil && amount != nil && amount.Sign() > 0:
-		// case 2: no authorization, amount positive -> create a new authorization
-		err = p.createAuthorization(ctx, grantee, granter, amount)
-	case authorization != nil && amount != nil && amount.Sign() <= 0:
-		// case 3: authorization exists, amount 0 or negative -> remove from spend limit and delete authorization if no spend limit left
-		err = p.removeSpendLimitOrDeleteAuthorization(ctx, grantee, granter, authorization, expiration)
-	case authorization != nil && amount != nil && amount.Sign() > 0:
-		// case 4: authorization exists, amount positive -> update authorization
-		sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
-		if !ok {
-			return nil, authz.ErrUnknownAuthorizationType
-		}
-
-		err = p.updateAuthorization(ctx, grantee, granter, amount, sendAuthz, expiration)
-	}
-
-	if err != nil {
+	if err := p.approve(ctx, contract.CallerAddress, spender, amount); err != nil {
 		return nil, err
 	}
 
@@ -220,6 +186,48 @@ func (p Precompile) DecreaseAllowance(
 	return method.Outputs.Pack(true)
 }
 
+// approve sets the given amount as the allowance of the spender address over
+// the owner's tokens, which is stored as an authz SendAuthorization granted by
+// the owner to the spender.
+func (p Precompile) approve(ctx sdk.Context, owner, spender common.Address, amount *big.Int) error {
+	grantee := spender
+	granter := owner
+
+	// NOTE: We do not support approvals if the grantee is the granter.
+	// This is different from the ERC20 standard but there is no reason to
+	// do so, since in that case the grantee can just transfer the tokens
+	// without authorization.
+	if bytes.Equal(grantee.Bytes(), granter.Bytes()) {
+		return ErrSpenderIsOwner
+	}
+
+	// TODO: owner should be the owner of the contract
+	authorization, expiration, _ := auth.CheckAuthzExists(ctx, p.AuthzKeeper, grantee, granter, SendMsgURL) //#nosec:G703 -- we are handling the error case (authorization == nil) in the switch statement below
+
+	var err error
+	switch {
+	case authorization == nil && amount != nil && amount.Sign() < 0:
+		// case 1: no authorization, amount 0 or negative -> error
+		err = ErrNegativeAmount
+	case authorization == nil && amount != nil && amount.Sign() > 0:
+		// case 2: no authorization, amount positive -> create a new authorization
+		err = p.createAuthorization(ctx, grantee, granter, amount)
+	case authorization != nil && amount != nil && amount.Sign() <= 0:
+		// case 3: authorization exists, amount 0 or negative -> remove from spend limit and delete authorization if no spend limit left
+		err = p.removeSpendLimitOrDeleteAuthorization(ctx, grantee, granter, authorization, expiration)
+	case authorization != nil && amount != nil && amount.Sign() > 0:
+		// case 4: authorization exists, amount positive -> update authorization
+		sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+		if !ok {
+			return authz.ErrUnknownAuthorizationType
+		}
+
+		err = p.updateAuthorization(ctx, grantee, granter, amount, sendAuthz, expiration)
+	}
+
+	return err
+}
+
 func (p Precompile) createAuthorization(ctx sdk.Context, grantee, granter common.Address, amount *big.Int) error {
 	if amount.BitLen() > sdkmath.MaxBitLen {
 		return fmt.Errorf(ErrIntegerOverflow, amount)
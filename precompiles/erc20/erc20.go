@@ -36,6 +36,15 @@ const (
 	GasTotalSupply       = 2_477
 	GasBalanceOf         = 2_851
 	GasAllowance         = 3_246
+	// GasPermit accounts for the signature recovery, the nonce update and the
+	// approval.
+	GasPermit = 55_000
+	// GasTransferWithAuthorization accounts for the signature recovery and the
+	// authorization state update on top of the transfer.
+	GasTransferWithAuthorization = GasTransfer + 25_000
+	GasNonces                    = 2_600
+	GasDomainSeparator           = 3_800
+	GasAuthorizationState        = 2_600
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -85,6 +94,16 @@ func (p Precompile) Address() common.Address {
 	return p.tokenPair.GetERC20Contract()
 }
 
+// PrecompileName returns the name of the ERC-20 precompile contract.
+func (Precompile) PrecompileName() string {
+	return "erc20"
+}
+
+// PrecompileVersion returns the interface version of the ERC-20 precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v2"
+}
+
 // RequiredGas calculates the contract gas used for the
 func (p Precompile) RequiredGas(input []byte) uint64 {
 	// Validate input length
@@ -126,6 +145,19 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case auth.AllowanceMethod:
 		return GasAllowance
+	// ERC-2612 and ERC-3009 transactions
+	case PermitMethod:
+		return GasPermit
+	case TransferWithAuthorizationMethod,
+		ReceiveWithAuthorizationMethod:
+		return GasTransferWithAuthorization
+	// ERC-2612 and ERC-3009 queries
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
+	case AuthorizationStateMethod:
+		return GasAuthorizationState
 	default:
 		return 0
 	}
@@ -163,7 +195,10 @@ func (Precompile) IsTransaction(methodName string) bool {
 		TransferFromMethod,
 		auth.ApproveMethod,
 		auth.IncreaseAllowanceMethod,
-		auth.DecreaseAllowanceMethod:
+		auth.DecreaseAllowanceMethod,
+		PermitMethod,
+		TransferWithAuthorizationMethod,
+		ReceiveWithAuthorizationMethod:
 		return true
 	default:
 		return false
@@ -203,6 +238,20 @@ func (p Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case auth.AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	// ERC-2612 and ERC-3009 transactions
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
+	case TransferWithAuthorizationMethod:
+		bz, err = p.TransferWithAuthorization(ctx, contract, stateDB, method, args)
+	case ReceiveWithAuthorizationMethod:
+		bz, err = p.ReceiveWithAuthorization(ctx, contract, stateDB, method, args)
+	// ERC-2612 and ERC-3009 queries
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
+	case AuthorizationStateMethod:
+		bz, err = p.AuthorizationState(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
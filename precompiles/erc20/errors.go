@@ -37,6 +37,17 @@ var (
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
 	ErrTransferAmountExceedsBalance = errors.New("ERC20: transfer amount exceeds balance")
+
+	// ERC20 permit errors
+	ErrPermitExpiredDeadline = errors.New("ERC20Permit: expired deadline")
+	ErrPermitInvalidSigner   = errors.New("ERC20Permit: invalid signature")
+
+	// ERC3009 errors
+	ErrAuthorizationNotYetValid      = errors.New("ERC3009: authorization is not yet valid")
+	ErrAuthorizationExpired          = errors.New("ERC3009: authorization is expired")
+	ErrAuthorizationUsed             = errors.New("ERC3009: authorization is used")
+	ErrAuthorizationInvalidSignature = errors.New("ERC3009: invalid signature")
+	ErrCallerMustBePayee             = errors.New("ERC3009: caller must be the payee")
 )
 
 // BuildExecRevertedErr returns a mocked error that should align with the
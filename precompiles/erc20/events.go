@@ -20,6 +20,9 @@ import (
 const (
 	// EventTypeTransfer defines the event type for the ERC-20 Transfer and TransferFrom transactions.
 	EventTypeTransfer = "Transfer"
+	// EventTypeAuthorizationUsed defines the event type for the ERC-3009 TransferWithAuthorization
+	// and ReceiveWithAuthorization transactions.
+	EventTypeAuthorizationUsed = "AuthorizationUsed"
 )
 
 // EmitTransferEvent creates a new Transfer event emitted on transfer and transferFrom transactions.
@@ -94,3 +97,31 @@ func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner
 
 	return nil
 }
+
+// EmitAuthorizationUsedEvent creates a new AuthorizationUsed event emitted on
+// TransferWithAuthorization and ReceiveWithAuthorization transactions.
+func (p Precompile) EmitAuthorizationUsedEvent(ctx sdk.Context, stateDB vm.StateDB, authorizer common.Address, nonce common.Hash) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeAuthorizationUsed]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(authorizer)
+	if err != nil {
+		return err
+	}
+
+	topics[2] = nonce
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        []byte{},
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
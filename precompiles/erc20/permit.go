@@ -0,0 +1,356 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmostypes "github.com/evmos/evmos/v16/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the ERC-2612 permit
+	// transaction.
+	PermitMethod = "permit"
+	// TransferWithAuthorizationMethod defines the ABI method name for the
+	// ERC-3009 transferWithAuthorization transaction.
+	TransferWithAuthorizationMethod = "transferWithAuthorization"
+	// ReceiveWithAuthorizationMethod defines the ABI method name for the
+	// ERC-3009 receiveWithAuthorization transaction.
+	ReceiveWithAuthorizationMethod = "receiveWithAuthorization"
+	// NoncesMethod defines the ABI method name for the ERC-2612 nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the ERC-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+	// AuthorizationStateMethod defines the ABI method name for the ERC-3009
+	// authorizationState query.
+	AuthorizationStateMethod = "authorizationState"
+
+	// EIP712Version defines the version of the EIP-712 signing domain of the
+	// ERC-20 precompiles.
+	EIP712Version = "1"
+)
+
+var (
+	// EIP712DomainTypeHash is the EIP-712 type hash of the signing domain.
+	EIP712DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// PermitTypeHash is the EIP-712 type hash of the ERC-2612 permit message.
+	PermitTypeHash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+	// TransferWithAuthorizationTypeHash is the EIP-712 type hash of the ERC-3009
+	// transferWithAuthorization message.
+	TransferWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte("TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+	// ReceiveWithAuthorizationTypeHash is the EIP-712 type hash of the ERC-3009
+	// receiveWithAuthorization message.
+	ReceiveWithAuthorizationTypeHash = crypto.Keccak256Hash([]byte("ReceiveWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+)
+
+// Prefixes of the storage slots of the precompile account, used to keep track
+// of the signed approvals and transfers.
+var (
+	noncesPrefix             = []byte{0x01}
+	authorizationStatePrefix = []byte{0x02}
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given the owner's EIP-712 signed approval. As with the Approve method,
+// the allowance is stored as an authorization granted by the owner to the spender.
+// It increases the owner's nonce and emits the Approval event on success.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	permit, err := NewPermitArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if permit.Deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, ErrPermitExpiredDeadline
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.getNonce(stateDB, permit.Owner)
+	structHash := crypto.Keccak256Hash(
+		PermitTypeHash.Bytes(),
+		common.LeftPadBytes(permit.Owner.Bytes(), 32),
+		common.LeftPadBytes(permit.Spender.Bytes(), 32),
+		common.BigToHash(permit.Value).Bytes(),
+		common.BigToHash(nonce).Bytes(),
+		common.BigToHash(permit.Deadline).Bytes(),
+	)
+
+	if !isValidSignature(domainSeparator, structHash, permit.Owner, permit.V, permit.R, permit.S) {
+		return nil, ErrPermitInvalidSigner
+	}
+
+	p.setNonce(stateDB, permit.Owner, new(big.Int).Add(nonce, common.Big1))
+
+	if err := p.approve(ctx, permit.Owner, permit.Spender, permit.Value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, permit.Owner, permit.Spender, permit.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// TransferWithAuthorization executes a transfer from the payer to the payee,
+// given the payer's EIP-712 signed authorization. Each authorization nonce can
+// only be used once. It emits the AuthorizationUsed and Transfer events on success.
+func (p Precompile) TransferWithAuthorization(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorization, err := NewTransferWithAuthorizationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.transferWithAuthorization(ctx, stateDB, TransferWithAuthorizationTypeHash, authorization); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// ReceiveWithAuthorization executes a transfer from the payer to the caller,
+// given the payer's EIP-712 signed authorization. The caller must be the payee
+// to prevent front-running the authorization from the mempool.
+func (p Precompile) ReceiveWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorization, err := NewTransferWithAuthorizationArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.CallerAddress != authorization.To {
+		return nil, ErrCallerMustBePayee
+	}
+
+	if err := p.transferWithAuthorization(ctx, stateDB, ReceiveWithAuthorizationTypeHash, authorization); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current ERC-2612 permit nonce of the given owner.
+func (p Precompile) Nonces(
+	_ sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.getNonce(stateDB, owner))
+}
+
+// DomainSeparator returns the EIP-712 domain separator of the token, which is
+// derived from the token name, the chain ID and the precompile address.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(domainSeparator)
+}
+
+// AuthorizationState returns true if the given ERC-3009 authorization nonce of
+// the authorizer has already been used.
+func (p Precompile) AuthorizationState(
+	_ sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorizer, nonce, err := ParseAuthorizationStateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(p.isAuthorizationUsed(stateDB, authorizer, nonce))
+}
+
+// transferWithAuthorization validates the signed authorization for the given
+// type hash, marks its nonce as used and executes the bank transfer.
+func (p Precompile) transferWithAuthorization(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	typeHash common.Hash,
+	authorization *TransferWithAuthorizationArgs,
+) error {
+	now := big.NewInt(ctx.BlockTime().Unix())
+	if now.Cmp(authorization.ValidAfter) <= 0 {
+		return ErrAuthorizationNotYetValid
+	}
+
+	if now.Cmp(authorization.ValidBefore) >= 0 {
+		return ErrAuthorizationExpired
+	}
+
+	if p.isAuthorizationUsed(stateDB, authorization.From, authorization.Nonce) {
+		return ErrAuthorizationUsed
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return err
+	}
+
+	structHash := crypto.Keccak256Hash(
+		typeHash.Bytes(),
+		common.LeftPadBytes(authorization.From.Bytes(), 32),
+		common.LeftPadBytes(authorization.To.Bytes(), 32),
+		common.BigToHash(authorization.Value).Bytes(),
+		common.BigToHash(authorization.ValidAfter).Bytes(),
+		common.BigToHash(authorization.ValidBefore).Bytes(),
+		authorization.Nonce[:],
+	)
+
+	if !isValidSignature(domainSeparator, structHash, authorization.From, authorization.V, authorization.R, authorization.S) {
+		return ErrAuthorizationInvalidSignature
+	}
+
+	p.setAuthorizationUsed(stateDB, authorization.From, authorization.Nonce)
+
+	if err := p.EmitAuthorizationUsedEvent(ctx, stateDB, authorization.From, authorization.Nonce); err != nil {
+		return err
+	}
+
+	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(authorization.Value)}}
+	msg := banktypes.NewMsgSend(authorization.From.Bytes(), authorization.To.Bytes(), coins)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err := msgSrv.Send(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return ConvertErrToERC20Error(err)
+	}
+
+	return p.EmitTransferEvent(ctx, stateDB, authorization.From, authorization.To, authorization.Value)
+}
+
+// domainSeparator returns the EIP-712 domain separator of the token.
+func (p Precompile) domainSeparator(ctx sdk.Context) (common.Hash, error) {
+	name, err := p.getName(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	chainID, err := evmostypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(
+		EIP712DomainTypeHash.Bytes(),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(EIP712Version)),
+		common.BigToHash(chainID).Bytes(),
+		common.LeftPadBytes(p.Address().Bytes(), 32),
+	), nil
+}
+
+// getNonce returns the permit nonce of the owner from the precompile storage.
+func (p Precompile) getNonce(stateDB vm.StateDB, owner common.Address) *big.Int {
+	return stateDB.GetState(p.Address(), noncesKey(owner)).Big()
+}
+
+// setNonce stores the permit nonce of the owner in the precompile storage.
+func (p Precompile) setNonce(stateDB vm.StateDB, owner common.Address, nonce *big.Int) {
+	stateDB.SetState(p.Address(), noncesKey(owner), common.BigToHash(nonce))
+}
+
+// isAuthorizationUsed returns true if the authorization nonce of the authorizer
+// is marked as used in the precompile storage.
+func (p Precompile) isAuthorizationUsed(stateDB vm.StateDB, authorizer common.Address, nonce [32]byte) bool {
+	return stateDB.GetState(p.Address(), authorizationStateKey(authorizer, nonce)) != (common.Hash{})
+}
+
+// setAuthorizationUsed marks the authorization nonce of the authorizer as used
+// in the precompile storage.
+func (p Precompile) setAuthorizationUsed(stateDB vm.StateDB, authorizer common.Address, nonce [32]byte) {
+	stateDB.SetState(p.Address(), authorizationStateKey(authorizer, nonce), common.BigToHash(common.Big1))
+}
+
+// noncesKey returns the storage slot of the permit nonce of the owner.
+func noncesKey(owner common.Address) common.Hash {
+	return crypto.Keccak256Hash(noncesPrefix, owner.Bytes())
+}
+
+// authorizationStateKey returns the storage slot of the state of the
+// authorization nonce of the authorizer.
+func authorizationStateKey(authorizer common.Address, nonce [32]byte) common.Hash {
+	return crypto.Keccak256Hash(authorizationStatePrefix, authorizer.Bytes(), nonce[:])
+}
+
+// isValidSignature returns true if the given signature of the EIP-712 typed
+// data hash, built from the domain separator and the struct hash, was signed
+// by the signer. Malleable signatures with a high s value are rejected.
+func isValidSignature(domainSeparator, structHash common.Hash, signer common.Address, v uint8, r, s [32]byte) bool {
+	if v != 27 && v != 28 {
+		return false
+	}
+
+	recoveryID := v - 27
+	if !crypto.ValidateSignatureValues(recoveryID, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return false
+	}
+
+	digest := crypto.Keccak256([]byte("\x19\x01"), domainSeparator.Bytes(), structHash.Bytes())
+
+	signature := make([]byte, 0, crypto.SignatureLength)
+	signature = append(signature, r[:]...)
+	signature = append(signature, s[:]...)
+	signature = append(signature, recoveryID)
+
+	pubKey, err := crypto.SigToPub(digest, signature)
+	if err != nil {
+		return false
+	}
+
+	return crypto.PubkeyToAddress(*pubKey) == signer
+}
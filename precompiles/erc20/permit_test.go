@@ -0,0 +1,430 @@
+package erc20_test
+
+import (
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/evmos/v16/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v16/precompiles/erc20"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	erc20types "github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+var (
+	// authorizationTypes are the EIP-712 types of the ERC-3009 messages.
+	authorizationTypes = []apitypes.Type{
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "validAfter", Type: "uint256"},
+		{Name: "validBefore", Type: "uint256"},
+		{Name: "nonce", Type: "bytes32"},
+	}
+	// blockTime is the block time used to check the validity of the signed messages.
+	blockTime = time.Unix(1_700_000_000, 0)
+)
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	owner := s.keyring.GetKey(0)
+	spender := s.keyring.GetKey(1)
+	amount := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		malleate    func(deadline *big.Int) []interface{}
+		postCheck   func(stateDB vm.StateDB)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(_ *big.Int) []interface{} {
+				return []interface{}{owner.Addr, spender.Addr, amount}
+			},
+			func(vm.StateDB) {},
+			true,
+			"invalid number of arguments",
+		},
+		{
+			"fail - expired deadline",
+			func(deadline *big.Int) []interface{} {
+				expired := new(big.Int).Sub(deadline, big.NewInt(7200))
+				return s.permitArgs(owner.Priv, owner.Addr, spender.Addr, amount, common.Big0, expired)
+			},
+			func(vm.StateDB) {},
+			true,
+			erc20.ErrPermitExpiredDeadline.Error(),
+		},
+		{
+			"fail - signed by another account",
+			func(deadline *big.Int) []interface{} {
+				return s.permitArgs(spender.Priv, owner.Addr, spender.Addr, amount, common.Big0, deadline)
+			},
+			func(vm.StateDB) {},
+			true,
+			erc20.ErrPermitInvalidSigner.Error(),
+		},
+		{
+			"fail - invalid nonce",
+			func(deadline *big.Int) []interface{} {
+				return s.permitArgs(owner.Priv, owner.Addr, spender.Addr, amount, common.Big1, deadline)
+			},
+			func(vm.StateDB) {},
+			true,
+			erc20.ErrPermitInvalidSigner.Error(),
+		},
+		{
+			"fail - spender is owner",
+			func(deadline *big.Int) []interface{} {
+				return s.permitArgs(owner.Priv, owner.Addr, owner.Addr, amount, common.Big0, deadline)
+			},
+			func(vm.StateDB) {},
+			true,
+			erc20.ErrSpenderIsOwner.Error(),
+		},
+		{
+			"pass - create allowance",
+			func(deadline *big.Int) []interface{} {
+				return s.permitArgs(owner.Priv, owner.Addr, spender.Addr, amount, common.Big0, deadline)
+			},
+			func(stateDB vm.StateDB) {
+				s.requireSendAuthz(
+					spender.AccAddr, owner.AccAddr,
+					sdk.NewCoins(sdk.NewCoin(s.tokenDenom, math.NewIntFromBigInt(amount))),
+					nil,
+				)
+				s.requireNonce(stateDB, owner.Addr, common.Big1)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setupTokenMetadata()
+			stateDB := s.network.GetStateDB()
+
+			// NOTE: anyone can submit the signed permit on behalf of the owner
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext().WithBlockTime(blockTime), spender.Addr, s.precompile, 0)
+			deadline := big.NewInt(ctx.BlockTime().Unix() + 3600)
+
+			_, err := s.precompile.Permit(ctx, contract, stateDB, &method, tc.malleate(deadline))
+			if tc.expErr {
+				s.Require().Error(err, "expected permit transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected permit transaction to fail with specific error")
+			} else {
+				s.Require().NoError(err, "expected permit transaction to succeed")
+				tc.postCheck(stateDB)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestPermitReplay() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	owner := s.keyring.GetKey(0)
+	spender := s.keyring.GetKey(1)
+
+	s.setupTokenMetadata()
+	stateDB := s.network.GetStateDB()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext().WithBlockTime(blockTime), spender.Addr, s.precompile, 0)
+	deadline := big.NewInt(ctx.BlockTime().Unix() + 3600)
+
+	args := s.permitArgs(owner.Priv, owner.Addr, spender.Addr, big.NewInt(100), common.Big0, deadline)
+	_, err := s.precompile.Permit(ctx, contract, stateDB, &method, args)
+	s.Require().NoError(err, "expected permit transaction to succeed")
+
+	_, err = s.precompile.Permit(ctx, contract, stateDB, &method, args)
+	s.Require().ErrorContains(err, erc20.ErrPermitInvalidSigner.Error(), "expected replayed permit to fail")
+}
+
+func (s *PrecompileTestSuite) TestTransferWithAuthorization() {
+	owner := s.keyring.GetKey(0)
+	caller := s.keyring.GetKey(1)
+	amount := big.NewInt(100)
+	nonce := common.BytesToHash(crypto.Keccak256([]byte("nonce")))
+
+	testcases := []struct {
+		name        string
+		method      string
+		malleate    func(now int64) []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - not yet valid",
+			erc20.TransferWithAuthorizationMethod,
+			func(now int64) []interface{} {
+				return s.authorizationArgs(erc20.TransferWithAuthorizationMethod, owner.Priv, owner.Addr, toAddr, amount, now, now+3600, nonce)
+			},
+			true,
+			erc20.ErrAuthorizationNotYetValid.Error(),
+		},
+		{
+			"fail - expired",
+			erc20.TransferWithAuthorizationMethod,
+			func(now int64) []interface{} {
+				return s.authorizationArgs(erc20.TransferWithAuthorizationMethod, owner.Priv, owner.Addr, toAddr, amount, 0, now, nonce)
+			},
+			true,
+			erc20.ErrAuthorizationExpired.Error(),
+		},
+		{
+			"fail - signed by another account",
+			erc20.TransferWithAuthorizationMethod,
+			func(now int64) []interface{} {
+				return s.authorizationArgs(erc20.TransferWithAuthorizationMethod, caller.Priv, owner.Addr, toAddr, amount, 0, now+3600, nonce)
+			},
+			true,
+			erc20.ErrAuthorizationInvalidSignature.Error(),
+		},
+		{
+			"fail - receive authorization used for transfer",
+			erc20.TransferWithAuthorizationMethod,
+			func(now int64) []interface{} {
+				return s.authorizationArgs(erc20.ReceiveWithAuthorizationMethod, owner.Priv, owner.Addr, toAddr, amount, 0, now+3600, nonce)
+			},
+			true,
+			erc20.ErrAuthorizationInvalidSignature.Error(),
+		},
+		{
+			"fail - not enough balance",
+			erc20.TransferWithAuthorizationMethod,
+			func(now int64) []interface{} {
+				return s.authorizationArgs(erc20.TransferWithAuthorizationMethod, owner.Priv, owner.Addr, toAddr, big.NewInt(2e18), 0, now+3600, nonce)
+			},
+			true,
+			erc20.ErrTransferAmountExceedsBalance.Error(),
+		},
+		{
+			"fail - receive with caller not being the payee",
+			erc20.ReceiveWithAuthorizationMethod,
+			func(now int64) []interface{} {
+				return s.authorizationArgs(erc20.ReceiveWithAuthorizationMethod, owner.Priv, owner.Addr, toAddr, amount, 0, now+3600, nonce)
+			},
+			true,
+			erc20.ErrCallerMustBePayee.Error(),
+		},
+		{
+			"pass - transfer with authorization",
+			erc20.TransferWithAuthorizationMethod,
+			func(now int64) []interface{} {
+				return s.authorizationArgs(erc20.TransferWithAuthorizationMethod, owner.Priv, owner.Addr, toAddr, amount, 0, now+3600, nonce)
+			},
+			false,
+			"",
+		},
+		{
+			"pass - receive with authorization",
+			erc20.ReceiveWithAuthorizationMethod,
+			func(now int64) []interface{} {
+				return s.authorizationArgs(erc20.ReceiveWithAuthorizationMethod, owner.Priv, owner.Addr, caller.Addr, amount, 0, now+3600, nonce)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setupTokenMetadata()
+			stateDB := s.network.GetStateDB()
+			method := s.precompile.Methods[tc.method]
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext().WithBlockTime(blockTime), caller.Addr, s.precompile, 0)
+
+			// Mint some coins to the module account and then send to the owner address
+			err := s.network.App.BankKeeper.MintCoins(ctx, erc20types.ModuleName, XMPLCoin)
+			s.Require().NoError(err, "failed to mint coins")
+			err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, owner.AccAddr, XMPLCoin)
+			s.Require().NoError(err, "failed to send coins from module to account")
+
+			args := tc.malleate(ctx.BlockTime().Unix())
+			to := args[1].(common.Address)
+
+			if tc.method == erc20.TransferWithAuthorizationMethod {
+				_, err = s.precompile.TransferWithAuthorization(ctx, contract, stateDB, &method, args)
+			} else {
+				_, err = s.precompile.ReceiveWithAuthorization(ctx, contract, stateDB, &method, args)
+			}
+
+			if tc.expErr {
+				s.Require().Error(err, "expected transaction to fail")
+				s.Require().Contains(err.Error(), tc.errContains, "expected transaction to fail with specific error")
+				return
+			}
+
+			s.Require().NoError(err, "expected transaction to succeed")
+
+			toBalance := s.network.App.BankKeeper.GetBalance(ctx, to.Bytes(), s.tokenDenom)
+			s.Require().Equal(amount, toBalance.Amount.BigInt(), "expected different balance for the payee")
+
+			stateMethod := s.precompile.Methods[erc20.AuthorizationStateMethod]
+			bz, err := s.precompile.AuthorizationState(ctx, contract, stateDB, &stateMethod, []interface{}{owner.Addr, [32]byte(nonce)})
+			s.requireOut(bz, err, stateMethod, true, "", true)
+
+			// the same authorization cannot be used twice
+			_, err = s.precompile.TransferWithAuthorization(ctx, contract, stateDB, &method, args)
+			s.Require().ErrorContains(err, erc20.ErrAuthorizationUsed.Error(), "expected replayed authorization to fail")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	method := s.precompile.Methods[erc20.DomainSeparatorMethod]
+
+	s.Run("fail - token without name", func() {
+		_, err := s.precompile.DomainSeparator(s.network.GetContext(), nil, s.network.GetStateDB(), &method, nil)
+		s.Require().Error(err, "expected error for token without metadata")
+	})
+
+	s.Run("pass", func() {
+		s.setupTokenMetadata()
+
+		bz, err := s.precompile.DomainSeparator(s.network.GetContext(), nil, s.network.GetStateDB(), &method, nil)
+		typedData := s.typedData("Permit", nil, nil)
+		expDomainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+		s.Require().NoError(err, "failed to hash domain")
+
+		s.requireOut(bz, err, method, true, "", [32]byte(common.BytesToHash(expDomainSeparator)))
+	})
+}
+
+// setupTokenMetadata registers the bank metadata of the test token, which is
+// required to derive the EIP-712 domain name.
+func (s *PrecompileTestSuite) setupTokenMetadata() {
+	s.network.App.BankKeeper.SetDenomMetaData(s.network.GetContext(), banktypes.Metadata{
+		Description: "Example token",
+		Base:        s.tokenDenom,
+		Display:     s.tokenDenom,
+		Name:        "Xmpl",
+		Symbol:      "XMPL",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: s.tokenDenom, Exponent: 0}},
+	})
+}
+
+// requireNonce checks the permit nonce of the given owner.
+func (s *PrecompileTestSuite) requireNonce(stateDB vm.StateDB, owner common.Address, expNonce *big.Int) {
+	method := s.precompile.Methods[erc20.NoncesMethod]
+	bz, err := s.precompile.Nonces(s.network.GetContext(), nil, stateDB, &method, []interface{}{owner})
+	s.requireOut(bz, err, method, true, "", expNonce)
+}
+
+// typedData returns the EIP-712 typed data of the given message for the test token.
+func (s *PrecompileTestSuite) typedData(primaryType string, fields []apitypes.Type, message apitypes.TypedDataMessage) apitypes.TypedData {
+	chainID := s.network.App.EvmKeeper.ChainID()
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			primaryType: fields,
+		},
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Xmpl",
+			Version:           erc20.EIP712Version,
+			ChainId:           ethmath.NewHexOrDecimal256(chainID.Int64()),
+			VerifyingContract: s.precompile.Address().Hex(),
+		},
+		Message: message,
+	}
+}
+
+// signTypedData signs the given EIP-712 typed data and returns the v, r and s
+// values of the signature.
+func (s *PrecompileTestSuite) signTypedData(priv cryptotypes.PrivKey, typedData apitypes.TypedData) (uint8, [32]byte, [32]byte) {
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	s.Require().NoError(err, "failed to hash typed data")
+
+	ethPriv, ok := priv.(*ethsecp256k1.PrivKey)
+	s.Require().True(ok, "expected eth_secp256k1 private key")
+	key, err := ethPriv.ToECDSA()
+	s.Require().NoError(err, "failed to convert private key")
+
+	sig, err := crypto.Sign(hash, key)
+	s.Require().NoError(err, "failed to sign typed data")
+
+	var r, sVal [32]byte
+	copy(r[:], sig[:32])
+	copy(sVal[:], sig[32:64])
+	return sig[64] + 27, r, sVal
+}
+
+// permitArgs returns the arguments of the permit method signed with the given key.
+func (s *PrecompileTestSuite) permitArgs(
+	priv cryptotypes.PrivKey,
+	owner, spender common.Address,
+	value, nonce, deadline *big.Int,
+) []interface{} {
+	typedData := s.typedData(
+		"Permit",
+		[]apitypes.Type{
+			{Name: "owner", Type: "address"},
+			{Name: "spender", Type: "address"},
+			{Name: "value", Type: "uint256"},
+			{Name: "nonce", Type: "uint256"},
+			{Name: "deadline", Type: "uint256"},
+		},
+		apitypes.TypedDataMessage{
+			"owner":    owner.Hex(),
+			"spender":  spender.Hex(),
+			"value":    value.String(),
+			"nonce":    nonce.String(),
+			"deadline": deadline.String(),
+		},
+	)
+
+	v, r, sVal := s.signTypedData(priv, typedData)
+	return []interface{}{owner, spender, value, deadline, v, r, sVal}
+}
+
+// authorizationArgs returns the arguments of the transferWithAuthorization and
+// receiveWithAuthorization methods signed with the given key.
+func (s *PrecompileTestSuite) authorizationArgs(
+	methodName string,
+	priv cryptotypes.PrivKey,
+	from, to common.Address,
+	value *big.Int,
+	validAfter, validBefore int64,
+	nonce common.Hash,
+) []interface{} {
+	primaryType := "TransferWithAuthorization"
+	if methodName == erc20.ReceiveWithAuthorizationMethod {
+		primaryType = "ReceiveWithAuthorization"
+	}
+
+	typedData := s.typedData(
+		primaryType,
+		authorizationTypes,
+		apitypes.TypedDataMessage{
+			"from":        from.Hex(),
+			"to":          to.Hex(),
+			"value":       value.String(),
+			"validAfter":  big.NewInt(validAfter).String(),
+			"validBefore": big.NewInt(validBefore).String(),
+			"nonce":       nonce.Hex(),
+		},
+	)
+
+	v, r, sVal := s.signTypedData(priv, typedData)
+	return []interface{}{from, to, value, big.NewInt(validAfter), big.NewInt(validBefore), [32]byte(nonce), v, r, sVal}
+}
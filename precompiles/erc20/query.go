@@ -51,17 +51,11 @@ func (p Precompile) Name(
 	method *abi.Method,
 	_ []interface{},
 ) ([]byte, error) {
-	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
-	if found {
-		return method.Outputs.Pack(metadata.Name)
-	}
-
-	baseDenom, err := p.getBaseDenomFromIBCVoucher(ctx, p.tokenPair.Denom)
+	name, err := p.getName(ctx)
 	if err != nil {
-		return nil, ConvertErrToERC20Error(err)
+		return nil, err
 	}
 
-	name := strings.ToUpper(string(baseDenom[1])) + baseDenom[2:]
 	return method.Outputs.Pack(name)
 }
 
@@ -247,3 +241,20 @@ func (p Precompile) getBaseDenomFromIBCVoucher(ctx sdk.Context, denom string) (s
 
 	return denomTrace.BaseDenom, nil
 }
+
+// getName returns the name of the token. If the token metadata is registered in the
+// bank module, it returns its name. Otherwise, it returns the base denomination of
+// the token capitalized (e.g. uatom -> Atom).
+func (p Precompile) getName(ctx sdk.Context) (string, error) {
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
+	if found {
+		return metadata.Name, nil
+	}
+
+	baseDenom, err := p.getBaseDenomFromIBCVoucher(ctx, p.tokenPair.Denom)
+	if err != nil {
+		return "", ConvertErrToERC20Error(err)
+	}
+
+	return strings.ToUpper(string(baseDenom[1])) + baseDenom[2:], nil
+}
@@ -33,6 +33,37 @@ type EventApproval struct {
 	Value   *big.Int
 }
 
+// EventAuthorizationUsed defines the event data for the ERC-3009 AuthorizationUsed events.
+type EventAuthorizationUsed struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+}
+
+// PermitArgs defines the arguments of the ERC-2612 permit method.
+type PermitArgs struct {
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Deadline *big.Int
+	V        uint8
+	R        [32]byte
+	S        [32]byte
+}
+
+// TransferWithAuthorizationArgs defines the arguments of the ERC-3009
+// transferWithAuthorization and receiveWithAuthorization methods.
+type TransferWithAuthorizationArgs struct {
+	From        common.Address
+	To          common.Address
+	Value       *big.Int
+	ValidAfter  *big.Int
+	ValidBefore *big.Int
+	Nonce       [32]byte
+	V           uint8
+	R           [32]byte
+	S           [32]byte
+}
+
 // ParseTransferArgs parses the arguments from the transfer method and returns
 // the destination address (to) and amount.
 func ParseTransferArgs(args []interface{}) (
@@ -140,6 +171,160 @@ func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
 	return account, nil
 }
 
+// NewPermitArgs parses the arguments from the permit method and returns
+// the PermitArgs.
+func NewPermitArgs(args []interface{}) (*PermitArgs, error) {
+	if len(args) != 7 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 7; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	spender, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid spender address: %v", args[1])
+	}
+
+	value, ok := args[2].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid value: %v", args[2])
+	}
+
+	deadline, ok := args[3].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid deadline: %v", args[3])
+	}
+
+	v, ok := args[4].(uint8)
+	if !ok {
+		return nil, fmt.Errorf("invalid v: %v", args[4])
+	}
+
+	r, ok := args[5].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid r: %v", args[5])
+	}
+
+	s, ok := args[6].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid s: %v", args[6])
+	}
+
+	return &PermitArgs{
+		Owner:    owner,
+		Spender:  spender,
+		Value:    value,
+		Deadline: deadline,
+		V:        v,
+		R:        r,
+		S:        s,
+	}, nil
+}
+
+// NewTransferWithAuthorizationArgs parses the arguments from the transferWithAuthorization
+// and receiveWithAuthorization methods and returns the TransferWithAuthorizationArgs.
+func NewTransferWithAuthorizationArgs(args []interface{}) (*TransferWithAuthorizationArgs, error) {
+	if len(args) != 9 {
+		return nil, fmt.Errorf("invalid number of arguments; expected 9; got: %d", len(args))
+	}
+
+	from, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid from address: %v", args[0])
+	}
+
+	to, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid to address: %v", args[1])
+	}
+
+	value, ok := args[2].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid value: %v", args[2])
+	}
+
+	validAfter, ok := args[3].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid validAfter: %v", args[3])
+	}
+
+	validBefore, ok := args[4].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid validBefore: %v", args[4])
+	}
+
+	nonce, ok := args[5].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid nonce: %v", args[5])
+	}
+
+	v, ok := args[6].(uint8)
+	if !ok {
+		return nil, fmt.Errorf("invalid v: %v", args[6])
+	}
+
+	r, ok := args[7].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid r: %v", args[7])
+	}
+
+	s, ok := args[8].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid s: %v", args[8])
+	}
+
+	return &TransferWithAuthorizationArgs{
+		From:        from,
+		To:          to,
+		Value:       value,
+		ValidAfter:  validAfter,
+		ValidBefore: validBefore,
+		Nonce:       nonce,
+		V:           v,
+		R:           r,
+		S:           s,
+	}, nil
+}
+
+// ParseNoncesArgs parses the nonces arguments and returns the owner address.
+func ParseNoncesArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf("invalid number of arguments; expected 1; got: %d", len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	return owner, nil
+}
+
+// ParseAuthorizationStateArgs parses the authorizationState arguments and returns
+// the authorizer address and the authorization nonce.
+func ParseAuthorizationStateArgs(args []interface{}) (
+	authorizer common.Address, nonce [32]byte, err error,
+) {
+	if len(args) != 2 {
+		return common.Address{}, [32]byte{}, fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	authorizer, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, [32]byte{}, fmt.Errorf("invalid authorizer address: %v", args[0])
+	}
+
+	nonce, ok = args[1].([32]byte)
+	if !ok {
+		return common.Address{}, [32]byte{}, fmt.Errorf("invalid nonce: %v", args[1])
+	}
+
+	return authorizer, nonce, nil
+}
+
 // updateOrAddCoin replaces the coin of the given denomination in the coins slice or adds it if it
 // does not exist yet.
 //
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev Define the allowance types that can be granted.
+string constant BASIC_ALLOWANCE = "/cosmos.feegrant.v1beta1.BasicAllowance";
+string constant PERIODIC_ALLOWANCE = "/cosmos.feegrant.v1beta1.PeriodicAllowance";
+
+/// @dev Allowance defines a fee allowance granted by a granter to a grantee.
+struct Allowance {
+    /// granter is the address of the account that pays the fees
+    address granter;
+    /// grantee is the address of the account that can use the allowance
+    address grantee;
+    /// allowanceType is the type URL of the allowance (basic or periodic)
+    string allowanceType;
+    /// spendLimit is the maximum amount of fees that can be spent. Empty for no limit.
+    Coin[] spendLimit;
+    /// expiration is the unix timestamp in seconds at which the allowance expires. Zero if it never expires.
+    int64 expiration;
+    /// period is the duration in seconds of each period of a periodic allowance. Zero for basic allowances.
+    int64 period;
+    /// periodSpendLimit is the maximum amount of fees that can be spent in each period.
+    Coin[] periodSpendLimit;
+    /// periodCanSpend is the amount of fees left to be spent in the current period.
+    Coin[] periodCanSpend;
+    /// periodReset is the unix timestamp in seconds at which the current period ends.
+    int64 periodReset;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the Cosmos SDK x/feegrant module.
+/// The caller of each transaction acts as the granter, so that contracts can sponsor the
+/// Cosmos transaction fees of their users from their own balance.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface IFeegrant {
+    /// @dev GrantAllowance defines an Event emitted when a fee allowance is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param allowanceType the type URL of the granted allowance
+    event GrantAllowance(
+        address indexed granter,
+        address indexed grantee,
+        string allowanceType
+    );
+
+    /// @dev RevokeAllowance defines an Event emitted when a fee allowance is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    event RevokeAllowance(
+        address indexed granter,
+        address indexed grantee
+    );
+
+    /// TRANSACTIONS
+
+    /// @dev Grants a fee allowance from the caller to the grantee. A basic allowance is granted
+    /// when the period is zero, otherwise a periodic allowance is granted.
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of fees that can be spent. Empty for no limit.
+    /// @param expiration The unix timestamp in seconds at which the allowance expires. Zero for no expiration.
+    /// @param period The duration in seconds of each period. Zero for a basic allowance.
+    /// @param periodSpendLimit The maximum amount of fees that can be spent in each period.
+    /// @return success Whether the transaction was successful or not
+    function grantAllowance(
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit
+    ) external returns (bool success);
+
+    /// @dev Revokes the fee allowance granted by the caller to the grantee.
+    /// @param grantee The address of the grantee
+    /// @return success Whether the transaction was successful or not
+    function revokeAllowance(
+        address grantee
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Returns the fee allowance granted by a granter to a grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return allowance The fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (Allowance memory allowance);
+
+    /// @dev Returns the fee allowances granted by a granter.
+    /// @param granter The address of the granter
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return allowances The fee allowances granted by the granter
+    /// @return pageResponse The pagination response for the query
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            Allowance[] memory allowances,
+            PageResponse memory pageResponse
+        );
+}
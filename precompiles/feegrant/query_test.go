@@ -0,0 +1,178 @@
+package feegrant_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/feegrant"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+	granteeAddr := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - zero granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, granteeAddr}
+			},
+			true,
+			fmt.Sprintf(feegrant.ErrInvalidGranter, common.Address{}),
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), granteeAddr}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - periodic allowance",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), granteeAddr}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			spendLimit := sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 1000))
+			periodReset := ctx.BlockTime().Add(time.Hour)
+			s.Require().NoError(s.network.App.FeeGrantKeeper.GrantAllowance(
+				ctx, s.keyring.GetAccAddr(0), granteeAddr.Bytes(), &feegranttypes.PeriodicAllowance{
+					Basic:            feegranttypes.BasicAllowance{SpendLimit: spendLimit},
+					Period:           time.Hour,
+					PeriodSpendLimit: spendLimit,
+					PeriodCanSpend:   spendLimit,
+					PeriodReset:      periodReset,
+				},
+			))
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Allowance(ctx, contract, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out struct{ Allowance feegrant.Allowance }
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz))
+			s.Require().Equal(s.keyring.GetAddr(0), out.Allowance.Granter)
+			s.Require().Equal(granteeAddr, out.Allowance.Grantee)
+			s.Require().Equal("/cosmos.feegrant.v1beta1.PeriodicAllowance", out.Allowance.AllowanceType)
+			s.Require().Equal(cmn.NewCoinsResponse(spendLimit), out.Allowance.SpendLimit)
+			s.Require().Equal(int64(3600), out.Allowance.Period)
+			s.Require().Equal(cmn.NewCoinsResponse(spendLimit), out.Allowance.PeriodCanSpend)
+			s.Require().Equal(periodReset.Unix(), out.Allowance.PeriodReset)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowancesByGranter() {
+	method := s.precompile.Methods[feegrant.AllowancesByGranterMethod]
+	granteeAddrs := []common.Address{evmosutiltx.GenerateAddress(), evmosutiltx.GenerateAddress()}
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expAllowances int
+		expTotal      uint64
+		expError      bool
+		errContains   string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - no allowances",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{}}
+			},
+			0,
+			0,
+			false,
+			"",
+		},
+		{
+			"success - paginated allowances",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			1,
+			2,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			for _, granteeAddr := range granteeAddrs {
+				s.Require().NoError(s.network.App.FeeGrantKeeper.GrantAllowance(
+					ctx, s.keyring.GetAccAddr(0), granteeAddr.Bytes(), &feegranttypes.BasicAllowance{},
+				))
+			}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.AllowancesByGranter(ctx, contract, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out feegrant.AllowancesOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesByGranterMethod, bz))
+			s.Require().Len(out.Allowances, tc.expAllowances)
+			s.Require().Equal(tc.expTotal, out.PageResponse.Total)
+			for _, allowance := range out.Allowances {
+				s.Require().Equal(s.keyring.GetAddr(0), allowance.Granter)
+				s.Require().Equal("/cosmos.feegrant.v1beta1.BasicAllowance", allowance.AllowanceType)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package feegrant_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/feegrant"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+// blockTime is the block time used to validate the allowance expirations in the tests.
+var blockTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	granteeAddr := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - zero grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, []cmn.Coin{}, int64(0), int64(0), []cmn.Coin{}}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(feegrant.ErrInvalidGrantee, common.Address{}),
+		},
+		{
+			"fail - negative period",
+			func() []interface{} {
+				return []interface{}{granteeAddr, []cmn.Coin{}, int64(0), int64(-1), []cmn.Coin{}}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(feegrant.ErrInvalidPeriod, -1),
+		},
+		{
+			"fail - period overflowing the duration",
+			func() []interface{} {
+				return []interface{}{granteeAddr, []cmn.Coin{}, int64(0), int64(math.MaxInt64/int64(time.Second) + 1), []cmn.Coin{}}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(feegrant.ErrInvalidPeriod, math.MaxInt64/int64(time.Second)+1),
+		},
+		{
+			"fail - expiration before block time",
+			func() []interface{} {
+				return []interface{}{granteeAddr, []cmn.Coin{}, blockTime.Add(-time.Hour).Unix(), int64(0), []cmn.Coin{}}
+			},
+			func() {},
+			true,
+			"expiration is before current block time",
+		},
+		{
+			"fail - periodic allowance without period spend limit",
+			func() []interface{} {
+				return []interface{}{granteeAddr, []cmn.Coin{}, int64(0), int64(3600), []cmn.Coin{}}
+			},
+			func() {},
+			true,
+			"spend limit must be positive",
+		},
+		{
+			"success - basic allowance without limits",
+			func() []interface{} {
+				return []interface{}{granteeAddr, []cmn.Coin{}, int64(0), int64(0), []cmn.Coin{}}
+			},
+			func() {
+				allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(s.network.GetContext(), s.keyring.GetAccAddr(0), granteeAddr.Bytes())
+				s.Require().NoError(err)
+				basic, ok := allowance.(*feegranttypes.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Empty(basic.SpendLimit)
+				s.Require().Nil(basic.Expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - periodic allowance",
+			func() []interface{} {
+				return []interface{}{
+					granteeAddr,
+					[]cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(1e18)}},
+					blockTime.Add(24 * time.Hour).Unix(),
+					int64(3600),
+					[]cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(1e17)}},
+				}
+			},
+			func() {
+				allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(s.network.GetContext(), s.keyring.GetAccAddr(0), granteeAddr.Bytes())
+				s.Require().NoError(err)
+				periodic, ok := allowance.(*feegranttypes.PeriodicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(time.Hour, periodic.Period)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 1e17)), periodic.PeriodCanSpend)
+				s.Require().Equal(blockTime.Add(time.Hour), periodic.PeriodReset)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			ctx = ctx.WithBlockTime(blockTime)
+			bz, err := s.precompile.GrantAllowance(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				s.Require().Len(stateDB.Logs(), 1)
+				s.Require().Equal(s.precompile.Events[feegrant.EventTypeGrantAllowance].ID, stateDB.Logs()[0].Topics[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	granteeAddr := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{evmosutiltx.GenerateAddress()}
+			},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{granteeAddr}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Require().NoError(s.network.App.FeeGrantKeeper.GrantAllowance(
+				s.network.GetContext(), s.keyring.GetAccAddr(0), granteeAddr.Bytes(), &feegranttypes.BasicAllowance{},
+			))
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.RevokeAllowance(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				s.Require().Equal(s.precompile.Events[feegrant.EventTypeRevokeAllowance].ID, stateDB.Logs()[0].Topics[0])
+				_, err := s.network.App.FeeGrantKeeper.GetAllowance(s.network.GetContext(), s.keyring.GetAccAddr(0), granteeAddr.Bytes())
+				s.Require().ErrorContains(err, "fee-grant not found")
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+// EventGrantAllowance defines the event data for the GrantAllowance transaction.
+type EventGrantAllowance struct {
+	Granter       common.Address
+	Grantee       common.Address
+	AllowanceType string
+}
+
+// EventRevokeAllowance defines the event data for the RevokeAllowance transaction.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// Allowance is a struct to represent the key information from a fee
+// allowance granted by a granter to a grantee.
+type Allowance struct {
+	Granter          common.Address
+	Grantee          common.Address
+	AllowanceType    string
+	SpendLimit       []cmn.Coin
+	Expiration       int64
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+	PeriodCanSpend   []cmn.Coin
+	PeriodReset      int64
+}
+
+// NewAllowanceFromGrant creates a new Allowance from a grant stored in the feegrant module.
+// Allowed message allowances are reported with the fields of the allowance they wrap.
+func NewAllowanceFromGrant(grant *feegrant.Grant) (Allowance, error) {
+	granterAddr, err := sdk.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	if grant.Allowance == nil {
+		return Allowance{}, fmt.Errorf(ErrInvalidAllowance, "")
+	}
+
+	allowance := Allowance{
+		Granter:          common.BytesToAddress(granterAddr),
+		Grantee:          common.BytesToAddress(granteeAddr),
+		AllowanceType:    grant.Allowance.TypeUrl,
+		SpendLimit:       []cmn.Coin{},
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+	}
+
+	feeAllowance, err := grant.GetGrant()
+	if err != nil {
+		return Allowance{}, err
+	}
+
+	if allowedMsgAllowance, ok := feeAllowance.(*feegrant.AllowedMsgAllowance); ok {
+		feeAllowance, err = allowedMsgAllowance.GetAllowance()
+		if err != nil {
+			return Allowance{}, err
+		}
+	}
+
+	switch a := feeAllowance.(type) {
+	case *feegrant.BasicAllowance:
+		allowance.setBasic(a)
+	case *feegrant.PeriodicAllowance:
+		allowance.setBasic(&a.Basic)
+		allowance.Period = int64(a.Period.Seconds())
+		allowance.PeriodSpendLimit = cmn.NewCoinsResponse(a.PeriodSpendLimit)
+		allowance.PeriodCanSpend = cmn.NewCoinsResponse(a.PeriodCanSpend)
+		allowance.PeriodReset = a.PeriodReset.Unix()
+	default:
+		return Allowance{}, fmt.Errorf(ErrInvalidAllowance, grant.Allowance.TypeUrl)
+	}
+
+	return allowance, nil
+}
+
+// setBasic sets the fields of a basic allowance.
+func (a *Allowance) setBasic(basic *feegrant.BasicAllowance) {
+	a.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		a.Expiration = basic.Expiration.Unix()
+	}
+}
+
+// GrantAllowanceInput is a struct to represent the input information for the grantAllowance transaction.
+// Needed to unpack arguments into the Coin struct.
+type GrantAllowanceInput struct {
+	Grantee          common.Address
+	SpendLimit       []cmn.Coin
+	Expiration       int64
+	Period           int64
+	PeriodSpendLimit []cmn.Coin
+}
+
+// AllowancesByGranterInput is a struct to represent the input information for the allowancesByGranter query.
+// Needed to unpack arguments into the PageRequest struct.
+type AllowancesByGranterInput struct {
+	Granter     common.Address
+	PageRequest query.PageRequest
+}
+
+// AllowancesOutput is a struct to represent the key information from an
+// allowancesByGranter response.
+type AllowancesOutput struct {
+	Allowances   []Allowance
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the AllowancesOutput from a QueryAllowancesByGranterResponse.
+func (ao *AllowancesOutput) FromResponse(res *feegrant.QueryAllowancesByGranterResponse) (*AllowancesOutput, error) {
+	ao.Allowances = make([]Allowance, len(res.Allowances))
+	for i, grant := range res.Allowances {
+		allowance, err := NewAllowanceFromGrant(grant)
+		if err != nil {
+			return nil, err
+		}
+		ao.Allowances[i] = allowance
+	}
+
+	if res.Pagination != nil {
+		ao.PageResponse.Total = res.Pagination.Total
+		ao.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return ao, nil
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (ao *AllowancesOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(ao.Allowances, ao.PageResponse)
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance instance for the given granter
+// and does sanity checks on the provided arguments. A basic allowance is created when
+// the period is zero, otherwise a periodic allowance whose first period starts at the
+// given block time.
+func NewMsgGrantAllowance(
+	blockTime time.Time,
+	granter common.Address,
+	method *abi.Method,
+	args []interface{},
+) (*feegrant.MsgGrantAllowance, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	var input GrantAllowanceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to GrantAllowanceInput struct: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	if input.Expiration < 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidExpiration, input.Expiration)
+	}
+
+	// the period is converted to a duration in nanoseconds, so it must not overflow
+	if input.Period < 0 || input.Period > math.MaxInt64/int64(time.Second) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidPeriod, input.Period)
+	}
+
+	spendLimit, err := newCoins(input.SpendLimit)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	basic := feegrant.BasicAllowance{SpendLimit: spendLimit}
+	if input.Expiration != 0 {
+		expiration := time.Unix(input.Expiration, 0).UTC()
+		basic.Expiration = &expiration
+	}
+
+	var allowance feegrant.FeeAllowanceI = &basic
+	if input.Period != 0 {
+		periodSpendLimit, err := newCoins(input.PeriodSpendLimit)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+
+		period := time.Duration(input.Period) * time.Second
+		allowance = &feegrant.PeriodicAllowance{
+			Basic:            basic,
+			Period:           period,
+			PeriodSpendLimit: periodSpendLimit,
+			PeriodCanSpend:   periodSpendLimit,
+			PeriodReset:      blockTime.Add(period),
+		}
+	} else if len(input.PeriodSpendLimit) != 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidPeriod, input.Period)
+	}
+
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granter.Bytes(), input.Grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.Grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance for the given granter
+// and does sanity checks on the provided arguments.
+func NewMsgRevokeAllowance(granter common.Address, args []interface{}) (*feegrant.MsgRevokeAllowance, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msg := feegrant.NewMsgRevokeAllowance(granter.Bytes(), grantee.Bytes())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return &msg, grantee, nil
+}
+
+// NewAllowanceRequest creates a new QueryAllowanceRequest instance and does sanity
+// checks on the provided arguments.
+func NewAllowanceRequest(args []interface{}) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: sdk.AccAddress(granter.Bytes()).String(),
+		Grantee: sdk.AccAddress(grantee.Bytes()).String(),
+	}, nil
+}
+
+// NewAllowancesByGranterRequest creates a new QueryAllowancesByGranterRequest instance and does sanity
+// checks on the provided arguments.
+func NewAllowancesByGranterRequest(method *abi.Method, args []interface{}) (*feegrant.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput struct: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &feegrant.QueryAllowancesByGranterRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.PageRequest,
+	}, nil
+}
+
+// newCoins converts the given coins into a sorted set of Cosmos SDK coins.
+// NOTE: nil is returned for an empty set, which stands for no spend limit.
+func newCoins(coins []cmn.Coin) (sdk.Coins, error) {
+	var sdkCoins sdk.Coins
+	for _, coin := range coins {
+		if coin.Amount == nil {
+			return nil, fmt.Errorf(ErrInvalidSpendLimit, coins)
+		}
+		sdkCoins = sdkCoins.Add(coin.ToSDKType())
+	}
+
+	return sdkCoins, nil
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IBCI contract's address.
+address constant IBC_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IBCI contract's instance.
+IBCI constant IBC_CONTRACT = IBCI(IBC_PRECOMPILE_ADDRESS);
+
+/// @dev Define the status of a light client.
+string constant CLIENT_STATUS_ACTIVE = "Active";
+string constant CLIENT_STATUS_FROZEN = "Frozen";
+string constant CLIENT_STATUS_EXPIRED = "Expired";
+string constant CLIENT_STATUS_UNKNOWN = "Unknown";
+
+/// @dev Define the states of a connection or a channel end.
+uint8 constant STATE_UNINITIALIZED = 0;
+uint8 constant STATE_INIT = 1;
+uint8 constant STATE_TRYOPEN = 2;
+uint8 constant STATE_OPEN = 3;
+uint8 constant STATE_CLOSED = 4;
+
+/// @dev Define the packet orderings of a channel.
+uint8 constant ORDER_NONE = 0;
+uint8 constant ORDER_UNORDERED = 1;
+uint8 constant ORDER_ORDERED = 2;
+
+/// @dev ClientState contains the state of an IBC light client.
+struct ClientState {
+    /// clientType is the type of the light client (e.g. 07-tendermint)
+    string clientType;
+    /// chainId is the chain identifier of the counterparty chain. Empty for non-tendermint clients.
+    string chainId;
+    /// latestHeight is the latest height the client was updated to
+    Height latestHeight;
+    /// frozenHeight is the height at which the client was frozen. Zero if the client is not frozen.
+    Height frozenHeight;
+    /// trustingPeriod is the duration in seconds of the period since the latest trusted
+    /// header during which the client can be updated. Zero for non-tendermint clients.
+    int64 trustingPeriod;
+    /// status is the current status of the client (Active, Frozen, Expired or Unknown)
+    string status;
+}
+
+/// @dev Connection contains the state of an IBC connection end.
+struct Connection {
+    /// clientId is the client identifier associated with the connection
+    string clientId;
+    /// state is the current state of the connection end
+    uint8 state;
+    /// counterpartyClientId is the client identifier of the counterparty chain
+    string counterpartyClientId;
+    /// counterpartyConnectionId is the connection identifier on the counterparty chain
+    string counterpartyConnectionId;
+    /// delayPeriod is the delay period in nanoseconds associated with the connection
+    uint64 delayPeriod;
+}
+
+/// @dev Channel contains the state of an IBC channel end.
+struct Channel {
+    /// state is the current state of the channel end
+    uint8 state;
+    /// ordering is the packet ordering of the channel
+    uint8 ordering;
+    /// counterpartyPortId is the port identifier on the counterparty chain
+    string counterpartyPortId;
+    /// counterpartyChannelId is the channel identifier on the counterparty chain
+    string counterpartyChannelId;
+    /// connectionHops is the list of connection identifiers the packets travel along
+    string[] connectionHops;
+    /// version is the version of the channel
+    string version;
+}
+
+/// @author Evmos Team
+/// @title IBC Precompile Contract
+/// @dev The interface through which solidity contracts can read the state of the IBC
+/// light clients, connections and channels (e.g. to check that a client is not frozen
+/// or a channel is not closed before sending an ICS20 transfer).
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IBCI {
+    /// @dev Returns the state of the given light client.
+    /// @param clientId The identifier of the light client.
+    /// @return clientState The state of the light client.
+    function clientState(
+        string memory clientId
+    ) external view returns (ClientState memory clientState);
+
+    /// @dev Returns the status of the given light client.
+    /// @param clientId The identifier of the light client.
+    /// @return status The status of the client (Active, Frozen, Expired or Unknown).
+    function clientStatus(
+        string memory clientId
+    ) external view returns (string memory status);
+
+    /// @dev Returns the state of the given connection end.
+    /// @param connectionId The identifier of the connection.
+    /// @return connection The state of the connection end.
+    function connection(
+        string memory connectionId
+    ) external view returns (Connection memory connection);
+
+    /// @dev Returns the state of the given channel end.
+    /// @param portId The port identifier of the channel.
+    /// @param channelId The identifier of the channel.
+    /// @return channel The state of the channel end.
+    function channel(
+        string memory portId,
+        string memory channelId
+    ) external view returns (Channel memory channel);
+
+    /// @dev Returns the sequence of the next packet to be sent on the given channel.
+    /// @param portId The port identifier of the channel.
+    /// @param channelId The identifier of the channel.
+    /// @return sequence The next send sequence of the channel.
+    function nextSequenceSend(
+        string memory portId,
+        string memory channelId
+    ) external view returns (uint64 sequence);
+}
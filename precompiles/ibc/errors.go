@@ -0,0 +1,17 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ibc
+
+const (
+	// ErrInvalidIdentifier is raised when an IBC identifier is not valid.
+	ErrInvalidIdentifier = "invalid %s identifier: %v"
+	// ErrClientNotFound is raised when the light client does not exist.
+	ErrClientNotFound = "light client %s not found"
+	// ErrConnectionNotFound is raised when the connection does not exist.
+	ErrConnectionNotFound = "connection %s not found"
+	// ErrChannelNotFound is raised when the channel does not exist.
+	ErrChannelNotFound = "channel %s not found on port %s"
+	// ErrNextSequenceSendNotFound is raised when the next send sequence of a channel does not exist.
+	ErrNextSequenceSendNotFound = "next send sequence not found for channel %s on port %s"
+)
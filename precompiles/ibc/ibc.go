@@ -0,0 +1,135 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ibc
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	clientkeeper "github.com/cosmos/ibc-go/v7/modules/core/02-client/keeper"
+	connectionkeeper "github.com/cosmos/ibc-go/v7/modules/core/03-connection/keeper"
+	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+// PrecompileAddress defines the IBC precompile address in Hex format
+const PrecompileAddress = "0x0000000000000000000000000000000000000809"
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the read-only precompiled contract for the IBC core
+// light client, connection and channel state.
+type Precompile struct {
+	cmn.Precompile
+	clientKeeper     clientkeeper.Keeper
+	connectionKeeper connectionkeeper.Keeper
+	channelKeeper    channelkeeper.Keeper
+}
+
+// NewPrecompile creates a new IBC Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	clientKeeper clientkeeper.Keeper,
+	connectionKeeper connectionkeeper.Keeper,
+	channelKeeper channelkeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		clientKeeper:     clientKeeper,
+		connectionKeeper: connectionKeeper,
+		channelKeeper:    channelKeeper,
+	}, nil
+}
+
+// Address defines the address of the IBC compile contract.
+// address: 0x0000000000000000000000000000000000000809
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// PrecompileName returns the name of the IBC precompile contract.
+func (Precompile) PrecompileName() string {
+	return "ibc"
+}
+
+// PrecompileVersion returns the interface version of the IBC precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract IBC methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// IBC queries
+	case ClientStateMethod:
+		bz, err = p.ClientState(ctx, contract, method, args)
+	case ClientStatusMethod:
+		bz, err = p.ClientStatus(ctx, contract, method, args)
+	case ConnectionMethod:
+		bz, err = p.Connection(ctx, contract, method, args)
+	case ChannelMethod:
+		bz, err = p.Channel(ctx, contract, method, args)
+	case NextSequenceSendMethod:
+		bz, err = p.NextSequenceSend(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// The IBC precompile only exposes queries.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
@@ -0,0 +1,136 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ibc
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// ClientStateMethod defines the ABI method name for the IBC
+	// ClientState query.
+	ClientStateMethod = "clientState"
+	// ClientStatusMethod defines the ABI method name for the IBC
+	// ClientStatus query.
+	ClientStatusMethod = "clientStatus"
+	// ConnectionMethod defines the ABI method name for the IBC
+	// Connection query.
+	ConnectionMethod = "connection"
+	// ChannelMethod defines the ABI method name for the IBC
+	// Channel query.
+	ChannelMethod = "channel"
+	// NextSequenceSendMethod defines the ABI method name for the IBC
+	// NextSequenceSend query.
+	NextSequenceSendMethod = "nextSequenceSend"
+)
+
+// ClientState returns the state of the given light client, including its
+// current status.
+func (p Precompile) ClientState(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	clientID, err := ParseClientIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	clientState, found := p.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, fmt.Errorf(ErrClientNotFound, clientID)
+	}
+
+	status := p.clientKeeper.GetClientStatus(ctx, clientState, clientID)
+
+	return method.Outputs.Pack(NewClientState(clientState, status))
+}
+
+// ClientStatus returns the status of the given light client.
+func (p Precompile) ClientStatus(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	clientID, err := ParseClientIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	clientState, found := p.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, fmt.Errorf(ErrClientNotFound, clientID)
+	}
+
+	status := p.clientKeeper.GetClientStatus(ctx, clientState, clientID)
+
+	return method.Outputs.Pack(status.String())
+}
+
+// Connection returns the state of the given connection end.
+func (p Precompile) Connection(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	connectionID, err := ParseConnectionIDArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, found := p.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return nil, fmt.Errorf(ErrConnectionNotFound, connectionID)
+	}
+
+	return method.Outputs.Pack(NewConnection(connection))
+}
+
+// Channel returns the state of the given channel end.
+func (p Precompile) Channel(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := ParseChannelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, found := p.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, fmt.Errorf(ErrChannelNotFound, channelID, portID)
+	}
+
+	return method.Outputs.Pack(NewChannel(channel))
+}
+
+// NextSequenceSend returns the sequence of the next packet to be sent on the
+// given channel.
+func (p Precompile) NextSequenceSend(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := ParseChannelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, found := p.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return nil, fmt.Errorf(ErrNextSequenceSendNotFound, channelID, portID)
+	}
+
+	return method.Outputs.Pack(sequence)
+}
@@ -0,0 +1,349 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ibc_test
+
+import (
+	"fmt"
+
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/ibc"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestClientState() {
+	method := s.precompile.Methods[ibc.ClientStateMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expStatus   exported.Status
+		expFrozen   bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			"",
+			false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid client identifier",
+			[]interface{}{"x"},
+			"",
+			false,
+			true,
+			"invalid client identifier",
+		},
+		{
+			"fail - client not found",
+			[]interface{}{"07-tendermint-9"},
+			"",
+			false,
+			true,
+			fmt.Sprintf(ibc.ErrClientNotFound, "07-tendermint-9"),
+		},
+		{
+			"success - active client",
+			[]interface{}{ActiveClientID},
+			exported.Active,
+			false,
+			false,
+			"",
+		},
+		{
+			"success - frozen client",
+			[]interface{}{FrozenClientID},
+			exported.Frozen,
+			true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.ClientState(ctx, contract, &method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out struct{ ClientState ibc.ClientState }
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, ibc.ClientStateMethod, bz))
+			s.Require().Equal(exported.Tendermint, out.ClientState.ClientType)
+			s.Require().Equal(CounterpartyID, out.ClientState.ChainId)
+			s.Require().Equal(ibc.Height{RevisionNumber: 1, RevisionHeight: 100}, out.ClientState.LatestHeight)
+			s.Require().Equal(tc.expFrozen, out.ClientState.FrozenHeight.RevisionHeight != 0)
+			s.Require().Equal(int64(14*24*3600), out.ClientState.TrustingPeriod)
+			s.Require().Equal(tc.expStatus.String(), out.ClientState.Status)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestClientStatus() {
+	method := s.precompile.Methods[ibc.ClientStatusMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expStatus   exported.Status
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid type",
+			[]interface{}{1},
+			"",
+			true,
+			"invalid type for clientId",
+		},
+		{
+			"fail - client not found",
+			[]interface{}{"07-tendermint-9"},
+			"",
+			true,
+			fmt.Sprintf(ibc.ErrClientNotFound, "07-tendermint-9"),
+		},
+		{
+			"success - active client",
+			[]interface{}{ActiveClientID},
+			exported.Active,
+			false,
+			"",
+		},
+		{
+			"success - frozen client",
+			[]interface{}{FrozenClientID},
+			exported.Frozen,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.ClientStatus(ctx, contract, &method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expStatus.String(), out[0])
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestConnection() {
+	method := s.precompile.Methods[ibc.ConnectionMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid connection identifier",
+			[]interface{}{"conn"},
+			true,
+			"invalid connection identifier",
+		},
+		{
+			"fail - connection not found",
+			[]interface{}{"connection-9"},
+			true,
+			fmt.Sprintf(ibc.ErrConnectionNotFound, "connection-9"),
+		},
+		{
+			"success - open connection",
+			[]interface{}{ConnectionID},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Connection(ctx, contract, &method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out struct{ Connection ibc.Connection }
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, ibc.ConnectionMethod, bz))
+			s.Require().Equal(ibc.Connection{
+				ClientId:                 ActiveClientID,
+				State:                    uint8(connectiontypes.OPEN),
+				CounterpartyClientId:     "07-tendermint-7",
+				CounterpartyConnectionId: "connection-7",
+			}, out.Connection)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestChannel() {
+	method := s.precompile.Methods[ibc.ChannelMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		args        []interface{}
+		expState    channeltypes.State
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() {},
+			[]interface{}{},
+			channeltypes.UNINITIALIZED,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid channel identifier",
+			func() {},
+			[]interface{}{PortID, "ch"},
+			channeltypes.UNINITIALIZED,
+			true,
+			"invalid channel identifier",
+		},
+		{
+			"fail - channel not found",
+			func() {},
+			[]interface{}{PortID, "channel-9"},
+			channeltypes.UNINITIALIZED,
+			true,
+			fmt.Sprintf(ibc.ErrChannelNotFound, "channel-9", PortID),
+		},
+		{
+			"success - open channel",
+			func() {},
+			[]interface{}{PortID, ChannelID},
+			channeltypes.OPEN,
+			false,
+			"",
+		},
+		{
+			"success - closed channel",
+			func() {
+				ctx := s.network.GetContext()
+				channelKeeper := s.network.App.IBCKeeper.ChannelKeeper
+				channel, found := channelKeeper.GetChannel(ctx, PortID, ChannelID)
+				s.Require().True(found)
+				channel.State = channeltypes.CLOSED
+				channelKeeper.SetChannel(ctx, PortID, ChannelID, channel)
+			},
+			[]interface{}{PortID, ChannelID},
+			channeltypes.CLOSED,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.Channel(ctx, contract, &method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out struct{ Channel ibc.Channel }
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, ibc.ChannelMethod, bz))
+			s.Require().Equal(ibc.Channel{
+				State:                 uint8(tc.expState),
+				Ordering:              uint8(channeltypes.UNORDERED),
+				CounterpartyPortId:    PortID,
+				CounterpartyChannelId: "channel-7",
+				ConnectionHops:        []string{ConnectionID},
+				Version:               "ics20-1",
+			}, out.Channel)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNextSequenceSend() {
+	method := s.precompile.Methods[ibc.NextSequenceSendMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid port identifier",
+			[]interface{}{"", ChannelID},
+			true,
+			"invalid port identifier",
+		},
+		{
+			"fail - sequence not found",
+			[]interface{}{PortID, "channel-9"},
+			true,
+			fmt.Sprintf(ibc.ErrNextSequenceSendNotFound, "channel-9", PortID),
+		},
+		{
+			"success - next sequence",
+			[]interface{}{PortID, ChannelID},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+
+			bz, err := s.precompile.NextSequenceSend(ctx, contract, &method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(NextSequence, out[0])
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ibc_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/evmos/evmos/v16/precompiles/ibc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	ActiveClientID = "07-tendermint-0"
+	FrozenClientID = "07-tendermint-1"
+	ConnectionID   = "connection-0"
+	PortID         = "transfer"
+	ChannelID      = "channel-0"
+	CounterpartyID = "osmosis-1"
+	NextSequence   = uint64(5)
+)
+
+var latestHeight = clienttypes.NewHeight(1, 100)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *ibc.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	ibcKeeper := unitNetwork.App.IBCKeeper
+	precompile, err := ibc.NewPrecompile(
+		ibcKeeper.ClientKeeper,
+		ibcKeeper.ConnectionKeeper,
+		ibcKeeper.ChannelKeeper,
+	)
+	s.Require().NoError(err, "expected no error during precompile creation")
+
+	ctx := unitNetwork.GetContext()
+
+	clientState := ibctm.NewClientState(
+		CounterpartyID,
+		ibctm.DefaultTrustLevel,
+		14*24*time.Hour,
+		21*24*time.Hour,
+		10*time.Second,
+		latestHeight,
+		commitmenttypes.GetSDKSpecs(),
+		[]string{"upgrade", "upgradedIBCState"},
+	)
+	consensusState := ibctm.NewConsensusState(
+		ctx.BlockTime(),
+		commitmenttypes.NewMerkleRoot([]byte("root")),
+		[]byte("0123456789abcdef0123456789abcdef"),
+	)
+	ibcKeeper.ClientKeeper.SetClientState(ctx, ActiveClientID, clientState)
+	ibcKeeper.ClientKeeper.SetClientConsensusState(ctx, ActiveClientID, latestHeight, consensusState)
+
+	frozenClientState := *clientState
+	frozenClientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+	ibcKeeper.ClientKeeper.SetClientState(ctx, FrozenClientID, &frozenClientState)
+	ibcKeeper.ClientKeeper.SetClientConsensusState(ctx, FrozenClientID, latestHeight, consensusState)
+
+	ibcKeeper.ConnectionKeeper.SetConnection(ctx, ConnectionID, connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN,
+		ActiveClientID,
+		connectiontypes.NewCounterparty("07-tendermint-7", "connection-7", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		[]*connectiontypes.Version{connectiontypes.DefaultIBCVersion},
+		0,
+	))
+
+	ibcKeeper.ChannelKeeper.SetChannel(ctx, PortID, ChannelID, channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(PortID, "channel-7"),
+		[]string{ConnectionID},
+		"ics20-1",
+	))
+	ibcKeeper.ChannelKeeper.SetNextSequenceSend(ctx, PortID, ChannelID, NextSequence)
+
+	s.network = unitNetwork
+	s.keyring = keyring
+	s.precompile = precompile
+}
@@ -0,0 +1,177 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ibc
+
+import (
+	"fmt"
+
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+// Height is the ABI representation of an IBC height.
+type Height struct {
+	RevisionNumber uint64
+	RevisionHeight uint64
+}
+
+// ClientState is a struct to represent the key information from the state
+// of an IBC light client.
+type ClientState struct {
+	ClientType     string
+	ChainId        string //nolint:revive,stylecheck // name must match the ABI
+	LatestHeight   Height
+	FrozenHeight   Height
+	TrustingPeriod int64
+	Status         string
+}
+
+// Connection is a struct to represent the key information from an IBC
+// connection end.
+type Connection struct {
+	ClientId                 string //nolint:revive,stylecheck // name must match the ABI
+	State                    uint8
+	CounterpartyClientId     string //nolint:revive,stylecheck // name must match the ABI
+	CounterpartyConnectionId string //nolint:revive,stylecheck // name must match the ABI
+	DelayPeriod              uint64
+}
+
+// Channel is a struct to represent the key information from an IBC channel
+// end.
+type Channel struct {
+	State                 uint8
+	Ordering              uint8
+	CounterpartyPortId    string //nolint:revive,stylecheck // name must match the ABI
+	CounterpartyChannelId string //nolint:revive,stylecheck // name must match the ABI
+	ConnectionHops        []string
+	Version               string
+}
+
+// NewClientState creates a new ClientState from the given light client state
+// and status. The tendermint specific fields are only set for tendermint
+// clients.
+func NewClientState(clientState exported.ClientState, status exported.Status) ClientState {
+	cs := ClientState{
+		ClientType:   clientState.ClientType(),
+		LatestHeight: newHeight(clientState.GetLatestHeight()),
+		Status:       status.String(),
+	}
+
+	if tmClientState, ok := clientState.(*ibctm.ClientState); ok {
+		cs.ChainId = tmClientState.ChainId
+		cs.FrozenHeight = newHeight(tmClientState.FrozenHeight)
+		cs.TrustingPeriod = int64(tmClientState.TrustingPeriod.Seconds())
+	}
+
+	return cs
+}
+
+// NewConnection creates a new Connection from the given connection end.
+func NewConnection(connection connectiontypes.ConnectionEnd) Connection {
+	return Connection{
+		ClientId:                 connection.ClientId,
+		State:                    uint8(connection.State),
+		CounterpartyClientId:     connection.Counterparty.ClientId,
+		CounterpartyConnectionId: connection.Counterparty.ConnectionId,
+		DelayPeriod:              connection.DelayPeriod,
+	}
+}
+
+// NewChannel creates a new Channel from the given channel end.
+func NewChannel(channel channeltypes.Channel) Channel {
+	connectionHops := channel.ConnectionHops
+	if connectionHops == nil {
+		connectionHops = []string{}
+	}
+
+	return Channel{
+		State:                 uint8(channel.State),
+		Ordering:              uint8(channel.Ordering),
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionHops:        connectionHops,
+		Version:               channel.Version,
+	}
+}
+
+// newHeight converts the given IBC height into its ABI representation.
+func newHeight(height exported.Height) Height {
+	if height == nil {
+		return Height{}
+	}
+
+	return Height{
+		RevisionNumber: height.GetRevisionNumber(),
+		RevisionHeight: height.GetRevisionHeight(),
+	}
+}
+
+// ParseClientIDArgs parses the arguments of the queries that take a single
+// client identifier.
+func ParseClientIDArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	clientID, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, "clientId", "", args[0])
+	}
+
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return "", fmt.Errorf(ErrInvalidIdentifier, "client", err)
+	}
+
+	return clientID, nil
+}
+
+// ParseConnectionIDArgs parses the arguments of the connection query.
+func ParseConnectionIDArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	connectionID, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, "connectionId", "", args[0])
+	}
+
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return "", fmt.Errorf(ErrInvalidIdentifier, "connection", err)
+	}
+
+	return connectionID, nil
+}
+
+// ParseChannelArgs parses the arguments of the queries that take a port and
+// a channel identifier.
+func ParseChannelArgs(args []interface{}) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	portID, ok := args[0].(string)
+	if !ok {
+		return "", "", fmt.Errorf(cmn.ErrInvalidType, "portId", "", args[0])
+	}
+
+	channelID, ok := args[1].(string)
+	if !ok {
+		return "", "", fmt.Errorf(cmn.ErrInvalidType, "channelId", "", args[1])
+	}
+
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return "", "", fmt.Errorf(ErrInvalidIdentifier, "port", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return "", "", fmt.Errorf(ErrInvalidIdentifier, "channel", err)
+	}
+
+	return portID, channelID, nil
+}
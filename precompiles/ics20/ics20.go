@@ -68,6 +68,16 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress("0x0000000000000000000000000000000000000802")
 }
 
+// PrecompileName returns the name of the ICS-20 precompile contract.
+func (Precompile) PrecompileName() string {
+	return "ics20"
+}
+
+// PrecompileVersion returns the interface version of the ICS-20 precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
 // RequiredGas calculates the precompiled contract's base gas rate.
 func (p Precompile) RequiredGas(input []byte) uint64 {
 	methodID := input[:4]
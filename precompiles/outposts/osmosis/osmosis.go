@@ -92,6 +92,16 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress(OsmosisOutpostAddress)
 }
 
+// PrecompileName returns the name of the Osmosis Outpost precompile contract.
+func (Precompile) PrecompileName() string {
+	return "osmosis-outpost"
+}
+
+// PrecompileVersion returns the interface version of the Osmosis Outpost precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
 // IsStateful returns true since the precompile contract has access to the
 // chain state.
 func (Precompile) IsStateful() bool {
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The Router Outpost contract's address.
+address constant ROUTER_OUTPOST_ADDRESS = 0x0000000000000000000000000000000000000902;
+
+/// @dev The Router Outpost contract's instance.
+IRouterOutpost constant ROUTER_OUTPOST_CONTRACT = IRouterOutpost(
+    ROUTER_OUTPOST_ADDRESS
+);
+
+/// @dev The Route struct contains a destination chain registered by governance.
+/// @param name - The unique name of the route.
+/// @param chainID - The chain ID of the destination chain.
+/// @param sourceChannel - The channel ID used to send the ICS-20 transfer.
+/// @param receiverTemplate - The template of the ICS-20 transfer receiver.
+/// @param memoTemplate - The JSON template of the ICS-20 transfer memo.
+/// @param enabled - Whether the route can be used.
+struct Route {
+    string name;
+    string chainID;
+    string sourceChannel;
+    string receiverTemplate;
+    string memoTemplate;
+    bool enabled;
+}
+
+/// @dev The MemoArg struct contains a value used to render the route templates.
+/// @param key - The placeholder key in the templates, without braces.
+/// @param value - The value replacing the placeholder.
+struct MemoArg {
+    string key;
+    string value;
+}
+
+/// @author Evmos Core Team.
+/// @dev Interface for sending tokens to the destination chains registered
+/// in the outposts module.
+interface IRouterOutpost {
+    /// @dev Emitted when an ICS-20 transfer is executed.
+    /// @param sender The address of the sender.
+    /// @param receiver The receiver of the transfer on the destination chain.
+    /// @param sourcePort The source port of the IBC transaction.
+    /// @param sourceChannel The source channel of the IBC transaction.
+    /// @param denom The denomination of the tokens transferred.
+    /// @param amount The amount of tokens transferred.
+    /// @param memo The IBC transaction memo.
+    event IBCTransfer(
+        address indexed sender,
+        string indexed receiver,
+        string sourcePort,
+        string sourceChannel,
+        string denom,
+        uint256 amount,
+        string memo
+    );
+
+    /// @dev Emitted when a user executes a swap through a route.
+    /// @param sender The address of the sender.
+    /// @param input The ERC-20 token contract address sent through the route.
+    /// @param route The name of the route.
+    /// @param amount The amount of input tokens sent.
+    /// @param receiver The receiver of the swapped tokens.
+    event Swap(
+        address indexed sender,
+        address indexed input,
+        string route,
+        uint256 amount,
+        string receiver
+    );
+
+    /// @dev This function sends tokens through a route, building the ICS-20 transfer
+    /// receiver and memo from the route templates.
+    /// @param sender The address on the Evmos chain sending the tokens.
+    /// @param route The name of the route.
+    /// @param input The ERC-20 token contract address to send. The zero address
+    /// sends the staking denomination.
+    /// @param amount The amount of input tokens to send.
+    /// @param receiver The receiver of the swapped tokens.
+    /// @param args The additional arguments used to render the route templates.
+    /// @return success The boolean value indicating whether the operation succeeded or not.
+    function swap(
+        address sender,
+        string calldata route,
+        address input,
+        uint256 amount,
+        string calldata receiver,
+        MemoArg[] calldata args
+    ) external returns (bool success);
+
+    /// @dev Returns the routes registered in the outposts module.
+    /// @return routes The registered routes.
+    function routes() external view returns (Route[] memory routes);
+
+    /// @dev Returns the route registered with the given name.
+    /// @param name The name of the route.
+    /// @return route The registered route.
+    function route(string calldata name) external view returns (Route memory route);
+}
@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package router
+
+const (
+	// ErrReservedArgument is raised when the caller provides a template argument
+	// that is set by the outpost.
+	ErrReservedArgument = "template argument %s is set by the outpost and cannot be provided"
+	// ErrDuplicateArgument is raised when the caller provides the same template
+	// argument more than once.
+	ErrDuplicateArgument = "duplicate template argument %s"
+	// ErrEmptyReceiver is raised when the receiver of the swapped tokens is empty.
+	ErrEmptyReceiver = "receiver cannot be empty"
+)
@@ -0,0 +1,64 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package router
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	outpoststypes "github.com/evmos/evmos/v16/x/outposts/types"
+)
+
+const (
+	// RoutesMethod defines the ABI method name for the routes query.
+	RoutesMethod = "routes"
+	// RouteMethod defines the ABI method name for the route query.
+	RouteMethod = "route"
+)
+
+// Routes returns all the routes registered in the outposts module.
+func (p Precompile) Routes(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	params := p.outpostsKeeper.GetParams(ctx)
+	routes := make([]Route, len(params.Routes))
+	for i, route := range params.Routes {
+		routes[i] = NewRoute(route)
+	}
+
+	return method.Outputs.Pack(routes)
+}
+
+// Route returns the route registered with the given name.
+func (p Precompile) Route(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	name, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid route name: %v", args[0])
+	}
+
+	route, found := p.outpostsKeeper.GetParams(ctx).GetRoute(name)
+	if !found {
+		return nil, errorsmod.Wrapf(outpoststypes.ErrRouteNotFound, "route '%s'", name)
+	}
+
+	return method.Outputs.Pack(NewRoute(route))
+}
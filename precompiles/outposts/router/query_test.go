@@ -0,0 +1,61 @@
+package router_test
+
+import (
+	"github.com/evmos/evmos/v16/precompiles/outposts/router"
+	outpoststypes "github.com/evmos/evmos/v16/x/outposts/types"
+)
+
+func (s *PrecompileTestSuite) TestRoutes() {
+	method := s.precompile.Methods[router.RoutesMethod]
+
+	bz, err := s.precompile.Routes(s.unitNetwork.GetContext(), &method, []interface{}{})
+	s.Require().NoError(err)
+
+	var routes []router.Route
+	err = s.precompile.UnpackIntoInterface(&routes, router.RoutesMethod, bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]router.Route{router.NewRoute(s.route)}, routes)
+}
+
+func (s *PrecompileTestSuite) TestRoute() {
+	method := s.precompile.Methods[router.RouteMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - route not found",
+			[]interface{}{"stride-stake"},
+			true,
+			outpoststypes.ErrRouteNotFound.Error(),
+		},
+		{
+			"pass - route found",
+			[]interface{}{RouteName},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.Route(s.unitNetwork.GetContext(), &method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			var out struct{ Route router.Route }
+			err = s.precompile.UnpackIntoInterface(&out, router.RouteMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(router.NewRoute(s.route), out.Route)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package router
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	erc20keeper "github.com/evmos/evmos/v16/x/erc20/keeper"
+	transferkeeper "github.com/evmos/evmos/v16/x/ibc/transfer/keeper"
+	outpostskeeper "github.com/evmos/evmos/v16/x/outposts/keeper"
+)
+
+const (
+	// RouterOutpostAddress is the address of the router outpost precompile.
+	RouterOutpostAddress = "0x0000000000000000000000000000000000000902"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile is the structure that defines the router outpost precompile extending
+// the common Precompile type. The destination chains reachable through the
+// outpost are the routes registered by governance in the outposts module.
+type Precompile struct {
+	cmn.Precompile
+
+	// Keepers
+	transferKeeper transferkeeper.Keeper
+	stakingKeeper  stakingkeeper.Keeper
+	erc20Keeper    erc20keeper.Keeper
+	outpostsKeeper outpostskeeper.Keeper
+}
+
+// NewPrecompile creates a new router outpost Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	authzKeeper authzkeeper.Keeper,
+	transferKeeper transferkeeper.Keeper,
+	stakingKeeper stakingkeeper.Keeper,
+	erc20Keeper erc20keeper.Keeper,
+	outpostsKeeper outpostskeeper.Keeper,
+) (*Precompile, error) {
+	newAbi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newAbi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ApprovalExpiration:   cmn.DefaultExpirationDuration,
+			AuthzKeeper:          authzKeeper,
+		},
+		transferKeeper: transferKeeper,
+		stakingKeeper:  stakingKeeper,
+		erc20Keeper:    erc20Keeper,
+		outpostsKeeper: outpostsKeeper,
+	}, nil
+}
+
+// LoadABI loads the router outpost ABI from the embedded abi.json file
+// for the router outpost precompile.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// Address defines the address of the router outpost precompile contract.
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(RouterOutpostAddress)
+}
+
+// PrecompileName returns the name of the Router Outpost precompile contract.
+func (Precompile) PrecompileName() string {
+	return "router-outpost"
+}
+
+// PrecompileVersion returns the interface version of the Router Outpost precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
+// IsStateful returns true since the precompile contract has access to the
+// chain state.
+func (Precompile) IsStateful() bool {
+	return true
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case SwapMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run executes the precompiled contract router outpost methods.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// Outpost transactions
+	case SwapMethod:
+		bz, err = p.Swap(ctx, evm.Origin, stateDB, contract, method, args)
+	// Outpost queries
+	case RoutesMethod:
+		bz, err = p.Routes(ctx, method, args)
+	case RouteMethod:
+		bz, err = p.Route(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
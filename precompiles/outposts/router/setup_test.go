@@ -0,0 +1,72 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package router_test
+
+import (
+	"testing"
+
+	"github.com/evmos/evmos/v16/precompiles/outposts/router"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	outpoststypes "github.com/evmos/evmos/v16/x/outposts/types"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	PortID      = "transfer"
+	ChannelID   = "channel-0"
+	RouteName   = "osmosis-swap"
+	XCSContract = "osmo1a34wxsxjwvtz3ua4hnkh4lv3d4qrgry0fhkasppplphwu5k538tqcyms9x"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	unitNetwork *network.UnitTestNetwork
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *router.Precompile
+	route      outpoststypes.Route
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	precompile, err := router.NewPrecompile(
+		unitNetwork.App.AuthzKeeper,
+		unitNetwork.App.TransferKeeper,
+		unitNetwork.App.StakingKeeper,
+		unitNetwork.App.Erc20Keeper,
+		unitNetwork.App.OutpostsKeeper,
+	)
+	s.Require().NoError(err, "expected no error during precompile creation")
+
+	route := outpoststypes.Route{
+		Name:             RouteName,
+		ChainId:          "osmosis-1",
+		SourceChannel:    ChannelID,
+		ReceiverTemplate: XCSContract,
+		MemoTemplate:     outpoststypes.WasmHooksSwapMemoTemplate,
+		Enabled:          true,
+	}
+	err = unitNetwork.App.OutpostsKeeper.SetParams(unitNetwork.GetContext(), outpoststypes.NewParams(true, route))
+	s.Require().NoError(err, "expected no error setting the outposts params")
+
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+
+	s.unitNetwork = unitNetwork
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+	s.precompile = precompile
+	s.route = route
+}
@@ -0,0 +1,151 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+//
+// Router package contains the logic of the generic outpost on the Evmos chain.
+// This outpost uses the ics20 precompile logic to relay IBC packets to the
+// destination chains registered by governance in the outposts module, building
+// the receiver and memo of the transfer from the templates of each route.
+
+package router
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/evmos/v16/precompiles/ics20"
+	outpoststypes "github.com/evmos/evmos/v16/x/outposts/types"
+)
+
+const (
+	// SwapMethod is the name of the swap method.
+	SwapMethod = "swap"
+)
+
+// Swap is a transaction that sends tokens through a governed route, rendering
+// the ICS20 transfer receiver and memo from the route templates.
+func (p Precompile) Swap(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParseSwapArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// The provided sender address should always be equal to the origin address.
+	// In case the contract caller address is the same as the sender address provided,
+	// update the sender address to be equal to the origin address.
+	// Otherwise, if the provided sender address is different from the origin address,
+	// return an error because is a forbidden operation
+	sender, err := ics20.CheckOriginAndSender(contract, origin, input.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := p.outpostsKeeper.GetEnabledRoute(ctx, input.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	// The zero address represents the staking denomination, otherwise the input
+	// has to be a registered token pair.
+	var inputDenom string
+	if input.Input == (common.Address{}) {
+		inputDenom = p.stakingKeeper.GetParams(ctx).BondDenom
+	} else {
+		inputDenom, err = p.erc20Keeper.GetTokenDenom(ctx, input.Input)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	senderBech32 := sdk.AccAddress(sender.Bytes()).String()
+	templateArgs, err := NewTemplateArgs(input.Args, senderBech32, input.Receiver, inputDenom, input.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ibcReceiver, err := route.RenderReceiver(templateArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	templateArgs[outpoststypes.ArgIBCReceiver] = ibcReceiver
+	memo, err := route.RenderMemo(templateArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutTimestamp := ctx.BlockTime().Add(ics20.DefaultTimeoutMinutes * time.Minute).UnixNano()
+	coin := sdk.Coin{Denom: inputDenom, Amount: math.NewIntFromBigInt(input.Amount)}
+	msg, err := ics20.CreateAndValidateMsgTransfer(
+		transfertypes.PortID,
+		route.SourceChannel,
+		coin,
+		senderBech32,
+		ibcReceiver,
+		ics20.DefaultTimeoutHeight,
+		uint64(timeoutTimestamp),
+		memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// No need to have authorization when the contract caller is the same as
+	// origin (owner of funds) and the sender is the origin.
+	accept, expiration, err := ics20.CheckAndAcceptAuthorizationIfNeeded(
+		ctx,
+		contract,
+		origin,
+		p.AuthzKeeper,
+		msg,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the ICS20 Transfer.
+	_, err = p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update grant only if is needed.
+	if err := ics20.UpdateGrantIfNeeded(ctx, contract, p.AuthzKeeper, origin, expiration, accept); err != nil {
+		return nil, err
+	}
+
+	// Emit the IBC transfer Event.
+	if err := ics20.EmitIBCTransferEvent(
+		ctx,
+		stateDB,
+		p.Events[ics20.EventTypeIBCTransfer],
+		p.Address(),
+		sender,
+		msg.Receiver,
+		msg.SourcePort,
+		msg.SourceChannel,
+		coin,
+		memo,
+	); err != nil {
+		return nil, err
+	}
+
+	// Emit the custom Swap Event.
+	if err := p.EmitSwapEvent(ctx, stateDB, sender, input.Input, route.Name, input.Amount, input.Receiver); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,228 @@
+package router_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/ics20"
+	"github.com/evmos/evmos/v16/precompiles/outposts/router"
+	commonnetwork "github.com/evmos/evmos/v16/testutil/integration/common/network"
+	"github.com/evmos/evmos/v16/testutil/integration/ibc/coordinator"
+	utiltx "github.com/evmos/evmos/v16/testutil/tx"
+	outpoststypes "github.com/evmos/evmos/v16/x/outposts/types"
+)
+
+func (s *PrecompileTestSuite) TestSwap() {
+	transferAmount := big.NewInt(1e18)
+	gas := uint64(2_000)
+	randomAddress := utiltx.GenerateAddress()
+	receiver := "evmos1vl0x3xr0zwgrllhdzxxlkal7txnnk56q3552x7" //nolint:goconst
+
+	memoArgs := []router.MemoArg{
+		{Key: "output_denom", Value: "uosmo"},
+		{Key: "slippage_percentage", Value: "10"},
+		{Key: "window_seconds", Value: "30"},
+		{Key: "recovery_address", Value: "osmo1vl0x3xr0zwgrllhdzxxlkal7txnnk56qv05kq8"},
+	}
+
+	method := s.precompile.Methods[router.SwapMethod]
+	testCases := []struct {
+		name        string
+		malleate    func(sender common.Address) []interface{}
+		ibcSetup    bool
+		expError    bool
+		errContains string
+	}{
+		{
+			name: "fail - invalid number of args",
+			malleate: func(_ common.Address) []interface{} {
+				return []interface{}{}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			name: "fail - empty receiver",
+			malleate: func(sender common.Address) []interface{} {
+				return []interface{}{sender, RouteName, common.Address{}, transferAmount, "", memoArgs}
+			},
+			expError:    true,
+			errContains: router.ErrEmptyReceiver,
+		},
+		{
+			name: "fail - origin different from sender",
+			malleate: func(_ common.Address) []interface{} {
+				return []interface{}{randomAddress, RouteName, common.Address{}, transferAmount, receiver, memoArgs}
+			},
+			expError:    true,
+			errContains: "is not the same as sender address",
+		},
+		{
+			name: "fail - route not found",
+			malleate: func(sender common.Address) []interface{} {
+				return []interface{}{sender, "stride-stake", common.Address{}, transferAmount, receiver, memoArgs}
+			},
+			expError:    true,
+			errContains: outpoststypes.ErrRouteNotFound.Error(),
+		},
+		{
+			name: "fail - route disabled",
+			malleate: func(sender common.Address) []interface{} {
+				route := s.route
+				route.Enabled = false
+				err := s.unitNetwork.App.OutpostsKeeper.SetParams(s.unitNetwork.GetContext(), outpoststypes.NewParams(true, route))
+				s.Require().NoError(err)
+				return []interface{}{sender, RouteName, common.Address{}, transferAmount, receiver, memoArgs}
+			},
+			expError:    true,
+			errContains: outpoststypes.ErrRouteDisabled.Error(),
+		},
+		{
+			name: "fail - outposts disabled",
+			malleate: func(sender common.Address) []interface{} {
+				err := s.unitNetwork.App.OutpostsKeeper.SetParams(s.unitNetwork.GetContext(), outpoststypes.NewParams(false, s.route))
+				s.Require().NoError(err)
+				return []interface{}{sender, RouteName, common.Address{}, transferAmount, receiver, memoArgs}
+			},
+			expError:    true,
+			errContains: outpoststypes.ErrOutpostsDisabled.Error(),
+		},
+		{
+			name: "fail - input token not registered",
+			malleate: func(sender common.Address) []interface{} {
+				return []interface{}{sender, RouteName, randomAddress, transferAmount, receiver, memoArgs}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf("token '%s' not registered", randomAddress),
+		},
+		{
+			name: "fail - reserved template argument",
+			malleate: func(sender common.Address) []interface{} {
+				args := append([]router.MemoArg{{Key: outpoststypes.ArgReceiver, Value: "osmo1"}}, memoArgs...)
+				return []interface{}{sender, RouteName, common.Address{}, transferAmount, receiver, args}
+			},
+			expError:    true,
+			errContains: fmt.Sprintf(router.ErrReservedArgument, outpoststypes.ArgReceiver),
+		},
+		{
+			name: "fail - missing template argument",
+			malleate: func(sender common.Address) []interface{} {
+				return []interface{}{sender, RouteName, common.Address{}, transferAmount, receiver, memoArgs[1:]}
+			},
+			expError:    true,
+			errContains: "missing argument 'output_denom'",
+		},
+		{
+			name: "fail - numeric template argument is not a number",
+			malleate: func(sender common.Address) []interface{} {
+				args := []router.MemoArg{memoArgs[0], memoArgs[1], {Key: "window_seconds", Value: "30}"}, memoArgs[3]}
+				return []interface{}{sender, RouteName, common.Address{}, transferAmount, receiver, args}
+			},
+			expError:    true,
+			errContains: "argument 'window_seconds' must be a number",
+		},
+		{
+			name: "fail - ibc channel not open",
+			malleate: func(sender common.Address) []interface{} {
+				return []interface{}{sender, RouteName, common.Address{}, transferAmount, receiver, memoArgs}
+			},
+			expError:    true,
+			errContains: "channel not found",
+		},
+		{
+			name: "pass - swap the staking denomination",
+			malleate: func(sender common.Address) []interface{} {
+				return []interface{}{sender, RouteName, common.Address{}, transferAmount, receiver, memoArgs}
+			},
+			ibcSetup: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			sender := s.keyring.GetAddr(0)
+			contract := vm.NewContract(vm.AccountRef(sender), s.precompile, big.NewInt(0), gas)
+
+			stateDB := s.unitNetwork.GetStateDB()
+
+			if tc.ibcSetup {
+				ibcSender, ibcSenderPrivKey := s.keyring.GetAccAddr(1), s.keyring.GetPrivKey(1)
+				// Account to sign IBC txs
+				ibcAcc, err := s.grpcHandler.GetAccount(ibcSender.String())
+				s.Require().NoError(err)
+
+				coordinator := coordinator.NewIntegrationCoordinator(
+					s.T(),
+					[]commonnetwork.Network{s.unitNetwork},
+				)
+
+				coordinator.SetDefaultSignerForChain(s.unitNetwork.GetChainID(), ibcSenderPrivKey, ibcAcc)
+				coordinator.Setup(s.unitNetwork.GetChainID(), coordinator.GetDummyChainsIds()[0])
+
+				err = coordinator.CommitAll()
+				s.Require().NoError(err)
+			}
+
+			_, err := s.precompile.Swap(
+				s.unitNetwork.GetContext(),
+				sender,
+				stateDB,
+				contract,
+				&method,
+				tc.malleate(sender),
+			)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			// The memo of the IBC transfer event contains the rendered wasm hook.
+			logs := stateDB.Logs()
+			s.Require().Len(logs, 2)
+			s.Require().Equal(s.precompile.Events[ics20.EventTypeIBCTransfer].ID, logs[0].Topics[0])
+			s.Require().Equal(s.precompile.Events[router.EventTypeSwap].ID, logs[1].Topics[0])
+
+			var event router.EventSwap
+			err = cmn.UnpackLog(s.precompile.ABI, &event, router.EventTypeSwap, *logs[1])
+			s.Require().NoError(err)
+			s.Require().Equal(sender, event.Sender)
+			s.Require().Equal(RouteName, event.Route)
+			s.Require().Equal(transferAmount, event.Amount)
+			s.Require().Equal(receiver, event.Receiver)
+
+			expMemo, err := s.route.RenderMemo(map[string]string{
+				outpoststypes.ArgIBCReceiver: XCSContract,
+				outpoststypes.ArgReceiver:    receiver,
+				"output_denom":               "uosmo",
+				"slippage_percentage":        "10",
+				"window_seconds":             "30",
+				"recovery_address":           memoArgs[3].Value,
+			})
+			s.Require().NoError(err)
+
+			var transferEvent struct {
+				SourcePort    string
+				SourceChannel string
+				Denom         string
+				Amount        *big.Int
+				Memo          string
+			}
+			err = s.precompile.ABI.UnpackIntoInterface(&transferEvent, ics20.EventTypeIBCTransfer, logs[0].Data)
+			s.Require().NoError(err)
+			s.Require().Equal(ChannelID, transferEvent.SourceChannel)
+			s.Require().Equal(s.unitNetwork.GetDenom(), transferEvent.Denom)
+			s.Require().Equal(expMemo, transferEvent.Memo)
+
+			balance := s.unitNetwork.App.BankKeeper.GetBalance(s.unitNetwork.GetContext(), sdk.AccAddress(sender.Bytes()), s.unitNetwork.GetDenom())
+			s.Require().True(balance.Amount.IsPositive())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package router
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	outpoststypes "github.com/evmos/evmos/v16/x/outposts/types"
+)
+
+// EventSwap is the event type emitted on a Swap transaction.
+type EventSwap struct {
+	Sender   common.Address
+	Input    common.Address
+	Route    string
+	Amount   *big.Int
+	Receiver string
+}
+
+// MemoArg is an additional argument used to render the route templates.
+type MemoArg struct {
+	Key   string `abi:"key"`
+	Value string `abi:"value"`
+}
+
+// SwapInput is the input struct of the swap method.
+type SwapInput struct {
+	Sender   common.Address `abi:"sender"`
+	Route    string         `abi:"route"`
+	Input    common.Address `abi:"input"`
+	Amount   *big.Int       `abi:"amount"`
+	Receiver string         `abi:"receiver"`
+	Args     []MemoArg      `abi:"args"`
+}
+
+// Route is the ABI representation of a route registered in the outposts module.
+type Route struct {
+	Name             string
+	ChainID          string
+	SourceChannel    string
+	ReceiverTemplate string
+	MemoTemplate     string
+	Enabled          bool
+}
+
+// NewRoute converts a route of the outposts module into its ABI representation.
+func NewRoute(route outpoststypes.Route) Route {
+	return Route{
+		Name:             route.Name,
+		ChainID:          route.ChainId,
+		SourceChannel:    route.SourceChannel,
+		ReceiverTemplate: route.ReceiverTemplate,
+		MemoTemplate:     route.MemoTemplate,
+		Enabled:          route.Enabled,
+	}
+}
+
+// ParseSwapArgs parses the arguments of the swap method.
+func ParseSwapArgs(method *abi.Method, args []interface{}) (*SwapInput, error) {
+	if len(args) != 6 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	var input SwapInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to SwapInput struct: %s", err)
+	}
+
+	if input.Amount == nil || input.Amount.Sign() <= 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, input.Amount)
+	}
+
+	if strings.TrimSpace(input.Receiver) == "" {
+		return nil, fmt.Errorf(ErrEmptyReceiver)
+	}
+
+	return &input, nil
+}
+
+// NewTemplateArgs returns the arguments used to render the route templates. The
+// arguments provided by the caller cannot override the ones set by the outpost.
+func NewTemplateArgs(memoArgs []MemoArg, sender, receiver, denom string, amount *big.Int) (map[string]string, error) {
+	args := make(map[string]string, len(memoArgs)+4)
+	for _, arg := range memoArgs {
+		if outpoststypes.IsReservedArg(arg.Key) {
+			return nil, fmt.Errorf(ErrReservedArgument, arg.Key)
+		}
+
+		if _, ok := args[arg.Key]; ok {
+			return nil, fmt.Errorf(ErrDuplicateArgument, arg.Key)
+		}
+
+		args[arg.Key] = arg.Value
+	}
+
+	args[outpoststypes.ArgSender] = sender
+	args[outpoststypes.ArgReceiver] = receiver
+	args[outpoststypes.ArgDenom] = denom
+	args[outpoststypes.ArgAmount] = amount.String()
+
+	return args, nil
+}
@@ -73,6 +73,16 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress("0x0000000000000000000000000000000000000900")
 }
 
+// PrecompileName returns the name of the Stride Outpost precompile contract.
+func (Precompile) PrecompileName() string {
+	return "stride-outpost"
+}
+
+// PrecompileVersion returns the interface version of the Stride Outpost precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
 // IsStateful returns true since the precompile contract has access to the
 // chain state.
 func (Precompile) IsStateful() bool {
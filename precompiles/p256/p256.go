@@ -46,6 +46,16 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress(PrecompileAddress)
 }
 
+// PrecompileName returns the name of the p256 precompile contract.
+func (Precompile) PrecompileName() string {
+	return "p256"
+}
+
+// PrecompileVersion returns the interface version of the p256 precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
 // RequiredGas returns the static gas required to execute the precompiled contract.
 func (p Precompile) RequiredGas(_ []byte) uint64 {
 	return VerifyGas
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IRandomness contract's address.
+address constant RANDOMNESS_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IRandomness contract's instance.
+IRandomness constant RANDOMNESS_CONTRACT = IRandomness(RANDOMNESS_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Randomness Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/randomness module.
+/// The randomness of each block is derived in BeginBlock from the randomness of the previous block,
+/// the hash of the last commit and the secrets revealed by the validators (commit-reveal).
+/// Contracts that need randomness that cannot be known when they act should use requestRandomness
+/// instead of the randomness of the current block.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IRandomness {
+    /// @dev RandomnessCommitted defines an Event emitted when a validator commits to a secret.
+    /// @param validator the operator address of the validator
+    /// @param commitment the keccak256 hash of the secret
+    event RandomnessCommitted(address indexed validator, bytes32 commitment);
+
+    /// @dev RandomnessRevealed defines an Event emitted when a validator reveals its secret.
+    /// @param validator the operator address of the validator
+    /// @param secret the revealed secret
+    event RandomnessRevealed(address indexed validator, bytes32 secret);
+
+    /// @dev RandomnessRequested defines an Event emitted when a contract requests randomness.
+    /// @param requestId the identifier of the request
+    /// @param requester the address of the contract to be called back
+    /// @param height the height of the randomness used to fulfill the request
+    /// @param callbackGasLimit the gas limit of the callback
+    event RandomnessRequested(
+        uint64 indexed requestId,
+        address indexed requester,
+        uint64 height,
+        uint64 callbackGasLimit
+    );
+
+    /// @dev Commits the hash of a secret on behalf of the bonded validator whose operator is the caller.
+    /// The secret must be revealed on a later block, within the reveal window, otherwise the validator
+    /// is jailed. A validator cannot commit again before revealing its pending commitment.
+    /// @param commitment the keccak256 hash of the secret
+    /// @return success true if the commitment was stored
+    function commitRandomness(bytes32 commitment) external returns (bool success);
+
+    /// @dev Reveals the secret committed by the validator whose operator is the caller.
+    /// The secret is mixed into the randomness at the end of the reveal window of the commitment.
+    /// @param secret the secret whose keccak256 hash matches the commitment
+    /// @return success true if the secret was revealed
+    function revealRandomness(bytes32 secret) external returns (bool success);
+
+    /// @dev Requests randomness to be delivered to the caller through the fulfillRandomness
+    /// callback of IRandomnessConsumer. The callback gas limit is charged on the request.
+    /// The total callback gas of a block is capped, so the callback might be executed on a
+    /// later block, still with the randomness of the returned height.
+    /// @param callbackGasLimit the gas limit of the callback
+    /// @return requestId the identifier of the request
+    /// @return height the height of the randomness used to fulfill the request
+    function requestRandomness(
+        uint64 callbackGasLimit
+    ) external returns (uint64 requestId, uint64 height);
+
+    /// @dev Returns the randomness of the given height. The randomness is only retained
+    /// for a limited number of blocks.
+    /// @param height the block height
+    /// @return randomness the randomness of the block
+    function getRandomness(uint64 height) external view returns (bytes32 randomness);
+}
+
+/// @author Evmos Team
+/// @title Randomness Consumer
+/// @dev The interface to be implemented by the contracts requesting randomness.
+interface IRandomnessConsumer {
+    /// @dev Called by the x/randomness module once the randomness of a request is available.
+    /// @param requestId the identifier of the request
+    /// @param randomness the randomness of the request height
+    function fulfillRandomness(uint256 requestId, bytes32 randomness) external;
+}
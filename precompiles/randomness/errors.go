@@ -0,0 +1,17 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package randomness
+
+const (
+	// ErrInvalidCommitment is raised when the commitment is not a valid bytes32 value.
+	ErrInvalidCommitment = "invalid commitment: %v"
+	// ErrInvalidSecret is raised when the secret is not a valid bytes32 value.
+	ErrInvalidSecret = "invalid secret: %v"
+	// ErrInvalidCallbackGasLimit is raised when the callback gas limit is not a valid uint64 value.
+	ErrInvalidCallbackGasLimit = "invalid callback gas limit: %v"
+	// ErrInvalidHeight is raised when the height is not a valid uint64 value.
+	ErrInvalidHeight = "invalid height: %v"
+	// ErrRandomnessNotFound is raised when there is no randomness for the given height.
+	ErrRandomnessNotFound = "randomness not found for height %d"
+)
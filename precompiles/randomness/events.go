@@ -0,0 +1,108 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package randomness
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// EventTypeRandomnessCommitted defines the event type for the randomness CommitRandomness transaction.
+	EventTypeRandomnessCommitted = "RandomnessCommitted"
+	// EventTypeRandomnessRevealed defines the event type for the randomness RevealRandomness transaction.
+	EventTypeRandomnessRevealed = "RandomnessRevealed"
+	// EventTypeRandomnessRequested defines the event type for the randomness RequestRandomness transaction.
+	EventTypeRandomnessRequested = "RandomnessRequested"
+)
+
+// EmitRandomnessCommittedEvent creates a new event emitted on a CommitRandomness transaction.
+func (p Precompile) EmitRandomnessCommittedEvent(ctx sdk.Context, stateDB vm.StateDB, validator common.Address, commitment common.Hash) error {
+	return p.emitValidatorEvent(ctx, stateDB, EventTypeRandomnessCommitted, validator, commitment)
+}
+
+// EmitRandomnessRevealedEvent creates a new event emitted on a RevealRandomness transaction.
+func (p Precompile) EmitRandomnessRevealedEvent(ctx sdk.Context, stateDB vm.StateDB, validator common.Address, secret common.Hash) error {
+	return p.emitValidatorEvent(ctx, stateDB, EventTypeRandomnessRevealed, validator, secret)
+}
+
+// EmitRandomnessRequestedEvent creates a new event emitted on a RequestRandomness transaction.
+func (p Precompile) EmitRandomnessRequestedEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	requestID uint64,
+	requester common.Address,
+	height, callbackGasLimit uint64,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeRandomnessRequested]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(requestID)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(requester)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(height, callbackGasLimit)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// emitValidatorEvent creates a new event with the validator as indexed topic
+// and the given hash as data.
+func (p Precompile) emitValidatorEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, validator common.Address, hash common.Hash) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(validator)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(hash)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
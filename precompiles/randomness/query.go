@@ -0,0 +1,44 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package randomness
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// GetRandomnessMethod defines the ABI method name for the randomness
+	// GetRandomness query.
+	GetRandomnessMethod = "getRandomness"
+)
+
+// GetRandomness returns the randomness of the given height. It returns an
+// error if the height is in the future or has been pruned.
+func (p Precompile) GetRandomness(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	height, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidHeight, args[0])
+	}
+
+	randomness, found := p.randomnessKeeper.GetRandomness(ctx, height)
+	if !found {
+		return nil, fmt.Errorf(ErrRandomnessNotFound, height)
+	}
+
+	return method.Outputs.Pack(randomness)
+}
@@ -0,0 +1,68 @@
+package randomness_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/randomness"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestGetRandomness() {
+	method := s.precompile.Methods[randomness.GetRandomnessMethod]
+
+	testCases := []struct {
+		name        string
+		args        func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} { return []interface{}{} },
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - future height",
+			func() []interface{} {
+				return []interface{}{uint64(1_000_000)}
+			},
+			true,
+			fmt.Sprintf(randomness.ErrRandomnessNotFound, 1_000_000),
+		},
+		{
+			"success - randomness of the previous block",
+			func() []interface{} {
+				return []interface{}{uint64(s.network.GetContext().BlockHeight() - 1)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.Require().NoError(s.network.NextBlock())
+			s.Require().NoError(s.network.NextBlock())
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile, 200_000)
+			args := tc.args()
+
+			bz, err := s.precompile.GetRandomness(ctx, contract, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				expRandomness, found := s.network.App.RandomnessKeeper.GetRandomness(ctx, args[0].(uint64))
+				s.Require().True(found)
+				s.Require().NotEqual(common.Hash{}, expRandomness)
+				s.Require().Equal(expRandomness.Bytes(), bz)
+			}
+		})
+	}
+}
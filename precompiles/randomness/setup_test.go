@@ -0,0 +1,47 @@
+package randomness_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/precompiles/randomness"
+	testkeyring "github.com/evmos/evmos/v16/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v16/testutil/integration/evmos/network"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the randomness precompile
+// unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	// validator is the operator address of a bonded validator
+	validator common.Address
+
+	precompile *randomness.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	s.keyring = keyring
+	s.network = integrationNetwork
+	s.validator = common.BytesToAddress(s.network.GetValidators()[0].GetOperator().Bytes())
+
+	precompile, err := randomness.NewPrecompile(s.network.App.RandomnessKeeper)
+	s.Require().NoError(err, "failed to create randomness precompile")
+	s.precompile = precompile
+}
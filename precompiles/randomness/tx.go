@@ -0,0 +1,125 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package randomness
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// CommitRandomnessMethod defines the ABI method name for the randomness
+	// CommitRandomness transaction.
+	CommitRandomnessMethod = "commitRandomness"
+	// RevealRandomnessMethod defines the ABI method name for the randomness
+	// RevealRandomness transaction.
+	RevealRandomnessMethod = "revealRandomness"
+	// RequestRandomnessMethod defines the ABI method name for the randomness
+	// RequestRandomness transaction.
+	RequestRandomnessMethod = "requestRandomness"
+)
+
+// CommitRandomness commits the hash of a secret on behalf of the bonded
+// validator whose operator address is the caller.
+func (p Precompile) CommitRandomness(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	commitment, err := parseHash(args, ErrInvalidCommitment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.randomnessKeeper.Commit(ctx, contract.CallerAddress, commitment); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRandomnessCommittedEvent(ctx, stateDB, contract.CallerAddress, commitment); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RevealRandomness reveals the secret committed by the validator whose operator
+// address is the caller, so that it's mixed into the randomness at the reveal height
+// of the commitment.
+func (p Precompile) RevealRandomness(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	secret, err := parseHash(args, ErrInvalidSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.randomnessKeeper.Reveal(ctx, contract.CallerAddress, secret); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitRandomnessRevealedEvent(ctx, stateDB, contract.CallerAddress, secret); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RequestRandomness registers a randomness request for the caller, which is
+// called back with the randomness once it's available. The callback gas limit
+// is charged to the caller on the request.
+func (p Precompile) RequestRandomness(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	callbackGasLimit, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidCallbackGasLimit, args[0])
+	}
+
+	request, err := p.randomnessKeeper.RequestRandomness(ctx, contract.CallerAddress, callbackGasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// the callback is executed on a later block, so its gas is paid upfront
+	ctx.GasMeter().ConsumeGas(callbackGasLimit, "randomness callback")
+
+	if err := p.EmitRandomnessRequestedEvent(ctx, stateDB, request.ID, request.Requester, request.Height, request.CallbackGasLimit); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(request.ID, request.Height)
+}
+
+// parseHash parses a single bytes32 argument.
+func parseHash(args []interface{}, errFormat string) (common.Hash, error) {
+	if len(args) != 1 {
+		return common.Hash{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	hash, ok := args[0].([32]byte)
+	if !ok {
+		return common.Hash{}, fmt.Errorf(errFormat, args[0])
+	}
+
+	return common.Hash(hash), nil
+}
@@ -0,0 +1,220 @@
+package randomness_test
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/randomness"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	randomnesstypes "github.com/evmos/evmos/v16/x/randomness/types"
+)
+
+func (s *PrecompileTestSuite) TestCommitRandomness() {
+	method := s.precompile.Methods[randomness.CommitRandomnessMethod]
+	commitment := crypto.Keccak256Hash([]byte("secret"))
+
+	testCases := []struct {
+		name        string
+		caller      func() common.Address
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() common.Address { return s.validator },
+			[]interface{}{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid commitment",
+			func() common.Address { return s.validator },
+			[]interface{}{"commitment"},
+			true,
+			fmt.Sprintf(randomness.ErrInvalidCommitment, "commitment"),
+		},
+		{
+			"fail - caller is not a validator",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			[]interface{}{[32]byte(commitment)},
+			true,
+			randomnesstypes.ErrValidatorNotBonded.Error(),
+		},
+		{
+			"success - validator commits",
+			func() common.Address { return s.validator },
+			[]interface{}{[32]byte(commitment)},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), tc.caller(), s.precompile, 200_000)
+
+			bz, err := s.precompile.CommitRandomness(ctx, contract, stateDB, &method, tc.args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				s.Require().Len(stateDB.Logs(), 1)
+				s.Require().Equal(s.precompile.Events[randomness.EventTypeRandomnessCommitted].ID, stateDB.Logs()[0].Topics[0])
+
+				stored, found := s.network.App.RandomnessKeeper.GetCommitment(ctx, s.validator)
+				s.Require().True(found)
+				s.Require().Equal(commitment, stored.Hash)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevealRandomness() {
+	method := s.precompile.Methods[randomness.RevealRandomnessMethod]
+	secret := common.BytesToHash([]byte("secret"))
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() {},
+			[]interface{}{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - no commitment",
+			func() {},
+			[]interface{}{[32]byte(secret)},
+			true,
+			randomnesstypes.ErrCommitmentNotFound.Error(),
+		},
+		{
+			"fail - secret does not match the commitment",
+			func() {
+				err := s.network.App.RandomnessKeeper.Commit(s.network.GetContext(), s.validator, crypto.Keccak256Hash([]byte("other")))
+				s.Require().NoError(err)
+				s.Require().NoError(s.network.NextBlock())
+			},
+			[]interface{}{[32]byte(secret)},
+			true,
+			randomnesstypes.ErrInvalidReveal.Error(),
+		},
+		{
+			"success - validator reveals",
+			func() {
+				err := s.network.App.RandomnessKeeper.Commit(s.network.GetContext(), s.validator, crypto.Keccak256Hash(secret.Bytes()))
+				s.Require().NoError(err)
+				s.Require().NoError(s.network.NextBlock())
+			},
+			[]interface{}{[32]byte(secret)},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.validator, s.precompile, 200_000)
+
+			bz, err := s.precompile.RevealRandomness(ctx, contract, stateDB, &method, tc.args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				s.Require().Len(stateDB.Logs(), 1)
+				s.Require().Equal(s.precompile.Events[randomness.EventTypeRandomnessRevealed].ID, stateDB.Logs()[0].Topics[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRequestRandomness() {
+	method := s.precompile.Methods[randomness.RequestRandomnessMethod]
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			[]interface{}{},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid callback gas limit type",
+			[]interface{}{"100000"},
+			true,
+			fmt.Sprintf(randomness.ErrInvalidCallbackGasLimit, "100000"),
+		},
+		{
+			"fail - callback gas limit above the maximum",
+			[]interface{}{randomnesstypes.MaxCallbackGasLimit + 1},
+			true,
+			randomnesstypes.ErrInvalidCallbackGasLimit.Error(),
+		},
+		{
+			"success - request randomness",
+			[]interface{}{uint64(100_000)},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			requester := s.keyring.GetAddr(0)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), requester, s.precompile, 200_000)
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			bz, err := s.precompile.RequestRandomness(ctx, contract, stateDB, &method, tc.args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(uint64(1), out[0])
+				s.Require().Equal(uint64(ctx.BlockHeight())+randomnesstypes.RequestDelay, out[1])
+
+				s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, uint64(100_000), "expected the callback gas to be charged")
+				s.Require().Len(stateDB.Logs(), 1)
+				s.Require().Equal(s.precompile.Events[randomness.EventTypeRandomnessRequested].ID, stateDB.Logs()[0].Topics[0])
+
+				requests, err := s.network.App.RandomnessKeeper.GetAllRequests(ctx)
+				s.Require().NoError(err)
+				s.Require().Len(requests, 1)
+				s.Require().Equal(requester, requests[0].Requester)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IRevenue contract's address.
+address constant REVENUE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+/// @dev The IRevenue contract's instance.
+IRevenue constant REVENUE_CONTRACT = IRevenue(REVENUE_PRECOMPILE_ADDRESS);
+
+/// @dev Revenue defines the revenue registration of a contract.
+struct Revenue {
+    /// contractAddress is the address of the registered contract
+    address contractAddress;
+    /// deployerAddress is the address of the account that registered the contract
+    address deployerAddress;
+    /// withdrawerAddress is the address that receives the developer revenue.
+    /// It is the zero address if the revenue is paid out to the deployer.
+    address withdrawerAddress;
+}
+
+/// @author Evmos Team
+/// @title Revenue Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the x/revenue module.
+/// The caller of each transaction is treated as the deployer of the registered contract,
+/// which allows factory contracts to register the contracts they create.
+/// @custom:address 0x0000000000000000000000000000000000000805
+interface IRevenue {
+    /// @dev RegisterRevenue defines an Event emitted when a contract is registered for revenue.
+    /// @param contractAddress the address of the registered contract
+    /// @param deployerAddress the address of the deployer that registered the contract
+    /// @param withdrawerAddress the address that receives the revenue
+    event RegisterRevenue(
+        address indexed contractAddress,
+        address indexed deployerAddress,
+        address withdrawerAddress
+    );
+
+    /// @dev UpdateRevenue defines an Event emitted when the withdrawer of a registered contract is updated.
+    /// @param contractAddress the address of the registered contract
+    /// @param deployerAddress the address of the deployer of the contract
+    /// @param withdrawerAddress the new address that receives the revenue
+    event UpdateRevenue(
+        address indexed contractAddress,
+        address indexed deployerAddress,
+        address withdrawerAddress
+    );
+
+    /// @dev CancelRevenue defines an Event emitted when a revenue registration is cancelled.
+    /// @param contractAddress the address of the contract
+    /// @param deployerAddress the address of the deployer of the contract
+    event CancelRevenue(
+        address indexed contractAddress,
+        address indexed deployerAddress
+    );
+
+    /// TRANSACTIONS
+
+    /// @dev Registers a contract deployed by the caller through the CREATE opcode.
+    /// The caller can register itself by passing its own address and an empty nonces array.
+    /// Otherwise, nonces contains the caller's nonce used to create the contract (or the
+    /// first factory in the chain), followed by the nonces of each intermediate factory.
+    /// @param contractAddress The address of the contract to register
+    /// @param withdrawerAddress The address that receives the revenue. Use the zero address
+    /// to pay out the revenue to the caller.
+    /// @param nonces The nonces used to derive the contract address from the caller
+    /// @return success Whether the transaction was successful or not
+    function registerRevenue(
+        address contractAddress,
+        address withdrawerAddress,
+        uint64[] memory nonces
+    ) external returns (bool success);
+
+    /// @dev Registers a contract deployed by the caller through the CREATE2 opcode.
+    /// @param contractAddress The address of the contract to register
+    /// @param withdrawerAddress The address that receives the revenue. Use the zero address
+    /// to pay out the revenue to the caller.
+    /// @param salt The salt used for the CREATE2 deployment
+    /// @param initCodeHash The keccak256 hash of the contract init code
+    /// @return success Whether the transaction was successful or not
+    function registerRevenueCreate2(
+        address contractAddress,
+        address withdrawerAddress,
+        bytes32 salt,
+        bytes32 initCodeHash
+    ) external returns (bool success);
+
+    /// @dev Updates the withdrawer address of a contract registered by the caller.
+    /// @param contractAddress The address of the registered contract
+    /// @param withdrawerAddress The new withdrawer address. Use the zero address
+    /// to pay out the revenue to the caller.
+    /// @return success Whether the transaction was successful or not
+    function updateRevenue(
+        address contractAddress,
+        address withdrawerAddress
+    ) external returns (bool success);
+
+    /// @dev Cancels the revenue registration of a contract registered by the caller.
+    /// @param contractAddress The address of the registered contract
+    /// @return success Whether the transaction was successful or not
+    function cancelRevenue(
+        address contractAddress
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev Returns the revenue registration of a given contract.
+    /// @param contractAddress The address of the registered contract
+    /// @return revenue The revenue registration of the contract
+    function revenue(
+        address contractAddress
+    ) external view returns (Revenue memory revenue);
+
+    /// @dev Returns the contracts registered by a given deployer.
+    /// @param deployerAddress The address of the deployer
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return contractAddresses The addresses of the registered contracts
+    /// @return pageResponse The pagination response for the query
+    function deployerRevenues(
+        address deployerAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            address[] memory contractAddresses,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev Returns the contracts that pay out revenue to a given withdrawer.
+    /// @param withdrawerAddress The address of the withdrawer
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return contractAddresses The addresses of the registered contracts
+    /// @return pageResponse The pagination response for the query
+    function withdrawerRevenues(
+        address withdrawerAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            address[] memory contractAddresses,
+            PageResponse memory pageResponse
+        );
+}
@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package revenue
+
+const (
+	// ErrInvalidContract is raised when the contract address is not valid.
+	ErrInvalidContract = "invalid contract address: %v"
+	// ErrInvalidDeployer is raised when the deployer address is not valid.
+	ErrInvalidDeployer = "invalid deployer address: %v"
+	// ErrInvalidWithdrawer is raised when the withdrawer address is not valid.
+	ErrInvalidWithdrawer = "invalid withdrawer address: %v"
+	// ErrDerivedAddressMismatch is raised when the contract address cannot be derived from the caller.
+	ErrDerivedAddressMismatch = "caller %s is not the contract deployer or wrong derivation arguments: expected %s instead of %s"
+)
@@ -0,0 +1,105 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package revenue
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+)
+
+const (
+	// EventTypeRegisterRevenue defines the event type for the revenue RegisterRevenue and RegisterRevenueCreate2 transactions.
+	EventTypeRegisterRevenue = "RegisterRevenue"
+	// EventTypeUpdateRevenue defines the event type for the revenue UpdateRevenue transaction.
+	EventTypeUpdateRevenue = "UpdateRevenue"
+	// EventTypeCancelRevenue defines the event type for the revenue CancelRevenue transaction.
+	EventTypeCancelRevenue = "CancelRevenue"
+)
+
+// EmitRegisterRevenueEvent creates a new event emitted on a RegisterRevenue or RegisterRevenueCreate2 transaction.
+func (p Precompile) EmitRegisterRevenueEvent(ctx sdk.Context, stateDB vm.StateDB, contractAddr, deployerAddr, withdrawerAddr common.Address) error {
+	return p.emitWithdrawerEvent(ctx, stateDB, EventTypeRegisterRevenue, contractAddr, deployerAddr, withdrawerAddr)
+}
+
+// EmitUpdateRevenueEvent creates a new event emitted on an UpdateRevenue transaction.
+func (p Precompile) EmitUpdateRevenueEvent(ctx sdk.Context, stateDB vm.StateDB, contractAddr, deployerAddr, withdrawerAddr common.Address) error {
+	return p.emitWithdrawerEvent(ctx, stateDB, EventTypeUpdateRevenue, contractAddr, deployerAddr, withdrawerAddr)
+}
+
+// EmitCancelRevenueEvent creates a new event emitted on a CancelRevenue transaction.
+func (p Precompile) EmitCancelRevenueEvent(ctx sdk.Context, stateDB vm.StateDB, contractAddr, deployerAddr common.Address) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeCancelRevenue]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(contractAddr)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(deployerAddr)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// emitWithdrawerEvent emits one of the events that are indexed by contract and deployer
+// and that contain the withdrawer address as data.
+func (p Precompile) emitWithdrawerEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	contractAddr, deployerAddr, withdrawerAddr common.Address,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(contractAddr)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(deployerAddr)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(withdrawerAddr)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package revenue
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// RevenueMethod defines the ABI method name for the revenue
+	// Revenue query.
+	RevenueMethod = "revenue"
+	// DeployerRevenuesMethod defines the ABI method name for the revenue
+	// DeployerRevenues query.
+	DeployerRevenuesMethod = "deployerRevenues"
+	// WithdrawerRevenuesMethod defines the ABI method name for the revenue
+	// WithdrawerRevenues query.
+	WithdrawerRevenuesMethod = "withdrawerRevenues"
+)
+
+// Revenue returns the revenue registration of a given contract.
+func (p Precompile) Revenue(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewRevenueRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.revenueKeeper.Revenue(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(NewRevenueFromResponse(res.Revenue))
+}
+
+// DeployerRevenues returns the contracts registered by a given deployer.
+func (p Precompile) DeployerRevenues(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDeployerRevenuesRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.revenueKeeper.DeployerRevenues(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(RevenuesOutput).FromResponse(res.ContractAddresses, res.Pagination)
+
+	return out.Pack(method.Outputs)
+}
+
+// WithdrawerRevenues returns the contracts that pay out revenue to a given withdrawer.
+func (p Precompile) WithdrawerRevenues(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewWithdrawerRevenuesRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.revenueKeeper.WithdrawerRevenues(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(RevenuesOutput).FromResponse(res.ContractAddresses, res.Pagination)
+
+	return out.Pack(method.Outputs)
+}
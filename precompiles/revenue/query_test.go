@@ -0,0 +1,68 @@
+package revenue_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v16/precompiles/revenue"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+func (s *PrecompileTestSuite) TestRevenueQueries() {
+	factoryAddr := evmosutiltx.GenerateAddress()
+	childAddr := crypto.CreateAddress(factoryAddr, 1)
+	withdrawerAddr := evmosutiltx.GenerateAddress()
+
+	stateDB := s.network.GetStateDB()
+	stateDB.SetCode(childAddr, dummyCode)
+
+	registerMethod := s.precompile.Methods[revenue.RegisterRevenueMethod]
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), factoryAddr, s.precompile, 200_000)
+	_, err := s.precompile.RegisterRevenue(ctx, contract, stateDB, &registerMethod, []interface{}{childAddr, withdrawerAddr, []uint64{1}})
+	s.Require().NoError(err)
+
+	s.Run("revenue", func() {
+		method := s.precompile.Methods[revenue.RevenueMethod]
+		bz, err := s.precompile.Revenue(ctx, contract, &method, []interface{}{childAddr})
+		s.Require().NoError(err)
+
+		var out struct{ Revenue revenue.Revenue }
+		s.Require().NoError(s.precompile.UnpackIntoInterface(&out, revenue.RevenueMethod, bz))
+		s.Require().Equal(childAddr, out.Revenue.ContractAddress)
+		s.Require().Equal(factoryAddr, out.Revenue.DeployerAddress)
+		s.Require().Equal(withdrawerAddr, out.Revenue.WithdrawerAddress)
+	})
+
+	s.Run("revenue - not registered", func() {
+		method := s.precompile.Methods[revenue.RevenueMethod]
+		_, err := s.precompile.Revenue(ctx, contract, &method, []interface{}{factoryAddr})
+		s.Require().Error(err)
+	})
+
+	for _, tc := range []struct {
+		methodName string
+		address    common.Address
+	}{
+		{revenue.DeployerRevenuesMethod, factoryAddr},
+		{revenue.WithdrawerRevenuesMethod, withdrawerAddr},
+	} {
+		s.Run(tc.methodName, func() {
+			method := s.precompile.Methods[tc.methodName]
+			args := []interface{}{tc.address, query.PageRequest{Limit: 10, CountTotal: true}}
+
+			var bz []byte
+			if tc.methodName == revenue.DeployerRevenuesMethod {
+				bz, err = s.precompile.DeployerRevenues(ctx, contract, &method, args)
+			} else {
+				bz, err = s.precompile.WithdrawerRevenues(ctx, contract, &method, args)
+			}
+			s.Require().NoError(err)
+
+			var out revenue.RevenuesOutput
+			s.Require().NoError(s.precompile.UnpackIntoInterface(&out, tc.methodName, bz))
+			s.Require().Equal([]common.Address{childAddr}, out.ContractAddresses)
+			s.Require().Equal(uint64(1), out.PageResponse.Total)
+		})
+	}
+}
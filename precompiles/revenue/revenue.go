@@ -0,0 +1,144 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package revenue
+
+import (
+	"embed"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	revenuekeeper "github.com/evmos/evmos/v16/x/revenue/v1/keeper"
+)
+
+// PrecompileAddress defines the revenue precompile address in Hex format
+const PrecompileAddress = "0x0000000000000000000000000000000000000805"
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for the revenue module.
+type Precompile struct {
+	cmn.Precompile
+	revenueKeeper revenuekeeper.Keeper
+}
+
+// NewPrecompile creates a new revenue Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	revenueKeeper revenuekeeper.Keeper,
+) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newABI,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		revenueKeeper: revenueKeeper,
+	}, nil
+}
+
+// Address defines the address of the revenue compile contract.
+// address: 0x0000000000000000000000000000000000000805
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// PrecompileName returns the name of the revenue precompile contract.
+func (Precompile) PrecompileName() string {
+	return "revenue"
+}
+
+// PrecompileVersion returns the interface version of the revenue precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract revenue methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// Revenue transactions
+	case RegisterRevenueMethod:
+		bz, err = p.RegisterRevenue(ctx, contract, stateDB, method, args)
+	case RegisterRevenueCreate2Method:
+		bz, err = p.RegisterRevenueCreate2(ctx, contract, stateDB, method, args)
+	case UpdateRevenueMethod:
+		bz, err = p.UpdateRevenue(ctx, contract, stateDB, method, args)
+	case CancelRevenueMethod:
+		bz, err = p.CancelRevenue(ctx, contract, stateDB, method, args)
+	// Revenue queries
+	case RevenueMethod:
+		bz, err = p.Revenue(ctx, contract, method, args)
+	case DeployerRevenuesMethod:
+		bz, err = p.DeployerRevenues(ctx, contract, method, args)
+	case WithdrawerRevenuesMethod:
+		bz, err = p.WithdrawerRevenues(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available revenue transactions are:
+//   - RegisterRevenue
+//   - RegisterRevenueCreate2
+//   - UpdateRevenue
+//   - CancelRevenue
+func (Precompile) IsTransaction(methodName string) bool {
+	switch methodName {
+	case RegisterRevenueMethod,
+		RegisterRevenueCreate2Method,
+		UpdateRevenueMethod,
+		CancelRevenueMethod:
+		return true
+	default:
+		return false
+	}
+}
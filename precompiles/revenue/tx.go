@@ -0,0 +1,235 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package revenue
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	revenuetypes "github.com/evmos/evmos/v16/x/revenue/v1/types"
+)
+
+const (
+	// RegisterRevenueMethod defines the ABI method name for the revenue
+	// RegisterRevenue transaction.
+	RegisterRevenueMethod = "registerRevenue"
+	// RegisterRevenueCreate2Method defines the ABI method name for the revenue
+	// RegisterRevenueCreate2 transaction.
+	RegisterRevenueCreate2Method = "registerRevenueCreate2"
+	// UpdateRevenueMethod defines the ABI method name for the revenue
+	// UpdateRevenue transaction.
+	UpdateRevenueMethod = "updateRevenue"
+	// CancelRevenueMethod defines the ABI method name for the revenue
+	// CancelRevenue transaction.
+	CancelRevenueMethod = "cancelRevenue"
+)
+
+// RegisterRevenue registers a contract deployed by the caller through the CREATE opcode.
+// The contract address is derived from the caller address and the given chain of nonces,
+// analogous to MsgRegisterRevenue. Contrary to the Cosmos message, the deployer can be a
+// contract, which allows factories to register the contracts they create, and contracts
+// to register themselves by passing their own address and no nonces.
+func (p Precompile) RegisterRevenue(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	contractAddr, withdrawerAddr, nonces, err := ParseRegisterRevenueArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := p.revenueKeeper.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, revenuetypes.ErrRevenueDisabled
+	}
+
+	deployerAddr := contract.CallerAddress
+	derivedContract := deployerAddr
+
+	// the contract can be the caller itself, created directly by the caller or
+	// created through one or more factory contracts. In the latter case, nonces
+	// contains the caller nonce for the origin factory contract, then the nonce
+	// of the factory for the creation of the next factory/contract.
+	for _, nonce := range nonces {
+		ctx.GasMeter().ConsumeGas(
+			params.AddrDerivationCostCreate,
+			"revenue registration: address derivation CREATE opcode",
+		)
+
+		derivedContract = crypto.CreateAddress(derivedContract, nonce)
+	}
+
+	if contractAddr != derivedContract {
+		return nil, fmt.Errorf(ErrDerivedAddressMismatch, deployerAddr, derivedContract, contractAddr)
+	}
+
+	if err := p.registerRevenue(ctx, stateDB, contractAddr, deployerAddr, withdrawerAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RegisterRevenueCreate2 registers a contract deployed by the caller through the CREATE2 opcode.
+// The contract address is derived from the caller address, the salt and the init code hash.
+func (p Precompile) RegisterRevenueCreate2(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	contractAddr, withdrawerAddr, salt, initCodeHash, err := ParseRegisterRevenueCreate2Args(args)
+	if err != nil {
+		return nil, err
+	}
+
+	params := p.revenueKeeper.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, revenuetypes.ErrRevenueDisabled
+	}
+
+	deployerAddr := contract.CallerAddress
+
+	ctx.GasMeter().ConsumeGas(
+		params.AddrDerivationCostCreate,
+		"revenue registration: address derivation CREATE2 opcode",
+	)
+
+	derivedContract := crypto.CreateAddress2(deployerAddr, salt, initCodeHash[:])
+	if contractAddr != derivedContract {
+		return nil, fmt.Errorf(ErrDerivedAddressMismatch, deployerAddr, derivedContract, contractAddr)
+	}
+
+	if err := p.registerRevenue(ctx, stateDB, contractAddr, deployerAddr, withdrawerAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// UpdateRevenue updates the withdrawer address of a contract registered by the caller.
+func (p Precompile) UpdateRevenue(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, contractAddr, withdrawerAddr, err := NewMsgUpdateRevenue(contract.CallerAddress, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.revenueKeeper.UpdateRevenue(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	// NOTE: the withdrawer is reset to the deployer if it is set to the caller address
+	if withdrawerAddr == contract.CallerAddress {
+		withdrawerAddr = common.Address{}
+	}
+
+	if err := p.EmitUpdateRevenueEvent(ctx, stateDB, contractAddr, contract.CallerAddress, withdrawerAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelRevenue cancels the revenue registration of a contract registered by the caller.
+func (p Precompile) CancelRevenue(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, contractAddr, err := NewMsgCancelRevenue(contract.CallerAddress, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.revenueKeeper.CancelRevenue(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCancelRevenueEvent(ctx, stateDB, contractAddr, contract.CallerAddress); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// registerRevenue stores the revenue registration of a contract whose address has
+// already been derived from the deployer address.
+func (p Precompile) registerRevenue(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	contractAddr, deployerAddr, withdrawerAddr common.Address,
+) error {
+	if p.revenueKeeper.IsRevenueRegistered(ctx, contractAddr) {
+		return errorsmod.Wrapf(
+			revenuetypes.ErrRevenueAlreadyRegistered,
+			"contract is already registered %s", contractAddr,
+		)
+	}
+
+	// contract must already be deployed, to avoid spam registrations.
+	// NOTE: the code is checked on the state DB so that contracts created
+	// within the same transaction can be registered.
+	if stateDB.GetCodeSize(contractAddr) == 0 {
+		return errorsmod.Wrapf(
+			revenuetypes.ErrRevenueNoContractDeployed,
+			"no contract code found at address %s", contractAddr,
+		)
+	}
+
+	// prevent storing the same address for deployer and withdrawer
+	if withdrawerAddr == deployerAddr {
+		withdrawerAddr = common.Address{}
+	}
+
+	var withdrawer sdk.AccAddress
+	if withdrawerAddr != (common.Address{}) {
+		withdrawer = withdrawerAddr.Bytes()
+	}
+
+	deployer := sdk.AccAddress(deployerAddr.Bytes())
+
+	revenue := revenuetypes.NewRevenue(contractAddr, deployer, withdrawer)
+	p.revenueKeeper.SetRevenue(ctx, revenue)
+	p.revenueKeeper.SetDeployerMap(ctx, deployer, contractAddr)
+
+	// The effective withdrawer is the withdraw address that is stored after the
+	// revenue registration is completed. It defaults to the deployer address if
+	// the withdrawer is omitted. When omitted, the withdraw map doesn't need to be set.
+	effectiveWithdrawer := deployer.String()
+
+	if len(withdrawer) != 0 {
+		p.revenueKeeper.SetWithdrawerMap(ctx, withdrawer, contractAddr)
+		effectiveWithdrawer = withdrawer.String()
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				revenuetypes.EventTypeRegisterRevenue,
+				sdk.NewAttribute(sdk.AttributeKeySender, deployer.String()),
+				sdk.NewAttribute(revenuetypes.AttributeKeyContract, contractAddr.String()),
+				sdk.NewAttribute(revenuetypes.AttributeKeyWithdrawerAddress, effectiveWithdrawer),
+			),
+		},
+	)
+
+	return p.EmitRegisterRevenueEvent(ctx, stateDB, contractAddr, deployerAddr, withdrawerAddr)
+}
@@ -0,0 +1,289 @@
+package revenue_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	"github.com/evmos/evmos/v16/precompiles/revenue"
+	"github.com/evmos/evmos/v16/precompiles/testutil"
+	evmosutiltx "github.com/evmos/evmos/v16/testutil/tx"
+)
+
+// dummyCode is the code set on the accounts that act as deployed contracts in the tests.
+var dummyCode = []byte{0x60, 0x00}
+
+func (s *PrecompileTestSuite) TestRegisterRevenue() {
+	method := s.precompile.Methods[revenue.RegisterRevenueMethod]
+	factoryAddr := evmosutiltx.GenerateAddress()
+	childAddr := crypto.CreateAddress(factoryAddr, 1)
+	grandChildAddr := crypto.CreateAddress(childAddr, 3)
+	withdrawerAddr := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - zero contract address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, withdrawerAddr, []uint64{1}}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(revenue.ErrInvalidContract, common.Address{}),
+		},
+		{
+			"fail - wrong nonce",
+			func() []interface{} {
+				return []interface{}{childAddr, withdrawerAddr, []uint64{2}}
+			},
+			func() {},
+			true,
+			"is not the contract deployer",
+		},
+		{
+			"fail - no contract deployed",
+			func() []interface{} {
+				return []interface{}{crypto.CreateAddress(factoryAddr, 5), withdrawerAddr, []uint64{5}}
+			},
+			func() {},
+			true,
+			"no contract code found",
+		},
+		{
+			"success - factory registers child contract",
+			func() []interface{} {
+				return []interface{}{childAddr, withdrawerAddr, []uint64{1}}
+			},
+			func() {
+				rev, found := s.network.App.RevenueKeeper.GetRevenue(s.network.GetContext(), childAddr)
+				s.Require().True(found)
+				s.Require().Equal(sdk.AccAddress(factoryAddr.Bytes()).String(), rev.DeployerAddress)
+				s.Require().Equal(sdk.AccAddress(withdrawerAddr.Bytes()).String(), rev.WithdrawerAddress)
+			},
+			false,
+			"",
+		},
+		{
+			"success - factory registers contract created by child factory",
+			func() []interface{} {
+				return []interface{}{grandChildAddr, common.Address{}, []uint64{1, 3}}
+			},
+			func() {
+				rev, found := s.network.App.RevenueKeeper.GetRevenue(s.network.GetContext(), grandChildAddr)
+				s.Require().True(found)
+				s.Require().Equal(sdk.AccAddress(factoryAddr.Bytes()).String(), rev.DeployerAddress)
+				s.Require().Empty(rev.WithdrawerAddress)
+			},
+			false,
+			"",
+		},
+		{
+			"success - contract registers itself",
+			func() []interface{} {
+				return []interface{}{factoryAddr, factoryAddr, []uint64{}}
+			},
+			func() {
+				rev, found := s.network.App.RevenueKeeper.GetRevenue(s.network.GetContext(), factoryAddr)
+				s.Require().True(found)
+				s.Require().Equal(sdk.AccAddress(factoryAddr.Bytes()).String(), rev.DeployerAddress)
+				s.Require().Empty(rev.WithdrawerAddress, "withdrawer should not be set if equal to the deployer")
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			for _, addr := range []common.Address{factoryAddr, childAddr, grandChildAddr} {
+				stateDB.SetCode(addr, dummyCode)
+			}
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), factoryAddr, s.precompile, 200_000)
+
+			bz, err := s.precompile.RegisterRevenue(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				s.Require().Len(stateDB.Logs(), 1)
+				s.Require().Equal(s.precompile.Events[revenue.EventTypeRegisterRevenue].ID, stateDB.Logs()[0].Topics[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRegisterRevenueCreate2() {
+	method := s.precompile.Methods[revenue.RegisterRevenueCreate2Method]
+	factoryAddr := evmosutiltx.GenerateAddress()
+	salt := [32]byte{1}
+	initCodeHash := crypto.Keccak256Hash([]byte("init code"))
+	childAddr := crypto.CreateAddress2(factoryAddr, salt, initCodeHash.Bytes())
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - wrong salt",
+			func() []interface{} {
+				return []interface{}{childAddr, common.Address{}, [32]byte{2}, [32]byte(initCodeHash)}
+			},
+			true,
+			"is not the contract deployer",
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{childAddr, common.Address{}, salt, [32]byte(initCodeHash)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			stateDB.SetCode(childAddr, dummyCode)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), factoryAddr, s.precompile, 200_000)
+
+			bz, err := s.precompile.RegisterRevenueCreate2(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				s.Require().True(s.network.App.RevenueKeeper.IsRevenueRegistered(s.network.GetContext(), childAddr))
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUpdateAndCancelRevenue() {
+	factoryAddr := evmosutiltx.GenerateAddress()
+	childAddr := crypto.CreateAddress(factoryAddr, 1)
+	withdrawerAddr := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		caller      common.Address
+		method      string
+		args        []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - update from a different deployer",
+			evmosutiltx.GenerateAddress(),
+			revenue.UpdateRevenueMethod,
+			[]interface{}{childAddr, withdrawerAddr},
+			func() {},
+			true,
+			"is not the contract deployer",
+		},
+		{
+			"fail - cancel from a different deployer",
+			evmosutiltx.GenerateAddress(),
+			revenue.CancelRevenueMethod,
+			[]interface{}{childAddr},
+			func() {},
+			true,
+			"is not the contract deployer",
+		},
+		{
+			"success - update withdrawer",
+			factoryAddr,
+			revenue.UpdateRevenueMethod,
+			[]interface{}{childAddr, withdrawerAddr},
+			func() {
+				rev, found := s.network.App.RevenueKeeper.GetRevenue(s.network.GetContext(), childAddr)
+				s.Require().True(found)
+				s.Require().Equal(sdk.AccAddress(withdrawerAddr.Bytes()).String(), rev.WithdrawerAddress)
+			},
+			false,
+			"",
+		},
+		{
+			"success - cancel revenue",
+			factoryAddr,
+			revenue.CancelRevenueMethod,
+			[]interface{}{childAddr},
+			func() {
+				s.Require().False(s.network.App.RevenueKeeper.IsRevenueRegistered(s.network.GetContext(), childAddr))
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			stateDB.SetCode(childAddr, dummyCode)
+
+			// register the child contract from the factory
+			registerMethod := s.precompile.Methods[revenue.RegisterRevenueMethod]
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), factoryAddr, s.precompile, 200_000)
+			_, err := s.precompile.RegisterRevenue(ctx, contract, stateDB, &registerMethod, []interface{}{childAddr, common.Address{}, []uint64{1}})
+			s.Require().NoError(err)
+
+			method := s.precompile.Methods[tc.method]
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), tc.caller, s.precompile, 200_000)
+
+			var bz []byte
+			if tc.method == revenue.UpdateRevenueMethod {
+				bz, err = s.precompile.UpdateRevenue(ctx, contract, stateDB, &method, tc.args)
+			} else {
+				bz, err = s.precompile.CancelRevenue(ctx, contract, stateDB, &method, tc.args)
+			}
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
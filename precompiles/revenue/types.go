@@ -0,0 +1,283 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package revenue
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v16/precompiles/common"
+	revenuetypes "github.com/evmos/evmos/v16/x/revenue/v1/types"
+)
+
+// EventRegisterRevenue defines the event data for the RegisterRevenue transaction.
+type EventRegisterRevenue struct {
+	ContractAddress   common.Address
+	DeployerAddress   common.Address
+	WithdrawerAddress common.Address
+}
+
+// EventUpdateRevenue defines the event data for the UpdateRevenue transaction.
+type EventUpdateRevenue struct {
+	ContractAddress   common.Address
+	DeployerAddress   common.Address
+	WithdrawerAddress common.Address
+}
+
+// EventCancelRevenue defines the event data for the CancelRevenue transaction.
+type EventCancelRevenue struct {
+	ContractAddress common.Address
+	DeployerAddress common.Address
+}
+
+// Revenue is a struct to represent the key information from a
+// revenue registration, using EVM native types.
+type Revenue struct {
+	ContractAddress   common.Address
+	DeployerAddress   common.Address
+	WithdrawerAddress common.Address
+}
+
+// NewRevenueFromResponse converts a revenue registration from the x/revenue module
+// into its EVM representation. The withdrawer address is the zero address if the
+// revenue is paid out to the deployer.
+func NewRevenueFromResponse(revenue revenuetypes.Revenue) Revenue {
+	var withdrawer common.Address
+	if withdrawerAddr := revenue.GetWithdrawerAddr(); len(withdrawerAddr) != 0 {
+		withdrawer = common.BytesToAddress(withdrawerAddr)
+	}
+
+	return Revenue{
+		ContractAddress:   revenue.GetContractAddr(),
+		DeployerAddress:   common.BytesToAddress(revenue.GetDeployerAddr()),
+		WithdrawerAddress: withdrawer,
+	}
+}
+
+// DeployerRevenuesInput is a struct to represent the input information for
+// the deployerRevenues query. Needed to unpack arguments into the PageRequest struct.
+type DeployerRevenuesInput struct {
+	DeployerAddress common.Address
+	PageRequest     query.PageRequest
+}
+
+// WithdrawerRevenuesInput is a struct to represent the input information for
+// the withdrawerRevenues query. Needed to unpack arguments into the PageRequest struct.
+type WithdrawerRevenuesInput struct {
+	WithdrawerAddress common.Address
+	PageRequest       query.PageRequest
+}
+
+// RevenuesOutput is a struct to represent the key information from
+// a deployerRevenues or withdrawerRevenues response.
+type RevenuesOutput struct {
+	ContractAddresses []common.Address
+	PageResponse      query.PageResponse
+}
+
+// FromResponse populates the RevenuesOutput from the contract addresses and
+// pagination of a DeployerRevenues or WithdrawerRevenues response.
+func (ro *RevenuesOutput) FromResponse(contracts []string, pageRes *query.PageResponse) *RevenuesOutput {
+	ro.ContractAddresses = make([]common.Address, len(contracts))
+	for i, contract := range contracts {
+		ro.ContractAddresses[i] = common.HexToAddress(contract)
+	}
+
+	if pageRes != nil {
+		ro.PageResponse.Total = pageRes.Total
+		ro.PageResponse.NextKey = pageRes.NextKey
+	}
+
+	return ro
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (ro *RevenuesOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(ro.ContractAddresses, ro.PageResponse)
+}
+
+// ParseRegisterRevenueArgs parses the arguments for the RegisterRevenue method.
+func ParseRegisterRevenueArgs(args []interface{}) (contract, withdrawer common.Address, nonces []uint64, err error) {
+	if len(args) != 3 {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	contract, withdrawer, err = parseContractAndWithdrawer(args)
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, err
+	}
+
+	nonces, ok := args[2].([]uint64)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "nonces", []uint64{}, args[2])
+	}
+
+	return contract, withdrawer, nonces, nil
+}
+
+// ParseRegisterRevenueCreate2Args parses the arguments for the RegisterRevenueCreate2 method.
+func ParseRegisterRevenueCreate2Args(args []interface{}) (contract, withdrawer common.Address, salt, initCodeHash [32]byte, err error) {
+	if len(args) != 4 {
+		return common.Address{}, common.Address{}, [32]byte{}, [32]byte{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	contract, withdrawer, err = parseContractAndWithdrawer(args)
+	if err != nil {
+		return common.Address{}, common.Address{}, [32]byte{}, [32]byte{}, err
+	}
+
+	salt, ok := args[2].([32]byte)
+	if !ok {
+		return common.Address{}, common.Address{}, [32]byte{}, [32]byte{}, fmt.Errorf(cmn.ErrInvalidType, "salt", [32]byte{}, args[2])
+	}
+
+	initCodeHash, ok = args[3].([32]byte)
+	if !ok {
+		return common.Address{}, common.Address{}, [32]byte{}, [32]byte{}, fmt.Errorf(cmn.ErrInvalidType, "initCodeHash", [32]byte{}, args[3])
+	}
+
+	return contract, withdrawer, salt, initCodeHash, nil
+}
+
+// NewMsgUpdateRevenue creates a new MsgUpdateRevenue instance for the given deployer and
+// does sanity checks on the provided arguments.
+func NewMsgUpdateRevenue(deployer common.Address, args []interface{}) (*revenuetypes.MsgUpdateRevenue, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	contract, withdrawer, err := parseContractAndWithdrawer(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	// NOTE: an empty withdrawer address resets the withdrawer to the deployer
+	withdrawerAddr := ""
+	if withdrawer != (common.Address{}) {
+		withdrawerAddr = sdk.AccAddress(withdrawer.Bytes()).String()
+	}
+
+	msg := &revenuetypes.MsgUpdateRevenue{
+		ContractAddress:   contract.Hex(),
+		DeployerAddress:   sdk.AccAddress(deployer.Bytes()).String(),
+		WithdrawerAddress: withdrawerAddr,
+	}
+
+	return msg, contract, withdrawer, nil
+}
+
+// NewMsgCancelRevenue creates a new MsgCancelRevenue instance for the given deployer and
+// does sanity checks on the provided arguments.
+func NewMsgCancelRevenue(deployer common.Address, args []interface{}) (*revenuetypes.MsgCancelRevenue, common.Address, error) {
+	contract, err := parseContractArg(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &revenuetypes.MsgCancelRevenue{
+		ContractAddress: contract.Hex(),
+		DeployerAddress: sdk.AccAddress(deployer.Bytes()).String(),
+	}
+
+	return msg, contract, nil
+}
+
+// NewRevenueRequest creates a new QueryRevenueRequest instance and does sanity
+// checks on the provided arguments.
+func NewRevenueRequest(args []interface{}) (*revenuetypes.QueryRevenueRequest, error) {
+	contract, err := parseContractArg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &revenuetypes.QueryRevenueRequest{
+		ContractAddress: contract.Hex(),
+	}, nil
+}
+
+// NewDeployerRevenuesRequest creates a new QueryDeployerRevenuesRequest instance and does sanity
+// checks on the provided arguments.
+func NewDeployerRevenuesRequest(method *abi.Method, args []interface{}) (*revenuetypes.QueryDeployerRevenuesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DeployerRevenuesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to DeployerRevenuesInput struct: %s", err)
+	}
+
+	if input.DeployerAddress == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidDeployer, args[0])
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &revenuetypes.QueryDeployerRevenuesRequest{
+		DeployerAddress: sdk.AccAddress(input.DeployerAddress.Bytes()).String(),
+		Pagination:      &input.PageRequest,
+	}, nil
+}
+
+// NewWithdrawerRevenuesRequest creates a new QueryWithdrawerRevenuesRequest instance and does sanity
+// checks on the provided arguments.
+func NewWithdrawerRevenuesRequest(method *abi.Method, args []interface{}) (*revenuetypes.QueryWithdrawerRevenuesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input WithdrawerRevenuesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to WithdrawerRevenuesInput struct: %s", err)
+	}
+
+	if input.WithdrawerAddress == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidWithdrawer, args[0])
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &revenuetypes.QueryWithdrawerRevenuesRequest{
+		WithdrawerAddress: sdk.AccAddress(input.WithdrawerAddress.Bytes()).String(),
+		Pagination:        &input.PageRequest,
+	}, nil
+}
+
+// parseContractArg parses a single non-zero contract address argument.
+func parseContractArg(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	contract, ok := args[0].(common.Address)
+	if !ok || contract == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidContract, args[0])
+	}
+
+	return contract, nil
+}
+
+// parseContractAndWithdrawer parses the contract and withdrawer address arguments,
+// which are the first two arguments of all the revenue transactions.
+func parseContractAndWithdrawer(args []interface{}) (common.Address, common.Address, error) {
+	contract, ok := args[0].(common.Address)
+	if !ok || contract == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidContract, args[0])
+	}
+
+	withdrawer, ok := args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "withdrawerAddress", common.Address{}, args[1])
+	}
+
+	return contract, withdrawer, nil
+}
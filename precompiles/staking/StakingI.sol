@@ -12,11 +12,20 @@ StakingI constant STAKING_CONTRACT = StakingI(STAKING_PRECOMPILE_ADDRESS);
 
 /// @dev Define all the available staking methods.
 string constant MSG_CREATE_VALIDATOR = "/cosmos.staking.v1beta1.MsgCreateValidator";
+string constant MSG_EDIT_VALIDATOR = "/cosmos.staking.v1beta1.MsgEditValidator";
 string constant MSG_DELEGATE = "/cosmos.staking.v1beta1.MsgDelegate";
 string constant MSG_UNDELEGATE = "/cosmos.staking.v1beta1.MsgUndelegate";
 string constant MSG_REDELEGATE = "/cosmos.staking.v1beta1.MsgBeginRedelegate";
 string constant MSG_CANCEL_UNDELEGATION = "/cosmos.staking.v1beta1.MsgCancelUnbondingDelegation";
 
+/// @dev Defines the value of the description fields that are left unchanged
+/// when editing a validator.
+string constant DO_NOT_MODIFY_DESCRIPTION = "[do-not-modify]";
+
+/// @dev Defines the value of the commission rate and minimum self delegation
+/// that are left unchanged when editing a validator.
+int256 constant DO_NOT_MODIFY = -1;
+
 /// @dev Defines the initial description to be used for creating
 /// a validator.
 struct Description {
@@ -110,6 +119,13 @@ struct UnbondingDelegationOutput {
     UnbondingDelegationEntry[] entries;
 }
 
+/// @dev Represents a delegation of a delegator to a single validator.
+struct DelegatorDelegation {
+    string validatorAddress;
+    uint256 shares;
+    Coin balance;
+}
+
 /// @dev The status of the validator.
 enum BondStatus {
     Unspecified,
@@ -142,6 +158,19 @@ interface StakingI is authorization.AuthorizationI {
         uint256 value
     ) external returns (bool success);
 
+    /// @dev Defines a method for editing a validator. The caller must be the validator operator.
+    /// @param description The new description, fields set to DO_NOT_MODIFY_DESCRIPTION are left unchanged
+    /// @param validatorAddress The validator address
+    /// @param commissionRate The new commission rate with a precision of 18 decimals, or DO_NOT_MODIFY
+    /// @param minSelfDelegation The new minimum self delegation, or DO_NOT_MODIFY
+    /// @return success Whether or not the edit validator was successful
+    function editValidator(
+        Description calldata description,
+        address validatorAddress,
+        int256 commissionRate,
+        int256 minSelfDelegation
+    ) external returns (bool success);
+
     /// @dev Defines a method for performing a delegation of coins from a delegator to a validator.
     /// @param delegatorAddress The address of the delegator
     /// @param validatorAddress The address of the validator
@@ -192,6 +221,44 @@ interface StakingI is authorization.AuthorizationI {
         uint256 creationHeight
     ) external returns (bool success);
 
+    /// @dev Defines a method for performing delegations of coins from a delegator
+    /// to multiple validators in a single transaction.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddresses The addresses of the validators
+    /// @param amounts The amounts of the Coin to be delegated to each validator
+    /// @return success Whether or not the delegations were successful
+    function delegateMany(
+        address delegatorAddress,
+        string[] memory validatorAddresses,
+        uint256[] memory amounts
+    ) external returns (bool success);
+
+    /// @dev Defines a method for performing undelegations from multiple validators
+    /// in a single transaction.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddresses The addresses of the validators
+    /// @param amounts The amounts to be undelegated from each validator
+    /// @return completionTimes The times when each undelegation is completed
+    function undelegateMany(
+        address delegatorAddress,
+        string[] memory validatorAddresses,
+        uint256[] memory amounts
+    ) external returns (int64[] memory completionTimes);
+
+    /// @dev Defines a method for performing multiple redelegations
+    /// of coins from source validators to destination validators in a single transaction.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorSrcAddresses The validators from which the redelegations are initiated
+    /// @param validatorDstAddresses The validators to which the redelegations are destined
+    /// @param amounts The amounts to be redelegated
+    /// @return completionTimes The times when each redelegation is completed
+    function redelegateMany(
+        address delegatorAddress,
+        string[] memory validatorSrcAddresses,
+        string[] memory validatorDstAddresses,
+        uint256[] memory amounts
+    ) external returns (int64[] memory completionTimes);
+
     /// @dev Queries the given amount of the bond denomination to a validator.
     /// @param delegatorAddress The address of the delegator.
     /// @param validatorAddress The address of the validator.
@@ -266,6 +333,44 @@ interface StakingI is authorization.AuthorizationI {
             PageResponse calldata pageResponse
         );
 
+    /// @dev Queries all delegations of a given delegator.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return delegations The delegations of the given delegator.
+    function delegatorDelegations(
+        address delegatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            DelegatorDelegation[] calldata delegations,
+            PageResponse calldata pageResponse
+        );
+
+    /// @dev Queries all unbonding delegations of a given delegator.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return unbondingDelegations The unbonding delegations of the given delegator.
+    function delegatorUnbondingDelegations(
+        address delegatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            UnbondingDelegationOutput[] calldata unbondingDelegations,
+            PageResponse calldata pageResponse
+        );
+
+    /// @dev Queries the amount of bonded and not bonded tokens of the staking pool.
+    /// @return notBondedTokens The amount of tokens that are not bonded.
+    /// @return bondedTokens The amount of tokens that are bonded.
+    function pool()
+        external
+        view
+        returns (uint256 notBondedTokens, uint256 bondedTokens);
+
     /// @dev CreateValidator defines an Event emitted when a create a new validator.
     /// @param validatorAddress The address of the validator
     /// @param value The amount of coin being self delegated
@@ -274,6 +379,16 @@ interface StakingI is authorization.AuthorizationI {
         uint256 value
     );
 
+    /// @dev EditValidator defines an Event emitted when a validator is edited.
+    /// @param validatorAddress The address of the validator
+    /// @param commissionRate The new commission rate, or DO_NOT_MODIFY if unchanged
+    /// @param minSelfDelegation The new minimum self delegation, or DO_NOT_MODIFY if unchanged
+    event EditValidator(
+        address indexed validatorAddress,
+        int256 commissionRate,
+        int256 minSelfDelegation
+    );
+
     /// @dev Delegate defines an Event emitted when a given amount of tokens are delegated from the
     /// delegator address to the validator address.
     /// @param delegatorAddress The address of the delegator
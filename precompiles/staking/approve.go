@@ -338,6 +338,35 @@ func (p Precompile) UpdateStakingAuthorization(
 	return nil
 }
 
+// UpdateStakingAuthorizationBatch updates the staking grant of the given grantee and granter
+// by accepting the given messages in order, and stores the resulting grant once.
+func (p Precompile) UpdateStakingAuthorizationBatch(
+	ctx sdk.Context,
+	grantee, granter common.Address,
+	stakeAuthz *stakingtypes.StakeAuthorization,
+	expiration *time.Time,
+	messageType string,
+	msgs []sdk.Msg,
+) error {
+	var updated authz.Authorization = stakeAuthz
+	for _, msg := range msgs {
+		updatedResponse, err := updated.Accept(ctx, msg)
+		if err != nil {
+			return err
+		}
+
+		// NOTE: the total amount of the batch has already been checked against the
+		// allowance, so the grant can only be exhausted by the last message
+		if updatedResponse.Delete {
+			return p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), messageType)
+		}
+
+		updated = updatedResponse.Updated
+	}
+
+	return p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), updated, expiration)
+}
+
 // convertMsgToAuthz converts a msg to an authorization type.
 func convertMsgToAuthz(msg string) (stakingtypes.AuthorizationType, error) {
 	switch msg {
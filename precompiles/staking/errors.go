@@ -8,6 +8,12 @@ const (
 	ErrDecreaseAmountTooBig = "amount by which the allowance should be decreased is greater than the authorization limit: %s > %s"
 	// ErrDifferentOriginFromDelegator is raised when the origin address is not the same as the delegator address.
 	ErrDifferentOriginFromDelegator = "origin address %s is not the same as delegator address %s"
+	// ErrDifferentCallerFromValidator is raised when the caller address is not the same as the validator address.
+	ErrDifferentCallerFromValidator = "caller address %s is not the same as validator address %s"
 	// ErrNoDelegationFound is raised when no delegation is found for the given delegator and validator addresses.
 	ErrNoDelegationFound = "delegation with delegator %s not found for validator %s"
+	// ErrEmptyBatch is raised when a batch transaction is called without any items.
+	ErrEmptyBatch = "batch cannot be empty"
+	// ErrBatchLengthMismatch is raised when the arrays of a batch transaction have different lengths.
+	ErrBatchLengthMismatch = "batch arguments length mismatch: %d validators and %d amounts"
 )
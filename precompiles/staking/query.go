@@ -36,6 +36,15 @@ const (
 	// RedelegationsMethod defines the ABI method name for the staking
 	// Redelegations query.
 	RedelegationsMethod = "redelegations"
+	// DelegatorDelegationsMethod defines the ABI method name for the staking
+	// DelegatorDelegations query.
+	DelegatorDelegationsMethod = "delegatorDelegations"
+	// DelegatorUnbondingDelegationsMethod defines the ABI method name for the staking
+	// DelegatorUnbondingDelegations query.
+	DelegatorUnbondingDelegationsMethod = "delegatorUnbondingDelegations"
+	// PoolMethod defines the ABI method name for the staking
+	// Pool query.
+	PoolMethod = "pool"
 )
 
 // Delegation returns the delegation that a delegator has with a specific validator.
@@ -196,6 +205,75 @@ func (p Precompile) Redelegations(
 	return out.Pack(method.Outputs)
 }
 
+// DelegatorDelegations returns all the delegations of a delegator with pagination.
+func (p Precompile) DelegatorDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDelegatorDelegationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: &p.stakingKeeper}
+
+	res, err := queryServer.DelegatorDelegations(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegatorDelegationsOutput).FromResponse(res)
+
+	return out.Pack(method.Outputs)
+}
+
+// DelegatorUnbondingDelegations returns all the unbonding delegations of a delegator with pagination.
+func (p Precompile) DelegatorUnbondingDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDelegatorUnbondingDelegationsRequest(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: &p.stakingKeeper}
+
+	res, err := queryServer.DelegatorUnbondingDelegations(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegatorUnbondingDelegationsOutput).FromResponse(res)
+
+	return out.Pack(method.Outputs)
+}
+
+// Pool returns the amount of bonded and not bonded tokens of the staking pool.
+func (p Precompile) Pool(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	queryServer := stakingkeeper.Querier{Keeper: &p.stakingKeeper}
+
+	res, err := queryServer.Pool(sdk.WrapSDKContext(ctx), &stakingtypes.QueryPoolRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Pool.NotBondedTokens.BigInt(), res.Pool.BondedTokens.BigInt())
+}
+
 // Allowance returns the remaining allowance of a grantee to the contract.
 func (p Precompile) Allowance(
 	ctx sdk.Context,
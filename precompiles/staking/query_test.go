@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -785,3 +786,129 @@ func (s *PrecompileTestSuite) TestAllowance() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDelegatorDelegations() {
+	method := s.precompile.Methods[staking.DelegatorDelegationsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - zero delegator address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidDelegator, common.Address{}),
+		},
+		{
+			"success - no delegations",
+			func() []interface{} {
+				return []interface{}{testutiltx.GenerateAddress(), query.PageRequest{}}
+			},
+			func(bz []byte) {
+				var out staking.DelegatorDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegatorDelegationsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Empty(out.Delegations)
+			},
+			false,
+			"",
+		},
+		{
+			"success - delegations with pagination",
+			func() []interface{} {
+				return []interface{}{s.address, query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			func(bz []byte) {
+				var out staking.DelegatorDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegatorDelegationsMethod, bz)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.Delegations, 1)
+				s.Require().Equal(len(s.validators), int(out.PageResponse.Total))
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+				s.Require().Equal(s.bondDenom, out.Delegations[0].Balance.Denom)
+				s.Require().Equal(big.NewInt(1e18), out.Delegations[0].Balance.Amount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 100000)
+
+			bz, err := s.precompile.DelegatorDelegations(s.ctx, &method, contract, tc.malleate())
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDelegatorUnbondingDelegations() {
+	method := s.precompile.Methods[staking.DelegatorUnbondingDelegationsMethod]
+
+	s.SetupTest()
+
+	// undelegate from the first validator
+	undelegateMsg := stakingtypes.NewMsgUndelegate(s.address.Bytes(), s.validators[0].GetOperator(), sdk.NewCoin(s.bondDenom, math.NewInt(5e17)))
+	_, err := stakingkeeper.NewMsgServerImpl(&s.app.StakingKeeper).Undelegate(s.ctx, undelegateMsg)
+	s.Require().NoError(err)
+
+	contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 100000)
+
+	bz, err := s.precompile.DelegatorUnbondingDelegations(s.ctx, &method, contract, []interface{}{s.address, query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+
+	var out staking.DelegatorUnbondingDelegationsOutput
+	err = s.precompile.UnpackIntoInterface(&out, staking.DelegatorUnbondingDelegationsMethod, bz)
+	s.Require().NoError(err, "failed to unpack output")
+	s.Require().Len(out.UnbondingDelegations, 1)
+	s.Require().Equal(uint64(1), out.PageResponse.Total)
+	s.Require().Equal(s.validators[0].OperatorAddress, out.UnbondingDelegations[0].ValidatorAddress)
+	s.Require().Len(out.UnbondingDelegations[0].Entries, 1)
+	s.Require().Equal(big.NewInt(5e17), out.UnbondingDelegations[0].Entries[0].Balance)
+}
+
+func (s *PrecompileTestSuite) TestPool() {
+	method := s.precompile.Methods[staking.PoolMethod]
+
+	s.SetupTest()
+
+	contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 100000)
+
+	_, err := s.precompile.Pool(s.ctx, &method, contract, []interface{}{s.address})
+	s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1))
+
+	bz, err := s.precompile.Pool(s.ctx, &method, contract, []interface{}{})
+	s.Require().NoError(err)
+
+	out, err := s.precompile.Unpack(staking.PoolMethod, bz)
+	s.Require().NoError(err)
+
+	notBondedTokens, ok := out[0].(*big.Int)
+	s.Require().True(ok)
+	s.Require().Zero(notBondedTokens.Sign(), "expected no tokens in the not bonded pool")
+	s.Require().Equal(s.app.StakingKeeper.TotalBondedTokens(s.ctx).BigInt(), out[1])
+}
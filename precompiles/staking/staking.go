@@ -83,6 +83,16 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress(PrecompileAddress)
 }
 
+// PrecompileName returns the name of the staking precompile contract.
+func (Precompile) PrecompileName() string {
+	return "staking"
+}
+
+// PrecompileVersion returns the interface version of the staking precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v2"
+}
+
 // Run executes the precompiled contract staking methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
 	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
@@ -94,7 +104,9 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
-	if err := stateDB.Commit(); err != nil {
+	// write the dirty EVM state to the branched context, so that the keepers
+	// see the balances changed earlier in the transaction
+	if err := stateDB.Flush(); err != nil {
 		return nil, err
 	}
 
@@ -111,6 +123,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// Staking transactions
 	case CreateValidatorMethod:
 		bz, err = p.CreateValidator(ctx, evm.Origin, contract, stateDB, method, args)
+	case EditValidatorMethod:
+		bz, err = p.EditValidator(ctx, evm.Origin, contract, stateDB, method, args)
 	case DelegateMethod:
 		bz, err = p.Delegate(ctx, evm.Origin, contract, stateDB, method, args)
 	case UndelegateMethod:
@@ -119,6 +133,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegate(ctx, evm.Origin, contract, stateDB, method, args)
 	case CancelUnbondingDelegationMethod:
 		bz, err = p.CancelUnbondingDelegation(ctx, evm.Origin, contract, stateDB, method, args)
+	case DelegateManyMethod:
+		bz, err = p.DelegateMany(ctx, evm.Origin, contract, stateDB, method, args)
+	case UndelegateManyMethod:
+		bz, err = p.UndelegateMany(ctx, evm.Origin, contract, stateDB, method, args)
+	case RedelegateManyMethod:
+		bz, err = p.RedelegateMany(ctx, evm.Origin, contract, stateDB, method, args)
 	// Staking queries
 	case DelegationMethod:
 		bz, err = p.Delegation(ctx, contract, method, args)
@@ -132,6 +152,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegation(ctx, method, contract, args)
 	case RedelegationsMethod:
 		bz, err = p.Redelegations(ctx, method, contract, args)
+	case DelegatorDelegationsMethod:
+		bz, err = p.DelegatorDelegations(ctx, method, contract, args)
+	case DelegatorUnbondingDelegationsMethod:
+		bz, err = p.DelegatorUnbondingDelegations(ctx, method, contract, args)
+	case PoolMethod:
+		bz, err = p.Pool(ctx, method, contract, args)
 	// Authorization queries
 	case authorization.AllowanceMethod:
 		bz, err = p.Allowance(ctx, method, contract, args)
@@ -154,10 +180,14 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available staking transactions are:
 //   - CreateValidator
+//   - EditValidator
 //   - Delegate
 //   - Undelegate
 //   - Redelegate
 //   - CancelUnbondingDelegation
+//   - DelegateMany
+//   - UndelegateMany
+//   - RedelegateMany
 //
 // Available authorization transactions are:
 //   - Approve
@@ -167,10 +197,14 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 func (Precompile) IsTransaction(method string) bool {
 	switch method {
 	case CreateValidatorMethod,
+		EditValidatorMethod,
 		DelegateMethod,
 		UndelegateMethod,
 		RedelegateMethod,
 		CancelUnbondingDelegationMethod,
+		DelegateManyMethod,
+		UndelegateManyMethod,
+		RedelegateManyMethod,
 		authorization.ApproveMethod,
 		authorization.RevokeMethod,
 		authorization.IncreaseAllowanceMethod,
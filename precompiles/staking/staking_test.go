@@ -45,6 +45,11 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 			s.precompile.Methods[staking.CreateValidatorMethod].Name,
 			true,
 		},
+		{
+			staking.EditValidatorMethod,
+			s.precompile.Methods[staking.EditValidatorMethod].Name,
+			true,
+		},
 		{
 			staking.DelegateMethod,
 			s.precompile.Methods[staking.DelegateMethod].Name,
@@ -475,3 +480,55 @@ func (s *PrecompileTestSuite) TestRun() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestRunDelegateCommit() {
+	s.SetupTest()
+
+	valAddr := s.validators[0].GetOperator()
+	prevDelegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.address.Bytes(), valAddr)
+	s.Require().True(found, "expected initial delegation to be found")
+
+	baseFee := s.app.FeeMarketKeeper.GetBaseFee(s.ctx)
+	contract := vm.NewPrecompile(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 1000000)
+	contractAddr := contract.Address()
+
+	input, err := s.precompile.Pack(
+		staking.DelegateMethod,
+		s.address,
+		valAddr.String(),
+		big.NewInt(1e18),
+	)
+	s.Require().NoError(err, "failed to pack input")
+	contract.Input = input
+
+	txArgs := evmtypes.EvmTxArgs{
+		ChainID:   s.app.EvmKeeper.ChainID(),
+		Nonce:     0,
+		To:        &contractAddr,
+		GasLimit:  1000000,
+		GasFeeCap: baseFee,
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	}
+	msgEthereumTx := evmtypes.NewTx(&txArgs)
+	msgEthereumTx.From = s.address.String()
+	err = msgEthereumTx.Sign(s.ethSigner, s.signer)
+	s.Require().NoError(err, "failed to sign Ethereum message")
+
+	cfg, err := s.app.EvmKeeper.EVMConfig(s.ctx, s.ctx.BlockHeader().ProposerAddress, s.app.EvmKeeper.ChainID())
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	msg, err := msgEthereumTx.AsMessage(s.ethSigner, baseFee)
+	s.Require().NoError(err, "failed to instantiate Ethereum message")
+
+	evm := s.app.EvmKeeper.NewEVM(s.ctx, msg, cfg, nil, s.stateDB)
+
+	_, err = s.precompile.Run(evm, contract, false)
+	s.Require().NoError(err, "expected no error when running the precompile")
+
+	// the delegation must be written to the transaction context on commit
+	s.Require().NoError(s.stateDB.Commit(), "failed to commit the state")
+
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.address.Bytes(), valAddr)
+	s.Require().True(found, "expected delegation to be found")
+	s.Require().Equal(prevDelegation.GetShares().Add(math.LegacyNewDec(1)), delegation.GetShares())
+}
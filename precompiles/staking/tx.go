@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -20,6 +21,8 @@ import (
 const (
 	// CreateValidatorMethod defines the ABI method name for the staking create validator transaction
 	CreateValidatorMethod = "createValidator"
+	// EditValidatorMethod defines the ABI method name for the staking edit validator transaction
+	EditValidatorMethod = "editValidator"
 	// DelegateMethod defines the ABI method name for the staking Delegate
 	// transaction.
 	DelegateMethod = "delegate"
@@ -32,6 +35,15 @@ const (
 	// CancelUnbondingDelegationMethod defines the ABI method name for the staking
 	// CancelUnbondingDelegation transaction.
 	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
+	// DelegateManyMethod defines the ABI method name for the staking batch
+	// Delegate transaction.
+	DelegateManyMethod = "delegateMany"
+	// UndelegateManyMethod defines the ABI method name for the staking batch
+	// Undelegate transaction.
+	UndelegateManyMethod = "undelegateMany"
+	// RedelegateManyMethod defines the ABI method name for the staking batch
+	// Redelegate transaction.
+	RedelegateManyMethod = "redelegateMany"
 )
 
 const (
@@ -88,6 +100,50 @@ func (p Precompile) CreateValidator(
 	return method.Outputs.Pack(true)
 }
 
+// EditValidator performs edit validator. The commission rate and the minimum
+// self delegation are only updated when they are not set to DoNotModify.
+func (p Precompile) EditValidator(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, validatorHexAddr, err := NewMsgEditValidator(args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"validator_address", validatorHexAddr.String(),
+		"commission_rate", msg.CommissionRate,
+		"min_self_delegation", msg.MinSelfDelegation,
+	)
+
+	// we only allow the validator operator to edit its own validator. The caller
+	// is checked instead of the origin so that validators operated by smart
+	// contracts (e.g. multisigs) can be edited.
+	if contract.CallerAddress != validatorHexAddr {
+		return nil, fmt.Errorf(ErrDifferentCallerFromValidator, contract.CallerAddress.String(), validatorHexAddr.String())
+	}
+
+	// Execute the transaction using the message server
+	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
+	if _, err = msgSrv.EditValidator(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the edit validator transaction
+	if err = p.EmitEditValidatorEvent(ctx, stateDB, msg, validatorHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
 // Delegate performs a delegation of coins from a delegator to a validator.
 func (p Precompile) Delegate(
 	ctx sdk.Context,
@@ -410,3 +466,257 @@ func (p Precompile) CancelUnbondingDelegation(
 
 	return method.Outputs.Pack(true)
 }
+
+// DelegateMany performs delegations of coins from a delegator to multiple validators.
+// The authorization grant is checked once against the total amount of the batch.
+func (p Precompile) DelegateMany(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom := p.stakingKeeper.BondDenom(ctx)
+	msgs, delegatorHexAddr, err := NewMsgDelegateMany(args, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	p.consumeBatchGas(ctx, len(msgs))
+
+	total := sdk.NewCoin(bondDenom, math.ZeroInt())
+	authzMsgs := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		total = total.Add(msg.Amount)
+		authzMsgs[i] = msg
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, validators: %d, total_amount: %s }",
+			delegatorHexAddr,
+			len(msgs),
+			total.Amount,
+		),
+	)
+
+	var (
+		// isCallerOrigin is true when the contract caller is the same as the origin
+		isCallerOrigin = contract.CallerAddress == origin
+		// isCallerDelegator is true when the contract caller is the same as the delegator
+		isCallerDelegator = contract.CallerAddress == delegatorHexAddr
+	)
+
+	delegatorHexAddr, stakeAuthz, expiration, err := p.checkBatchAuthz(ctx, origin, contract, delegatorHexAddr, total, DelegateMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
+	for _, msg := range msgs {
+		if _, err = msgSrv.Delegate(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return nil, err
+		}
+
+		if err = p.EmitDelegateEvent(ctx, stateDB, msg, delegatorHexAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	// Only update the authorization if the contract caller is different from the origin
+	if !isCallerOrigin {
+		if err := p.UpdateStakingAuthorizationBatch(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, DelegateMsg, authzMsgs); err != nil {
+			return nil, err
+		}
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if isCallerDelegator {
+		stateDB.(*statedb.StateDB).SubBalance(contract.CallerAddress, total.Amount.BigInt())
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// UndelegateMany performs undelegations of coins from multiple validators for a delegator.
+// The authorization grant is checked once against the total amount of the batch.
+func (p Precompile) UndelegateMany(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom := p.stakingKeeper.BondDenom(ctx)
+	msgs, delegatorHexAddr, err := NewMsgUndelegateMany(args, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	p.consumeBatchGas(ctx, len(msgs))
+
+	total := sdk.NewCoin(bondDenom, math.ZeroInt())
+	authzMsgs := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		total = total.Add(msg.Amount)
+		authzMsgs[i] = msg
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, validators: %d, total_amount: %s }",
+			delegatorHexAddr,
+			len(msgs),
+			total.Amount,
+		),
+	)
+
+	// isCallerOrigin is true when the contract caller is the same as the origin
+	isCallerOrigin := contract.CallerAddress == origin
+
+	delegatorHexAddr, stakeAuthz, expiration, err := p.checkBatchAuthz(ctx, origin, contract, delegatorHexAddr, total, UndelegateMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTimes := make([]int64, len(msgs))
+	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
+	for i, msg := range msgs {
+		res, err := msgSrv.Undelegate(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return nil, err
+		}
+
+		completionTimes[i] = res.CompletionTime.UTC().Unix()
+		if err = p.EmitUnbondEvent(ctx, stateDB, msg, delegatorHexAddr, completionTimes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	// Only update the authorization if the contract caller is different from the origin
+	if !isCallerOrigin {
+		if err := p.UpdateStakingAuthorizationBatch(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, UndelegateMsg, authzMsgs); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(completionTimes)
+}
+
+// RedelegateMany performs multiple redelegations of coins for a delegator from source
+// validators to destination validators.
+// The authorization grant is checked once against the total amount of the batch.
+func (p Precompile) RedelegateMany(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom := p.stakingKeeper.BondDenom(ctx)
+	msgs, delegatorHexAddr, err := NewMsgRedelegateMany(args, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	p.consumeBatchGas(ctx, len(msgs))
+
+	total := sdk.NewCoin(bondDenom, math.ZeroInt())
+	authzMsgs := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		total = total.Add(msg.Amount)
+		authzMsgs[i] = msg
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, redelegations: %d, total_amount: %s }",
+			delegatorHexAddr,
+			len(msgs),
+			total.Amount,
+		),
+	)
+
+	// isCallerOrigin is true when the contract caller is the same as the origin
+	isCallerOrigin := contract.CallerAddress == origin
+
+	delegatorHexAddr, stakeAuthz, expiration, err := p.checkBatchAuthz(ctx, origin, contract, delegatorHexAddr, total, RedelegateMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTimes := make([]int64, len(msgs))
+	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
+	for i, msg := range msgs {
+		res, err := msgSrv.BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return nil, err
+		}
+
+		completionTimes[i] = res.CompletionTime.UTC().Unix()
+		if err = p.EmitRedelegateEvent(ctx, stateDB, msg, delegatorHexAddr, completionTimes[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	// Only update the authorization if the contract caller is different from the origin
+	if !isCallerOrigin {
+		if err := p.UpdateStakingAuthorizationBatch(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, RedelegateMsg, authzMsgs); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(completionTimes)
+}
+
+// checkBatchAuthz checks that the delegator of a batch transaction is the origin and,
+// in case the contract caller is not the origin, that a single authorization grant
+// covers the total amount of the batch. It returns the delegator address to be used
+// for the batch together with the authorization grant and its expiration.
+func (p Precompile) checkBatchAuthz(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	delegatorHexAddr common.Address,
+	total sdk.Coin,
+	msgURL string,
+) (common.Address, *stakingtypes.StakeAuthorization, *time.Time, error) {
+	// The provided delegator address should always be equal to the origin address.
+	// In case the contract caller address is the same as the delegator address provided,
+	// update the delegator address to be equal to the origin address.
+	// Otherwise, if the provided delegator address is different from the origin address,
+	// return an error because is a forbidden operation
+	if contract.CallerAddress == delegatorHexAddr {
+		delegatorHexAddr = origin
+	} else if origin != delegatorHexAddr {
+		return common.Address{}, nil, nil, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
+	}
+
+	// no need to have authorization when the contract caller is the same as origin (owner of funds)
+	if contract.CallerAddress == origin {
+		return delegatorHexAddr, nil, nil, nil
+	}
+
+	stakeAuthz, expiration, err := authorization.CheckAuthzAndAllowanceForGranter(ctx, p.AuthzKeeper, contract.CallerAddress, delegatorHexAddr, &total, msgURL)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	return delegatorHexAddr, stakeAuthz, expiration, nil
+}
+
+// consumeBatchGas charges the flat write cost for every item of a batch transaction.
+// NOTE: the flat write cost of the first item is already charged on RequiredGas.
+func (p Precompile) consumeBatchGas(ctx sdk.Context, items int) {
+	ctx.GasMeter().ConsumeGas(p.KvGasConfig.WriteCostFlat*uint64(items-1), "staking extension batch method")
+}
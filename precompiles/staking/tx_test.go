@@ -4,12 +4,15 @@ import (
 	"encoding/base64"
 	"fmt"
 	"math/big"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	geth "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v16/precompiles/authorization"
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 	"github.com/evmos/evmos/v16/precompiles/staking"
 	"github.com/evmos/evmos/v16/precompiles/testutil"
@@ -297,6 +300,232 @@ func (s *PrecompileTestSuite) TestCreateValidator() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestEditValidator() {
+	var (
+		method      = s.precompile.Methods[staking.EditValidatorMethod]
+		description = staking.Description{
+			Moniker:         "node0-edited",
+			Identity:        stakingtypes.DoNotModifyDesc,
+			Website:         "https://evmos.org",
+			SecurityContact: stakingtypes.DoNotModifyDesc,
+			Details:         stakingtypes.DoNotModifyDesc,
+		}
+		// the validator is created with a commission rate of 0.1 and a max change rate of 0.1
+		commissionRate    = math.LegacyNewDecWithPrec(2, 1).BigInt()
+		minSelfDelegation = big.NewInt(10)
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(data []byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid description",
+			func() []interface{} {
+				return []interface{}{
+					"",
+					s.address,
+					commissionRate,
+					minSelfDelegation,
+				}
+			},
+			func(data []byte) {},
+			true,
+			"invalid description",
+		},
+		{
+			"fail - invalid validator address",
+			func() []interface{} {
+				return []interface{}{
+					description,
+					"",
+					commissionRate,
+					minSelfDelegation,
+				}
+			},
+			func(data []byte) {},
+			true,
+			"invalid validator address",
+		},
+		{
+			"fail - invalid commission rate",
+			func() []interface{} {
+				return []interface{}{
+					description,
+					s.address,
+					"",
+					minSelfDelegation,
+				}
+			},
+			func(data []byte) {},
+			true,
+			"invalid type for commissionRate",
+		},
+		{
+			"fail - commission rate higher than the max change rate",
+			func() []interface{} {
+				return []interface{}{
+					description,
+					s.address,
+					math.LegacyNewDecWithPrec(3, 1).BigInt(),
+					minSelfDelegation,
+				}
+			},
+			func(data []byte) {},
+			true,
+			stakingtypes.ErrCommissionGTMaxChangeRate.Error(),
+		},
+		{
+			"fail - non-positive min self delegation",
+			func() []interface{} {
+				return []interface{}{
+					description,
+					s.address,
+					commissionRate,
+					big.NewInt(0),
+				}
+			},
+			func(data []byte) {},
+			true,
+			"minimum self delegation must be a positive integer",
+		},
+		{
+			"fail - different caller than validator",
+			func() []interface{} {
+				differentAddr := evmosutiltx.GenerateAddress()
+				return []interface{}{
+					description,
+					differentAddr,
+					commissionRate,
+					minSelfDelegation,
+				}
+			},
+			func(data []byte) {},
+			true,
+			"is not the same as validator address",
+		},
+		{
+			"success - do not modify commission rate and min self delegation",
+			func() []interface{} {
+				return []interface{}{
+					description,
+					s.address,
+					big.NewInt(staking.DoNotModifyCommissionRate),
+					big.NewInt(staking.DoNotModifyMinSelfDelegation),
+				}
+			},
+			func(data []byte) {
+				validator := s.app.StakingKeeper.Validator(s.ctx, s.address.Bytes())
+				s.Require().Equal(math.LegacyNewDecWithPrec(1, 1), validator.GetCommission())
+				s.Require().Equal(math.OneInt(), validator.GetMinSelfDelegation())
+
+				var editValidatorEvent staking.EventEditValidator
+				err := cmn.UnpackLog(s.precompile.ABI, &editValidatorEvent, staking.EventTypeEditValidator, *s.stateDB.Logs()[1])
+				s.Require().NoError(err)
+				s.Require().Equal(big.NewInt(staking.DoNotModifyCommissionRate), editValidatorEvent.CommissionRate)
+				s.Require().Equal(big.NewInt(staking.DoNotModifyMinSelfDelegation), editValidatorEvent.MinSelfDelegation)
+			},
+			false,
+			"",
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{
+					description,
+					s.address,
+					commissionRate,
+					minSelfDelegation,
+				}
+			},
+			func(data []byte) {
+				success, err := s.precompile.Unpack(staking.EditValidatorMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal(success[0], true)
+
+				validator := s.app.StakingKeeper.Validator(s.ctx, s.address.Bytes())
+				s.Require().Equal(commissionRate.String(), validator.GetCommission().BigInt().String())
+				s.Require().Equal(minSelfDelegation.String(), validator.GetMinSelfDelegation().String())
+
+				// the fields that are not modified keep their initial value
+				val, found := s.app.StakingKeeper.GetValidator(s.ctx, s.address.Bytes())
+				s.Require().True(found)
+				s.Require().Equal(description.Moniker, val.Description.Moniker)
+				s.Require().Equal(description.Website, val.Description.Website)
+				s.Require().Equal("initial-identity", val.Description.Identity)
+
+				// the first log is emitted on the validator creation
+				log := s.stateDB.Logs()[1]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.ABI.Events[staking.EventTypeEditValidator]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), geth.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(s.ctx.BlockHeight()))
+
+				// Check the fully unpacked event matches the one emitted
+				var editValidatorEvent staking.EventEditValidator
+				err = cmn.UnpackLog(s.precompile.ABI, &editValidatorEvent, staking.EventTypeEditValidator, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.address, editValidatorEvent.ValidatorAddress)
+				s.Require().Equal(commissionRate, editValidatorEvent.CommissionRate)
+				s.Require().Equal(minSelfDelegation, editValidatorEvent.MinSelfDelegation)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, s.address, s.precompile, 200000)
+
+			// create the validator that is edited
+			createMethod := s.precompile.Methods[staking.CreateValidatorMethod]
+			_, err := s.precompile.CreateValidator(s.ctx, s.address, contract, s.stateDB, &createMethod, []interface{}{
+				staking.Description{Moniker: "node0", Identity: "initial-identity"},
+				staking.Commission{
+					Rate:          math.LegacyNewDecWithPrec(1, 1).BigInt(),
+					MaxRate:       math.LegacyNewDecWithPrec(5, 1).BigInt(),
+					MaxChangeRate: math.LegacyNewDecWithPrec(1, 1).BigInt(),
+				},
+				big.NewInt(1),
+				s.address,
+				"nfJ0axJC9dhta1MAE1EBFaVdxxkYzxYrBaHuJVjG//M=",
+				big.NewInt(1205000000000000000),
+			})
+			s.Require().NoError(err)
+
+			// the commission rate can only be updated once every 24 hours
+			s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(25 * time.Hour))
+
+			bz, err := s.precompile.EditValidator(s.ctx, s.address, contract, s.stateDB, &method, tc.malleate())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestDelegate() {
 	method := s.precompile.Methods[staking.DelegateMethod]
 
@@ -957,3 +1186,274 @@ func (s *PrecompileTestSuite) TestCancelUnbondingDelegation() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDelegateMany() {
+	method := s.precompile.Methods[staking.DelegateManyMethod]
+	grantee := evmosutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		caller      func() geth.Address
+		malleate    func(operatorAddresses []string) []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() geth.Address { return s.address },
+			func([]string) []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - empty batch",
+			func() geth.Address { return s.address },
+			func([]string) []interface{} {
+				return []interface{}{s.address, []string{}, []*big.Int{}}
+			},
+			func([]byte) {},
+			true,
+			staking.ErrEmptyBatch,
+		},
+		{
+			"fail - length mismatch",
+			func() geth.Address { return s.address },
+			func(operatorAddresses []string) []interface{} {
+				return []interface{}{s.address, operatorAddresses, []*big.Int{big.NewInt(1e18)}}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(staking.ErrBatchLengthMismatch, 2, 1),
+		},
+		{
+			"fail - different origin than delegator",
+			func() geth.Address { return s.address },
+			func(operatorAddresses []string) []interface{} {
+				return []interface{}{evmosutiltx.GenerateAddress(), operatorAddresses, []*big.Int{big.NewInt(1e18), big.NewInt(1e18)}}
+			},
+			func([]byte) {},
+			true,
+			"is not the same as delegator address",
+		},
+		{
+			"fail - total amount exceeds the allowance",
+			func() geth.Address {
+				err := s.CreateAuthorization(grantee, staking.DelegateAuthz, &sdk.Coin{Denom: s.bondDenom, Amount: math.NewInt(15e17)})
+				s.Require().NoError(err)
+				return grantee
+			},
+			func(operatorAddresses []string) []interface{} {
+				return []interface{}{s.address, operatorAddresses, []*big.Int{big.NewInt(1e18), big.NewInt(1e18)}}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(authorization.ErrExceededAllowance, math.NewInt(2e18), math.NewInt(15e17)),
+		},
+		{
+			"success - delegate to multiple validators",
+			func() geth.Address { return s.address },
+			func(operatorAddresses []string) []interface{} {
+				return []interface{}{s.address, operatorAddresses, []*big.Int{big.NewInt(1e18), big.NewInt(1e18)}}
+			},
+			func(data []byte) {
+				success, err := s.precompile.Unpack(staking.DelegateManyMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+
+				logs := s.stateDB.Logs()
+				s.Require().Len(logs, 2)
+				event := s.precompile.ABI.Events[staking.EventTypeDelegate]
+				for _, log := range logs {
+					s.Require().Equal(event.ID, log.Topics[0])
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - delegate through a grantee and update the authorization once",
+			func() geth.Address {
+				err := s.CreateAuthorization(grantee, staking.DelegateAuthz, &sdk.Coin{Denom: s.bondDenom, Amount: math.NewInt(3e18)})
+				s.Require().NoError(err)
+				return grantee
+			},
+			func(operatorAddresses []string) []interface{} {
+				return []interface{}{s.address, operatorAddresses, []*big.Int{big.NewInt(1e18), big.NewInt(1e18)}}
+			},
+			func([]byte) {
+				authz, _ := s.CheckAuthorization(staking.DelegateAuthz, grantee, s.address)
+				s.Require().NotNil(authz)
+				s.Require().Equal(math.NewInt(1e18), authz.MaxTokens.Amount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			operatorAddresses := []string{s.validators[0].OperatorAddress, s.validators[1].OperatorAddress}
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, tc.caller(), s.precompile, 200000)
+
+			bz, err := s.precompile.DelegateMany(s.ctx, s.address, contract, s.stateDB, &method, tc.malleate(operatorAddresses))
+
+			for _, validator := range s.validators[:2] {
+				delegation := s.app.StakingKeeper.Delegation(s.ctx, s.address.Bytes(), validator.GetOperator())
+				if tc.expError {
+					s.Require().ErrorContains(err, tc.errContains)
+					s.Require().Empty(bz)
+					s.Require().Equal(validator.DelegatorShares, delegation.GetShares())
+				} else {
+					s.Require().NoError(err)
+					s.Require().Equal(math.NewInt(2), delegation.GetShares().TruncateInt())
+				}
+			}
+
+			if !tc.expError {
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUndelegateMany() {
+	method := s.precompile.Methods[staking.UndelegateManyMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(operatorAddresses []string) []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid amounts",
+			func(operatorAddresses []string) []interface{} {
+				return []interface{}{s.address, operatorAddresses, []string{"1", "1"}}
+			},
+			true,
+			"invalid type for amounts",
+		},
+		{
+			"fail - second undelegation exceeds the delegation",
+			func(operatorAddresses []string) []interface{} {
+				return []interface{}{s.address, operatorAddresses, []*big.Int{big.NewInt(1e18), big.NewInt(2e18)}}
+			},
+			true,
+			"invalid shares amount",
+		},
+		{
+			"success - undelegate from multiple validators",
+			func(operatorAddresses []string) []interface{} {
+				return []interface{}{s.address, operatorAddresses, []*big.Int{big.NewInt(1e18), big.NewInt(1e18)}}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			operatorAddresses := []string{s.validators[0].OperatorAddress, s.validators[1].OperatorAddress}
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, s.address, s.precompile, 200000)
+
+			bz, err := s.precompile.UndelegateMany(s.ctx, s.address, contract, s.stateDB, &method, tc.malleate(operatorAddresses))
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(staking.UndelegateManyMethod, bz)
+			s.Require().NoError(err)
+			completionTimes, ok := out[0].([]int64)
+			s.Require().True(ok)
+			s.Require().Len(completionTimes, 2)
+
+			params := s.app.StakingKeeper.GetParams(s.ctx)
+			for i, validator := range s.validators[:2] {
+				s.Require().Equal(s.ctx.BlockTime().Add(params.UnbondingTime).UTC().Unix(), completionTimes[i])
+				unbonding, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, s.address.Bytes(), validator.GetOperator())
+				s.Require().True(found)
+				s.Require().Len(unbonding.Entries, 1)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRedelegateMany() {
+	method := s.precompile.Methods[staking.RedelegateManyMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func(srcAddresses, dstAddresses []string) []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func([]string, []string) []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - destination length mismatch",
+			func(srcAddresses, dstAddresses []string) []interface{} {
+				return []interface{}{s.address, srcAddresses, dstAddresses[:1], []*big.Int{big.NewInt(1e17), big.NewInt(1e17)}}
+			},
+			true,
+			fmt.Sprintf(staking.ErrBatchLengthMismatch, 1, 2),
+		},
+		{
+			"success - redelegate to multiple validators",
+			func(srcAddresses, dstAddresses []string) []interface{} {
+				return []interface{}{s.address, srcAddresses, dstAddresses, []*big.Int{big.NewInt(1e17), big.NewInt(1e17)}}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			srcAddresses := []string{s.validators[0].OperatorAddress, s.validators[0].OperatorAddress}
+			dstAddresses := []string{s.validators[1].OperatorAddress, s.validators[2].OperatorAddress}
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, s.address, s.precompile, 200000)
+
+			bz, err := s.precompile.RedelegateMany(s.ctx, s.address, contract, s.stateDB, &method, tc.malleate(srcAddresses, dstAddresses))
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := s.precompile.Unpack(staking.RedelegateManyMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Len(out[0], 2)
+			s.Require().Len(s.stateDB.Logs(), 2)
+
+			redelegation, found := s.app.StakingKeeper.GetRedelegation(s.ctx, s.address.Bytes(), s.validators[0].GetOperator(), s.validators[1].GetOperator())
+			s.Require().True(found)
+			s.Require().Equal(math.NewInt(1e17), redelegation.Entries[0].InitialBalance)
+		})
+	}
+}
@@ -22,12 +22,28 @@ import (
 	cmn "github.com/evmos/evmos/v16/precompiles/common"
 )
 
+const (
+	// DoNotModifyCommissionRate is the value of the commission rate that leaves
+	// the validator commission rate unchanged on an EditValidator transaction.
+	DoNotModifyCommissionRate = -1
+	// DoNotModifyMinSelfDelegation is the value of the minimum self delegation that
+	// leaves the validator minimum self delegation unchanged on an EditValidator transaction.
+	DoNotModifyMinSelfDelegation = -1
+)
+
 // EventCreateValidator defines the event data for the staking CreateValidator transaction.
 type EventCreateValidator struct {
 	ValidatorAddress common.Address
 	Value            *big.Int
 }
 
+// EventEditValidator defines the event data for the staking EditValidator transaction.
+type EventEditValidator struct {
+	ValidatorAddress  common.Address
+	CommissionRate    *big.Int
+	MinSelfDelegation *big.Int
+}
+
 // EventDelegate defines the event data for the staking Delegate transaction.
 type EventDelegate struct {
 	DelegatorAddress common.Address
@@ -158,6 +174,68 @@ func NewMsgCreateValidator(args []interface{}, denom string) (*stakingtypes.MsgC
 	return msg, validatorAddress, nil
 }
 
+// NewMsgEditValidator creates a new MsgEditValidator instance and does sanity checks
+// on the given arguments before populating the message. The description fields set to
+// stakingtypes.DoNotModifyDesc, as well as the commission rate and minimum self
+// delegation set to their DoNotModify values, are left unchanged.
+func NewMsgEditValidator(args []interface{}) (*stakingtypes.MsgEditValidator, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	description := stakingtypes.Description{}
+	if descriptionInput, ok := args[0].(Description); ok {
+		description.Moniker = descriptionInput.Moniker
+		description.Identity = descriptionInput.Identity
+		description.Website = descriptionInput.Website
+		description.SecurityContact = descriptionInput.SecurityContact
+		description.Details = descriptionInput.Details
+	} else {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidDescription, args[0])
+	}
+
+	validatorAddress, ok := args[1].(common.Address)
+	if !ok || validatorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidValidator, args[1])
+	}
+
+	commissionRateBigInt, ok := args[2].(*big.Int)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "commissionRate", &big.Int{}, args[2])
+	}
+
+	// the commission rate uses the same precision of 18 decimals as the CommissionRates struct
+	var commissionRate *math.LegacyDec
+	if commissionRateBigInt.Cmp(big.NewInt(DoNotModifyCommissionRate)) != 0 {
+		rate := math.LegacyNewDecFromBigIntWithPrec(commissionRateBigInt, math.LegacyPrecision)
+		commissionRate = &rate
+	}
+
+	minSelfDelegationBigInt, ok := args[3].(*big.Int)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "minSelfDelegation", &big.Int{}, args[3])
+	}
+
+	var minSelfDelegation *math.Int
+	if minSelfDelegationBigInt.Cmp(big.NewInt(DoNotModifyMinSelfDelegation)) != 0 {
+		msd := math.NewIntFromBigInt(minSelfDelegationBigInt)
+		minSelfDelegation = &msd
+	}
+
+	msg := stakingtypes.NewMsgEditValidator(
+		sdk.ValAddress(validatorAddress.Bytes()),
+		description,
+		commissionRate,
+		minSelfDelegation,
+	)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, validatorAddress, nil
+}
+
 // NewMsgDelegate creates a new MsgDelegate instance and does sanity checks
 // on the given arguments before populating the message.
 func NewMsgDelegate(args []interface{}, denom string) (*stakingtypes.MsgDelegate, common.Address, error) {
@@ -250,6 +328,95 @@ func NewMsgRedelegate(args []interface{}, denom string) (*stakingtypes.MsgBeginR
 	return msg, delegatorAddr, nil
 }
 
+// NewMsgDelegateMany creates a new MsgDelegate instance for every validator and does sanity checks
+// on the given arguments before populating the messages.
+func NewMsgDelegateMany(args []interface{}, denom string) ([]*stakingtypes.MsgDelegate, common.Address, error) {
+	delegatorAddr, validatorAddresses, amounts, err := checkBatchDelegationUndelegationArgs(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msgs := make([]*stakingtypes.MsgDelegate, len(validatorAddresses))
+	for i := range validatorAddresses {
+		msgs[i], _, err = NewMsgDelegate([]interface{}{delegatorAddr, validatorAddresses[i], amounts[i]}, denom)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
+// NewMsgUndelegateMany creates a new MsgUndelegate instance for every validator and does sanity checks
+// on the given arguments before populating the messages.
+func NewMsgUndelegateMany(args []interface{}, denom string) ([]*stakingtypes.MsgUndelegate, common.Address, error) {
+	delegatorAddr, validatorAddresses, amounts, err := checkBatchDelegationUndelegationArgs(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msgs := make([]*stakingtypes.MsgUndelegate, len(validatorAddresses))
+	for i := range validatorAddresses {
+		msgs[i], _, err = NewMsgUndelegate([]interface{}{delegatorAddr, validatorAddresses[i], amounts[i]}, denom)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
+// NewMsgRedelegateMany creates a new MsgBeginRedelegate instance for every source and destination
+// validator pair and does sanity checks on the given arguments before populating the messages.
+func NewMsgRedelegateMany(args []interface{}, denom string) ([]*stakingtypes.MsgBeginRedelegate, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	validatorSrcAddresses, ok := args[1].([]string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "validatorSrcAddresses", []string{}, args[1])
+	}
+
+	validatorDstAddresses, ok := args[2].([]string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "validatorDstAddresses", []string{}, args[2])
+	}
+
+	amounts, ok := args[3].([]*big.Int)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "amounts", []*big.Int{}, args[3])
+	}
+
+	if len(amounts) == 0 {
+		return nil, common.Address{}, errors.New(ErrEmptyBatch)
+	}
+
+	if len(validatorSrcAddresses) != len(amounts) {
+		return nil, common.Address{}, fmt.Errorf(ErrBatchLengthMismatch, len(validatorSrcAddresses), len(amounts))
+	}
+
+	if len(validatorDstAddresses) != len(amounts) {
+		return nil, common.Address{}, fmt.Errorf(ErrBatchLengthMismatch, len(validatorDstAddresses), len(amounts))
+	}
+
+	var err error
+	msgs := make([]*stakingtypes.MsgBeginRedelegate, len(amounts))
+	for i := range amounts {
+		msgs[i], _, err = NewMsgRedelegate([]interface{}{delegatorAddr, validatorSrcAddresses[i], validatorDstAddresses[i], amounts[i]}, denom)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
 // NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation instance and does sanity checks
 // on the given arguments before populating the message.
 func NewMsgCancelUnbondingDelegation(args []interface{}, denom string) (*stakingtypes.MsgCancelUnbondingDelegation, common.Address, error) {
@@ -807,6 +974,166 @@ func checkDelegationUndelegationArgs(args []interface{}) (common.Address, string
 	return delegatorAddr, validatorAddress, amount, nil
 }
 
+// checkBatchDelegationUndelegationArgs checks the arguments for the batch delegation and undelegation functions.
+func checkBatchDelegationUndelegationArgs(args []interface{}) (common.Address, []string, []*big.Int, error) {
+	if len(args) != 3 {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	validatorAddresses, ok := args[1].([]string)
+	if !ok {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "validatorAddresses", []string{}, args[1])
+	}
+
+	amounts, ok := args[2].([]*big.Int)
+	if !ok {
+		return common.Address{}, nil, nil, fmt.Errorf(cmn.ErrInvalidType, "amounts", []*big.Int{}, args[2])
+	}
+
+	if len(validatorAddresses) == 0 {
+		return common.Address{}, nil, nil, errors.New(ErrEmptyBatch)
+	}
+
+	if len(validatorAddresses) != len(amounts) {
+		return common.Address{}, nil, nil, fmt.Errorf(ErrBatchLengthMismatch, len(validatorAddresses), len(amounts))
+	}
+
+	return delegatorAddr, validatorAddresses, amounts, nil
+}
+
+// NewDelegatorDelegationsRequest creates a new QueryDelegatorDelegationsRequest instance and does sanity checks
+// on the given arguments before populating the request.
+func NewDelegatorDelegationsRequest(method *abi.Method, args []interface{}) (*stakingtypes.QueryDelegatorDelegationsRequest, error) {
+	input, err := newDelegatorInput(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.QueryDelegatorDelegationsRequest{
+		DelegatorAddr: sdk.AccAddress(input.DelegatorAddress.Bytes()).String(), // bech32 formatted
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
+// NewDelegatorUnbondingDelegationsRequest creates a new QueryDelegatorUnbondingDelegationsRequest instance
+// and does sanity checks on the given arguments before populating the request.
+func NewDelegatorUnbondingDelegationsRequest(method *abi.Method, args []interface{}) (*stakingtypes.QueryDelegatorUnbondingDelegationsRequest, error) {
+	input, err := newDelegatorInput(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+		DelegatorAddr: sdk.AccAddress(input.DelegatorAddress.Bytes()).String(), // bech32 formatted
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
+// newDelegatorInput unpacks and validates the arguments of the delegator queries.
+func newDelegatorInput(method *abi.Method, args []interface{}) (*DelegatorInput, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DelegatorInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to DelegatorInput struct: %s", err)
+	}
+
+	if input.DelegatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &input, nil
+}
+
+// DelegatorInput is a struct to represent the input information for
+// the delegator queries. Needed to unpack arguments into the PageRequest struct.
+type DelegatorInput struct {
+	DelegatorAddress common.Address
+	PageRequest      query.PageRequest
+}
+
+// DelegatorDelegation is a struct to represent the key information from
+// a delegation of a delegator to a single validator.
+type DelegatorDelegation struct {
+	ValidatorAddress string
+	Shares           *big.Int
+	Balance          cmn.Coin
+}
+
+// DelegatorDelegationsOutput is a struct to represent the key information from
+// a delegator delegations response.
+type DelegatorDelegationsOutput struct {
+	Delegations  []DelegatorDelegation
+	PageResponse query.PageResponse
+}
+
+// FromResponse populates the DelegatorDelegationsOutput from a QueryDelegatorDelegationsResponse.
+func (do *DelegatorDelegationsOutput) FromResponse(res *stakingtypes.QueryDelegatorDelegationsResponse) *DelegatorDelegationsOutput {
+	do.Delegations = make([]DelegatorDelegation, len(res.DelegationResponses))
+	for i, resp := range res.DelegationResponses {
+		do.Delegations[i] = DelegatorDelegation{
+			ValidatorAddress: resp.Delegation.ValidatorAddress,
+			Shares:           resp.Delegation.Shares.BigInt(),
+			Balance: cmn.Coin{
+				Denom:  resp.Balance.Denom,
+				Amount: resp.Balance.Amount.BigInt(),
+			},
+		}
+	}
+
+	if res.Pagination != nil {
+		do.PageResponse.Total = res.Pagination.Total
+		do.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return do
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (do *DelegatorDelegationsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(do.Delegations, do.PageResponse)
+}
+
+// DelegatorUnbondingDelegationsOutput is a struct to represent the key information from
+// a delegator unbonding delegations response.
+type DelegatorUnbondingDelegationsOutput struct {
+	UnbondingDelegations []UnbondingDelegationResponse
+	PageResponse         query.PageResponse
+}
+
+// FromResponse populates the DelegatorUnbondingDelegationsOutput from a QueryDelegatorUnbondingDelegationsResponse.
+func (do *DelegatorUnbondingDelegationsOutput) FromResponse(res *stakingtypes.QueryDelegatorUnbondingDelegationsResponse) *DelegatorUnbondingDelegationsOutput {
+	do.UnbondingDelegations = make([]UnbondingDelegationResponse, len(res.UnbondingResponses))
+	for i, ubd := range res.UnbondingResponses {
+		ubd := ubd
+		out := new(UnbondingDelegationOutput).FromResponse(&stakingtypes.QueryUnbondingDelegationResponse{Unbond: ubd})
+		do.UnbondingDelegations[i] = out.UnbondingDelegation
+	}
+
+	if res.Pagination != nil {
+		do.PageResponse.Total = res.Pagination.Total
+		do.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return do
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (do *DelegatorUnbondingDelegationsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(do.UnbondingDelegations, do.PageResponse)
+}
+
 // FormatConsensusPubkey format ConsensusPubkey into a base64 string
 func FormatConsensusPubkey(consensusPubkey *codectypes.Any) string {
 	ed25519pk, ok := consensusPubkey.GetCachedValue().(cryptotypes.PubKey)
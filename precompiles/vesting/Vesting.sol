@@ -155,4 +155,43 @@ interface VestingI {
     function balances(
         address vestingAddress
     ) external view returns (Coin[] memory locked, Coin[] memory unvested, Coin[] memory vested);
+
+    /// @dev Defines a query for getting the lockup and vesting schedules of a vesting account.
+    /// @param vestingAddress The address of the vesting account.
+    /// @return funderAddress The address of the account that funded the vesting account.
+    /// @return startTime The unix timestamp at which the schedules start.
+    /// @return endTime The unix timestamp at which the last period of the schedules ends.
+    /// @return lockupPeriods The lockup periods of the vesting account.
+    /// @return vestingPeriods The vesting periods of the vesting account.
+    function vestingSchedule(
+        address vestingAddress
+    )
+        external
+        view
+        returns (
+            address funderAddress,
+            int64 startTime,
+            int64 endTime,
+            Period[] memory lockupPeriods,
+            Period[] memory vestingPeriods
+        );
+
+    /// @dev Defines a query for getting the next unlock and vesting events of a vesting account.
+    /// The times are zero and the amounts empty when all the events have already passed.
+    /// @param vestingAddress The address of the vesting account.
+    /// @return unlockTime The unix timestamp of the next lockup period end.
+    /// @return unlocked The coins unlocked at the next lockup period end.
+    /// @return vestingTime The unix timestamp of the next vesting period end.
+    /// @return vested The coins vested at the next vesting period end.
+    function nextUnlock(
+        address vestingAddress
+    )
+        external
+        view
+        returns (
+            int64 unlockTime,
+            Coin[] memory unlocked,
+            int64 vestingTime,
+            Coin[] memory vested
+        );
 }
@@ -10,6 +10,10 @@ import (
 const (
 	// BalancesMethod defines the ABI method name for the Balances query.
 	BalancesMethod = "balances"
+	// VestingScheduleMethod defines the ABI method name for the VestingSchedule query.
+	VestingScheduleMethod = "vestingSchedule"
+	// NextUnlockMethod defines the ABI method name for the NextUnlock query.
+	NextUnlockMethod = "nextUnlock"
 )
 
 // Balances queries the balances of a clawback vesting account.
@@ -32,3 +36,50 @@ func (p Precompile) Balances(
 
 	return method.Outputs.Pack(out.Locked, out.Unvested, out.Vested)
 }
+
+// VestingSchedule queries the funder, start and end time, and the lockup and
+// vesting periods of a clawback vesting account.
+func (p Precompile) VestingSchedule(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	vestingAddress, err := parseVestingAddress(args)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := p.vestingKeeper.GetClawbackVestingAccount(ctx, vestingAddress.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(VestingScheduleOutput).FromAccount(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(out.FunderAddress, out.StartTime, out.EndTime, out.LockupPeriods, out.VestingPeriods)
+}
+
+// NextUnlock queries the next unlock and vesting events of a clawback vesting
+// account at the current block time.
+func (p Precompile) NextUnlock(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	vestingAddress, err := parseVestingAddress(args)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := p.vestingKeeper.GetClawbackVestingAccount(ctx, vestingAddress.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(NextUnlockOutput).FromAccount(account, ctx.BlockTime())
+
+	return method.Outputs.Pack(out.UnlockTime, out.Unlocked, out.VestingTime, out.Vested)
+}
@@ -93,3 +93,149 @@ func (s *PrecompileTestSuite) TestBalances() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestVestingSchedule() {
+	method := s.precompile.Methods[vesting.VestingScheduleMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(data []byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - account is not a vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+				}
+			},
+			func(data []byte) {},
+			true,
+			"is not subject to clawback vesting",
+		},
+		{
+			"success - should return vesting account schedule",
+			func() []interface{} {
+				s.CreateTestClawbackVestingAccount(s.address, toAddr)
+				s.FundTestClawbackVestingAccount()
+				return []interface{}{
+					toAddr,
+				}
+			},
+			func(data []byte) {
+				var out vesting.VestingScheduleOutput
+				err := s.precompile.UnpackIntoInterface(&out, vesting.VestingScheduleMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal(s.address, out.FunderAddress)
+				s.Require().Equal(out.StartTime+8000, out.EndTime)
+				s.Require().Equal(lockupPeriods, out.LockupPeriods)
+				s.Require().Equal(vestingPeriods, out.VestingPeriods)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			bz, err := s.precompile.VestingSchedule(s.ctx, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNextUnlock() {
+	method := s.precompile.Methods[vesting.NextUnlockMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(data []byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - account is not a vesting account",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+				}
+			},
+			func(data []byte) {},
+			true,
+			"is not subject to clawback vesting",
+		},
+		{
+			"success - should return the first unlock and vesting events",
+			func() []interface{} {
+				s.CreateTestClawbackVestingAccount(s.address, toAddr)
+				s.FundTestClawbackVestingAccount()
+				return []interface{}{
+					toAddr,
+				}
+			},
+			func(data []byte) {
+				account, err := s.app.VestingKeeper.GetClawbackVestingAccount(s.ctx, toAddr.Bytes())
+				s.Require().NoError(err)
+				startTime := account.GetStartTime()
+
+				var out vesting.NextUnlockOutput
+				err = s.precompile.UnpackIntoInterface(&out, vesting.NextUnlockMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal(startTime+5000, out.UnlockTime)
+				s.Require().Equal(balances, out.Unlocked)
+				s.Require().Equal(startTime+2000, out.VestingTime)
+				s.Require().Equal(quarter, out.Vested)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+
+			bz, err := s.precompile.NextUnlock(s.ctx, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
@@ -214,13 +214,9 @@ func NewMsgConvertVestingAccount(args []interface{}) (*vestingtypes.MsgConvertVe
 
 // NewBalancesRequest creates a new QueryBalancesRequest instance.
 func NewBalancesRequest(args []interface{}) (*vestingtypes.QueryBalancesRequest, error) {
-	if len(args) != 1 {
-		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
-	}
-
-	address, ok := args[0].(common.Address)
-	if !ok {
-		return nil, fmt.Errorf(cmn.ErrInvalidType, "vestingAddress", "Address", args[0])
+	address, err := parseVestingAddress(args)
+	if err != nil {
+		return nil, err
 	}
 
 	msg := &vestingtypes.QueryBalancesRequest{
@@ -230,6 +226,21 @@ func NewBalancesRequest(args []interface{}) (*vestingtypes.QueryBalancesRequest,
 	return msg, nil
 }
 
+// parseVestingAddress parses the vesting address argument of the vesting
+// account queries.
+func parseVestingAddress(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	address, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "vestingAddress", "Address", args[0])
+	}
+
+	return address, nil
+}
+
 // validateBasicArgs validates the basic arguments and length of the provided arguments.
 func validateBasicArgs(args []interface{}, expectedLength int) (common.Address, common.Address, error) {
 	if len(args) != expectedLength {
@@ -267,6 +278,19 @@ func createCosmosPeriodsFromPeriod(inputPeriods []Period) cosmosvestingtypes.Per
 	return periods
 }
 
+// createPeriodsFromCosmosPeriods creates a Period slice from a cosmosvestingtypes.Period slice.
+func createPeriodsFromCosmosPeriods(inputPeriods cosmosvestingtypes.Periods) []Period {
+	periods := make([]Period, len(inputPeriods))
+	for i, period := range inputPeriods {
+		periods[i] = Period{
+			Length: period.Length,
+			Amount: cmn.NewCoinsResponse(period.Amount),
+		}
+	}
+
+	return periods
+}
+
 // BalancesOutput represents the balances of a ClawbackVestingAccount
 type BalancesOutput struct {
 	Locked   []cmn.Coin
@@ -282,6 +306,53 @@ func (bo *BalancesOutput) FromResponse(res *vestingtypes.QueryBalancesResponse)
 	return bo
 }
 
+// VestingScheduleOutput represents the lockup and vesting schedules of a
+// ClawbackVestingAccount.
+type VestingScheduleOutput struct {
+	FunderAddress  common.Address
+	StartTime      int64
+	EndTime        int64
+	LockupPeriods  []Period
+	VestingPeriods []Period
+}
+
+// FromAccount populates the VestingScheduleOutput from a ClawbackVestingAccount.
+func (vo *VestingScheduleOutput) FromAccount(account *vestingtypes.ClawbackVestingAccount) (*VestingScheduleOutput, error) {
+	funder, err := sdk.AccAddressFromBech32(account.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	vo.FunderAddress = common.BytesToAddress(funder.Bytes())
+	vo.StartTime = account.GetStartTime()
+	vo.EndTime = account.GetEndTime()
+	vo.LockupPeriods = createPeriodsFromCosmosPeriods(account.LockupPeriods)
+	vo.VestingPeriods = createPeriodsFromCosmosPeriods(account.VestingPeriods)
+	return vo, nil
+}
+
+// NextUnlockOutput represents the next unlock and vesting events of a
+// ClawbackVestingAccount.
+type NextUnlockOutput struct {
+	UnlockTime  int64
+	Unlocked    []cmn.Coin
+	VestingTime int64
+	Vested      []cmn.Coin
+}
+
+// FromAccount populates the NextUnlockOutput from a ClawbackVestingAccount at
+// the given block time.
+func (no *NextUnlockOutput) FromAccount(account *vestingtypes.ClawbackVestingAccount, blockTime time.Time) *NextUnlockOutput {
+	unlockTime, unlocked := vestingtypes.ReadNextEvent(account.GetStartTime(), account.LockupPeriods, blockTime.Unix())
+	vestingTime, vested := vestingtypes.ReadNextEvent(account.GetStartTime(), account.VestingPeriods, blockTime.Unix())
+
+	no.UnlockTime = unlockTime
+	no.Unlocked = cmn.NewCoinsResponse(unlocked)
+	no.VestingTime = vestingTime
+	no.Vested = cmn.NewCoinsResponse(vested)
+	return no
+}
+
 // ClawbackOutput represents the clawed back coins from a Clawback transaction.
 type ClawbackOutput struct {
 	Coins []cmn.Coin
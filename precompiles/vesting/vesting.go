@@ -82,6 +82,16 @@ func (Precompile) Address() common.Address {
 	return common.HexToAddress("0x0000000000000000000000000000000000000803")
 }
 
+// PrecompileName returns the name of the vesting precompile contract.
+func (Precompile) PrecompileName() string {
+	return "vesting"
+}
+
+// PrecompileVersion returns the interface version of the vesting precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v2"
+}
+
 // Run executes the precompiled contract staking methods defined in the ABI.
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
 	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
@@ -93,7 +103,9 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
-	if err := stateDB.Commit(); err != nil {
+	// write the dirty EVM state to the branched context, so that the keepers
+	// see the balances changed earlier in the transaction
+	if err := stateDB.Flush(); err != nil {
 		return nil, err
 	}
 
@@ -115,6 +127,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// Vesting queries
 	case BalancesMethod:
 		bz, err = p.Balances(ctx, method, args)
+	case VestingScheduleMethod:
+		bz, err = p.VestingSchedule(ctx, method, args)
+	case NextUnlockMethod:
+		bz, err = p.NextUnlock(ctx, method, args)
 	}
 
 	if err != nil {
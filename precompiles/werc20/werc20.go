@@ -71,6 +71,16 @@ func (p Precompile) Address() common.Address {
 	return p.Precompile.Address()
 }
 
+// PrecompileName returns the name of the WERC-20 precompile contract.
+func (Precompile) PrecompileName() string {
+	return "werc20"
+}
+
+// PrecompileVersion returns the interface version of the WERC-20 precompile contract.
+func (Precompile) PrecompileVersion() string {
+	return "v1"
+}
+
 // RequiredGas calculates the contract gas use.
 func (p Precompile) RequiredGas(input []byte) uint64 {
 	// TODO: these values were obtained from Remix using the WEVMOS9.sol.
@@ -3,6 +3,7 @@
 package backend
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/big"
@@ -18,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/trie"
 	rpctypes "github.com/evmos/evmos/v16/rpc/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -439,9 +441,9 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 
 	validatorAddr := common.BytesToAddress(validatorAccAddr)
 
-	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(ctx, b.clientCtx, block.Height)
+	gasLimit, err := b.blockMaxEVMGas(ctx, block.Height)
 	if err != nil {
-		b.logger.Error("failed to query consensus params", "error", err.Error())
+		b.logger.Error("failed to query the block gas limit", "error", err.Error())
 	}
 
 	gasUsed := uint64(0)
@@ -463,6 +465,29 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 	return formattedBlock, nil
 }
 
+// blockMaxEVMGas returns the gas limit of the EVM transactions of the block at the given
+// height, which is the consensus max block gas capped by the max EVM block gas of the
+// fee market. The consensus max block gas is returned along with the error if the fee
+// market params can't be queried.
+func (b *Backend) blockMaxEVMGas(ctx context.Context, height int64) (int64, error) {
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(ctx, b.clientCtx, height)
+	if err != nil {
+		return gasLimit, errors.Wrap(err, "failed to query consensus params")
+	}
+
+	res, err := b.queryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return gasLimit, errors.Wrap(err, "failed to query fee market params")
+	}
+
+	maxEVMBlockGas := res.Params.MaxEVMBlockGas
+	if maxEVMBlockGas != 0 && maxEVMBlockGas < uint64(gasLimit) { // #nosec G701 -- gasLimit is positive
+		return int64(maxEVMBlockGas), nil // #nosec G701 -- lower than gasLimit
+	}
+
+	return gasLimit, nil
+}
+
 // EthBlockByNumber returns the Ethereum Block identified by number.
 func (b *Backend) EthBlockByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Block, error) {
 	resBlock, err := b.TendermintBlockByNumber(blockNum)
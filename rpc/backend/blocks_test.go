@@ -20,6 +20,7 @@ import (
 	ethrpc "github.com/evmos/evmos/v16/rpc/types"
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 func (suite *BackendTestSuite) TestBlockNumber() {
@@ -163,6 +164,9 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 				blockRes, _ = RegisterBlockResults(client, blockNum.Int64())
 				RegisterConsensusParams(client, height)
 
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
+
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
@@ -185,6 +189,9 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 				blockRes, _ = RegisterBlockResults(client, blockNum.Int64())
 				RegisterConsensusParams(client, height)
 
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
+
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
@@ -308,6 +315,9 @@ func (suite *BackendTestSuite) TestGetBlockByHash() {
 				blockRes, _ = RegisterBlockResults(client, height)
 				RegisterConsensusParams(client, height)
 
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
+
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
@@ -331,6 +341,9 @@ func (suite *BackendTestSuite) TestGetBlockByHash() {
 				blockRes, _ = RegisterBlockResults(client, height)
 				RegisterConsensusParams(client, height)
 
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
+
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
@@ -920,6 +933,9 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
 			},
 			false,
 			true,
@@ -944,6 +960,9 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
 			},
 			true,
 			true,
@@ -968,6 +987,9 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
 			},
 			true,
 			true,
@@ -1022,6 +1044,9 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
 			},
 			false,
 			true,
@@ -1046,6 +1071,9 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
 			},
 			true,
 			true,
@@ -1070,6 +1098,9 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				RegisterConsensusParams(client, height)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
 			},
 			true,
 			true,
@@ -1629,3 +1660,93 @@ func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestBlockMaxEVMGas() {
+	height := int64(1)
+	consensusMaxGas := int64(^uint32(0)) // for `MaxGas = -1` (DefaultConsensusParams)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expGasLimit  int64
+		expPass      bool
+	}{
+		{
+			"fail - consensus params error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterConsensusParamsError(client, height)
+			},
+			consensusMaxGas,
+			false,
+		},
+		{
+			"fail - fee market params error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterConsensusParams(client, height)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParamsError(feeMarketClient, height)
+			},
+			consensusMaxGas,
+			false,
+		},
+		{
+			"pass - max EVM block gas not set",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterConsensusParams(client, height)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, height)
+			},
+			consensusMaxGas,
+			true,
+		},
+		{
+			"pass - max EVM block gas lower than the consensus max gas",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterConsensusParams(client, height)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				params := feemarkettypes.DefaultParams()
+				params.MaxEVMBlockGas = 10_000_000
+				feeMarketClient.On("Params", ethrpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
+					Return(&feemarkettypes.QueryParamsResponse{Params: params}, nil)
+			},
+			10_000_000,
+			true,
+		},
+		{
+			"pass - max EVM block gas higher than the consensus max gas",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterConsensusParams(client, height)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				params := feemarkettypes.DefaultParams()
+				params.MaxEVMBlockGas = uint64(consensusMaxGas) + 1
+				feeMarketClient.On("Params", ethrpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
+					Return(&feemarkettypes.QueryParamsResponse{Params: params}, nil)
+			},
+			consensusMaxGas,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			gasLimit, err := suite.backend.blockMaxEVMGas(ethrpc.ContextWithHeight(height), height)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+			suite.Require().Equal(tc.expGasLimit, gasLimit)
+		})
+	}
+}
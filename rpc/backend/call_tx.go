@@ -71,7 +71,8 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 	}
 
 	for _, tx := range pending {
-		// FIXME does Resend api possible at all?  https://github.com/evmos/ethermint/issues/905
+		// NOTE: the pending tx is replaced on CheckTx by the app-side mempool if the new
+		// tx fees are increased by at least the configured price bump
 		p, err := evmtypes.UnwrapEthereumMsg(tx, common.Hash{})
 		if err != nil {
 			// not valid ethereum tx
@@ -153,7 +154,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
 	if rsp != nil && rsp.Code != 0 {
-		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+		err = toEthereumTxError(errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog))
 	}
 	if err != nil {
 		b.logger.Error("failed to broadcast tx", "error", err.Error())
@@ -354,6 +354,19 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 			common.HexToHash(ethTx.Hash),
 			false,
 		},
+		{
+			"fail - replacement transaction underpriced",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBroadcastTxReplacementUnderpriced(client, txBytes)
+			},
+			rlpEncodedBz,
+			common.HexToHash(ethTx.Hash),
+			false,
+		},
 		{
 			"pass - Gets the correct transaction hash of the eth transaction",
 			func() {
@@ -3,6 +3,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
@@ -178,9 +180,21 @@ func (b *Backend) FeeHistory(
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
+	rewardCount := len(rewardPercentiles)
+
+	// the rewards can only be calculated from the block transactions, so the
+	// base fee history of the fee market module is only used when no reward
+	// percentiles are requested
+	if rewardCount == 0 {
+		feeHistory, err := b.feeHistoryFromBaseFeeHistory(blockStart, blockEnd)
+		if err == nil {
+			return feeHistory, nil
+		}
+		b.logger.Debug("failed to get fee history from the base fee history, fetching the blocks", "error", err.Error())
+	}
+
 	// prepare space
 	reward := make([][]*hexutil.Big, blocks)
-	rewardCount := len(rewardPercentiles)
 	for i := 0; i < int(blocks); i++ {
 		reward[i] = make([]*hexutil.Big, rewardCount)
 	}
@@ -246,15 +260,115 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
-// SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// feeHistoryFromBaseFeeHistory returns the base fees and the gas used ratios of
+// the given range of blocks from the base fee history kept by the fee market
+// module, which avoids fetching the blocks and their results. It fails if any
+// of the blocks is not part of the base fee history.
+func (b *Backend) feeHistoryFromBaseFeeHistory(blockStart, blockEnd int64) (*rpctypes.FeeHistoryResult, error) {
+	// NOTE: query the entry of the next block too, as it contains the base fee
+	// that follows the last block if it has already been committed
+	res, err := b.queryClient.FeeMarket.BaseFeeHistory(b.ctx, &feemarkettypes.QueryBaseFeeHistoryRequest{
+		FromHeight: blockStart,
+		ToHeight:   blockEnd + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := blockEnd + 1 - blockStart
+	if int64(len(res.Entries)) < blocks {
+		return nil, fmt.Errorf("base fee history only contains %d out of %d blocks", len(res.Entries), blocks)
+	}
+
+	// NOTE: use the same EVM block gas limit as the fetched blocks, which is
+	// capped by the max EVM block gas of the fee market
+	gasLimit, err := b.blockMaxEVMGas(rpctypes.ContextWithHeight(blockEnd), blockEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	if gasLimit <= 0 {
+		return nil, fmt.Errorf("gasLimit of block height %d should be bigger than 0 , current gaslimit %d", blockEnd, gasLimit)
+	}
+
+	thisBaseFee := make([]*hexutil.Big, blocks+1)
+	thisGasUsedRatio := make([]float64, blocks)
+
+	for i, entry := range res.Entries {
+		index := entry.Height - blockStart
+		if index != int64(i) {
+			return nil, fmt.Errorf("block %d is missing from the base fee history", blockStart+int64(i))
+		}
+
+		thisBaseFee[index] = (*hexutil.Big)(entry.BaseFee.BigInt())
+		if index < blocks {
+			thisGasUsedRatio[index] = float64(entry.GasUsed) / float64(gasLimit)
+		}
+	}
+
+	// calculate the base fee that follows the last block if it's the latest one
+	if thisBaseFee[blocks] == nil {
+		nextBaseFee, err := b.nextBaseFee(blockEnd)
+		if err != nil {
+			return nil, err
+		}
+		thisBaseFee[blocks] = (*hexutil.Big)(nextBaseFee)
+	}
+
+	return &rpctypes.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:      thisBaseFee,
+		GasUsedRatio: thisGasUsedRatio,
+	}, nil
+}
+
+// nextBaseFee returns the base fee of the block that follows the given one, as
+// calculated by the fee market module with its base fee strategy and bounds. It
+// returns zero if the base fee is not enabled.
+func (b *Backend) nextBaseFee(height int64) (*big.Int, error) {
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(rpctypes.ContextWithHeight(height), &height)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.queryClient.FeeMarket.NextBaseFee(rpctypes.ContextWithHeight(height), &feemarkettypes.QueryNextBaseFeeRequest{
+		BlockMaxGas: cp.ConsensusParams.Block.MaxGas,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.BaseFee == nil {
+		return new(big.Int), nil
+	}
+
+	return res.BaseFee.BigInt(), nil
+}
+
+// SuggestGasTipCap returns the suggested tip cap, which is sampled from the effective tips of the
+// recent blocks by the gas price oracle. If the oracle is disabled or the recent blocks don't
+// contain any EVM transactions, we return the max base fee change to help client to mitigate the
+// base fee changes.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	if baseFee == nil {
 		// london hardfork not enabled or feemarket not enabled
 		return big.NewInt(0), nil
 	}
 
+	// suggest the tip from the recent blocks if the gas price oracle is enabled,
+	// falling back to the max base fee delta otherwise
+	tip, err := b.suggestTipCapFromOracle()
+	if err != nil {
+		b.logger.Debug("gas price oracle failed to suggest the tip", "error", err.Error())
+	} else if tip != nil {
+		return tip, nil
+	}
+
 	params, err := b.queryClient.FeeMarket.Params(b.ctx, &feemarkettypes.QueryParamsRequest{})
 	if err != nil {
 		return nil, err
@@ -276,3 +390,32 @@ func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	}
 	return big.NewInt(maxDelta), nil
 }
+
+// GetPrecompiles returns the registry of the precompiled contracts available in
+// the EVM at the latest block, including their name, version and ABI.
+func (b *Backend) GetPrecompiles(activeOnly bool) ([]rpctypes.PrecompileResult, error) {
+	res, err := b.queryClient.PrecompileRegistry(b.ctx, &evmtypes.QueryPrecompileRegistryRequest{
+		ActiveOnly: activeOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	precompiles := make([]rpctypes.PrecompileResult, len(res.Precompiles))
+	for i, info := range res.Precompiles {
+		precompiles[i] = rpctypes.PrecompileResult{
+			Name:    info.Name,
+			Address: common.HexToAddress(info.Address),
+			Version: info.Version,
+			Active:  info.Active,
+		}
+
+		if info.Abi != "" {
+			abiHash := common.HexToHash(info.AbiHash)
+			precompiles[i].ABIHash = &abiHash
+			precompiles[i].ABI = json.RawMessage(info.Abi)
+		}
+	}
+
+	return precompiles, nil
+}
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 
@@ -321,6 +322,62 @@ func (suite *BackendTestSuite) TestGlobalMinGasPrice() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetPrecompiles() {
+	abiJSON := `[{"inputs":[],"name":"foo","outputs":[],"stateMutability":"view","type":"function"}]`
+	abiHash := common.HexToHash("0x1234")
+	precompiles := []evmtypes.PrecompileInfo{
+		{Name: "ecrecover", Address: "0x0000000000000000000000000000000000000001", Version: "v1", Active: true},
+		{Name: "staking", Address: "0x0000000000000000000000000000000000000800", Version: "v1", AbiHash: abiHash.Hex(), Abi: abiJSON},
+	}
+
+	testCases := []struct {
+		name           string
+		registerMock   func()
+		activeOnly     bool
+		expPrecompiles []rpc.PrecompileResult
+		expPass        bool
+	}{
+		{
+			"fail - can't query the precompile registry",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPrecompileRegistryError(queryClient)
+			},
+			false,
+			nil,
+			false,
+		},
+		{
+			"pass - precompiles with and without ABI",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterPrecompileRegistry(queryClient, true, precompiles)
+			},
+			true,
+			[]rpc.PrecompileResult{
+				{Name: "ecrecover", Address: common.BytesToAddress([]byte{0x01}), Version: "v1", Active: true},
+				{Name: "staking", Address: common.HexToAddress("0x0000000000000000000000000000000000000800"), Version: "v1", ABIHash: &abiHash, ABI: []byte(abiJSON)},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.GetPrecompiles(tc.activeOnly)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPrecompiles, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestFeeHistory() {
 	testCases := []struct {
 		name           string
@@ -401,6 +458,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				RegisterBaseFeeError(queryClient)
 				RegisterValidatorAccount(queryClient, validator)
 				RegisterConsensusParams(client, 1)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
 			},
 			1,
 			1,
@@ -411,7 +471,6 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 		{
 			"pass - Valid FeeHistoryResults object",
 			func(validator sdk.AccAddress) {
-				var header metadata.MD
 				baseFee := math.NewInt(1)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
@@ -423,8 +482,10 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				RegisterBaseFee(queryClient, baseFee)
 				RegisterValidatorAccount(queryClient, validator)
 				RegisterConsensusParams(client, 1)
-				RegisterParams(queryClient, &header, 1)
-				RegisterParamsWithoutHeader(queryClient, 1)
+
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterNextBaseFee(feeMarketClient, 1, baseFee)
 			},
 			1,
 			1,
@@ -454,3 +515,132 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestFeeHistoryFromBaseFeeHistory() {
+	testCases := []struct {
+		name           string
+		registerMock   func()
+		userBlockCount ethrpc.DecimalOrHex
+		latestBlock    ethrpc.BlockNumber
+		expFeeHistory  *rpc.FeeHistoryResult
+		expPass        bool
+	}{
+		{
+			"fail - incomplete base fee history, blocks are fetched",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterBaseFeeHistory(feeMarketClient, 1, 3, []feemarkettypes.BaseFeeHistoryEntry{
+					{Height: 2, BaseFee: math.NewInt(2), GasWanted: 100, GasUsed: 50},
+				})
+				RegisterBlockError(client, 1)
+			},
+			2,
+			2,
+			nil,
+			false,
+		},
+		{
+			"pass - fee history from the base fee history",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterBaseFeeHistory(feeMarketClient, 1, 3, []feemarkettypes.BaseFeeHistoryEntry{
+					{Height: 1, BaseFee: math.NewInt(1), GasWanted: 0, GasUsed: 0},
+					{Height: 2, BaseFee: math.NewInt(2), GasWanted: 100, GasUsed: 50},
+					{Height: 3, BaseFee: math.NewInt(3), GasWanted: 0, GasUsed: 0},
+				})
+				RegisterConsensusParams(client, 2)
+				RegisterFeeMarketParams(feeMarketClient, 2)
+			},
+			2,
+			2,
+			&rpc.FeeHistoryResult{
+				OldestBlock: (*hexutil.Big)(big.NewInt(1)),
+				BaseFee: []*hexutil.Big{
+					(*hexutil.Big)(big.NewInt(1)),
+					(*hexutil.Big)(big.NewInt(2)),
+					(*hexutil.Big)(big.NewInt(3)),
+				},
+				// the default consensus params have an unlimited block gas
+				GasUsedRatio: []float64{0, 50 / float64(^uint32(0))},
+			},
+			true,
+		},
+		{
+			"pass - gas used ratio against the max EVM block gas",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterBaseFeeHistory(feeMarketClient, 1, 3, []feemarkettypes.BaseFeeHistoryEntry{
+					{Height: 1, BaseFee: math.NewInt(1), GasWanted: 0, GasUsed: 0},
+					{Height: 2, BaseFee: math.NewInt(2), GasWanted: 100, GasUsed: 50},
+					{Height: 3, BaseFee: math.NewInt(3), GasWanted: 0, GasUsed: 0},
+				})
+				RegisterConsensusParams(client, 2)
+				params := feemarkettypes.DefaultParams()
+				params.MaxEVMBlockGas = 200
+				params.TargetBlockGas = 100
+				feeMarketClient.On("Params", rpc.ContextWithHeight(2), &feemarkettypes.QueryParamsRequest{}).
+					Return(&feemarkettypes.QueryParamsResponse{Params: params}, nil)
+			},
+			2,
+			2,
+			&rpc.FeeHistoryResult{
+				OldestBlock: (*hexutil.Big)(big.NewInt(1)),
+				BaseFee: []*hexutil.Big{
+					(*hexutil.Big)(big.NewInt(1)),
+					(*hexutil.Big)(big.NewInt(2)),
+					(*hexutil.Big)(big.NewInt(3)),
+				},
+				GasUsedRatio: []float64{0, 0.25},
+			},
+			true,
+		},
+		{
+			"pass - next base fee of the latest block from the fee market module",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterBaseFeeHistory(feeMarketClient, 1, 3, []feemarkettypes.BaseFeeHistoryEntry{
+					{Height: 1, BaseFee: math.NewInt(1), GasWanted: 0, GasUsed: 0},
+					{Height: 2, BaseFee: math.NewInt(2), GasWanted: 100, GasUsed: 50},
+				})
+				RegisterConsensusParams(client, 2)
+				RegisterFeeMarketParams(feeMarketClient, 2)
+				RegisterNextBaseFee(feeMarketClient, 2, math.NewInt(5))
+			},
+			2,
+			2,
+			&rpc.FeeHistoryResult{
+				OldestBlock: (*hexutil.Big)(big.NewInt(1)),
+				BaseFee: []*hexutil.Big{
+					(*hexutil.Big)(big.NewInt(1)),
+					(*hexutil.Big)(big.NewInt(2)),
+					(*hexutil.Big)(big.NewInt(5)),
+				},
+				GasUsedRatio: []float64{0, 50 / float64(^uint32(0))},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			feeHistory, err := suite.backend.FeeHistory(tc.userBlockCount, tc.latestBlock, nil)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expFeeHistory, feeHistory)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
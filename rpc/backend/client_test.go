@@ -15,6 +15,7 @@ import (
 	"github.com/cometbft/cometbft/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v16/app/mempool"
 	"github.com/evmos/evmos/v16/rpc/backend/mocks"
 	rpc "github.com/evmos/evmos/v16/rpc/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
@@ -57,6 +58,15 @@ func RegisterBroadcastTxError(client *mocks.Client, tx types.Tx) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterBroadcastTxReplacementUnderpriced(client *mocks.Client, tx types.Tx) {
+	client.On("BroadcastTxSync", context.Background(), tx).
+		Return(&tmrpctypes.ResultBroadcastTx{
+			Code:      mempool.ErrReplacementUnderpriced.ABCICode(),
+			Codespace: mempool.ErrReplacementUnderpriced.Codespace(),
+			Log:       mempool.ErrReplacementUnderpriced.Error(),
+		}, nil)
+}
+
 // Unconfirmed Transactions
 func RegisterUnconfirmedTxs(client *mocks.Client, limit *int, txs []types.Tx) {
 	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
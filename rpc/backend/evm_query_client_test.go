@@ -170,6 +170,17 @@ func RegisterBaseFeeError(queryClient *mocks.EVMQueryClient) {
 		Return(&evmtypes.QueryBaseFeeResponse{}, evmtypes.ErrInvalidBaseFee)
 }
 
+// Precompile registry
+func RegisterPrecompileRegistry(queryClient *mocks.EVMQueryClient, activeOnly bool, precompiles []evmtypes.PrecompileInfo) {
+	queryClient.On("PrecompileRegistry", rpc.ContextWithHeight(1), &evmtypes.QueryPrecompileRegistryRequest{ActiveOnly: activeOnly}).
+		Return(&evmtypes.QueryPrecompileRegistryResponse{Precompiles: precompiles}, nil)
+}
+
+func RegisterPrecompileRegistryError(queryClient *mocks.EVMQueryClient) {
+	queryClient.On("PrecompileRegistry", rpc.ContextWithHeight(1), mock.Anything).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Base fee not enabled
 func RegisterBaseFeeDisabled(queryClient *mocks.EVMQueryClient) {
 	queryClient.On("BaseFee", rpc.ContextWithHeight(1), &evmtypes.QueryBaseFeeRequest{}).
@@ -1,6 +1,8 @@
 package backend
 
 import (
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/evmos/evmos/v16/rpc/backend/mocks"
 	rpc "github.com/evmos/evmos/v16/rpc/types"
@@ -19,3 +21,20 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// BaseFeeHistory
+func RegisterBaseFeeHistory(
+	feeMarketClient *mocks.FeeMarketQueryClient,
+	fromHeight, toHeight int64,
+	entries []feemarkettypes.BaseFeeHistoryEntry,
+) {
+	feeMarketClient.On("BaseFeeHistory", rpc.ContextWithHeight(1), &feemarkettypes.QueryBaseFeeHistoryRequest{FromHeight: fromHeight, ToHeight: toHeight}).
+		Return(&feemarkettypes.QueryBaseFeeHistoryResponse{Entries: entries}, nil)
+}
+
+// NextBaseFee
+func RegisterNextBaseFee(feeMarketClient *mocks.FeeMarketQueryClient, height int64, baseFee math.Int) {
+	req := &feemarkettypes.QueryNextBaseFeeRequest{BlockMaxGas: types.DefaultConsensusParams().Block.MaxGas}
+	feeMarketClient.On("NextBaseFee", rpc.ContextWithHeight(height), req).
+		Return(&feemarkettypes.QueryNextBaseFeeResponse{BaseFee: &baseFee}, nil)
+}
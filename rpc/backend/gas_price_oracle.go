@@ -0,0 +1,152 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	rpctypes "github.com/evmos/evmos/v16/rpc/types"
+)
+
+// gpoSampleNumber is the number of the lowest effective tips sampled from each
+// block, so that blocks with many transactions don't dominate the suggestion.
+const gpoSampleNumber = 3
+
+// gasPriceOracle caches the effective tips sampled from the recent blocks and
+// the last suggested tip, in order to avoid querying the same blocks on every
+// request. It works like the go-ethereum gas price oracle.
+type gasPriceOracle struct {
+	mtx sync.Mutex
+	// lastHeight is the latest block height when the last tip was suggested
+	lastHeight int64
+	lastTip    *big.Int
+	// blockTips maps the heights of the sampled blocks to their lowest tips
+	blockTips map[int64][]*big.Int
+}
+
+// newGasPriceOracle creates a new gas price oracle with an empty cache.
+func newGasPriceOracle() *gasPriceOracle {
+	return &gasPriceOracle{
+		blockTips: make(map[int64][]*big.Int),
+	}
+}
+
+// suggestTipCapFromOracle returns the effective tip at the configured
+// percentile of the tips sampled from the last blocks. It returns nil if the
+// oracle is disabled or if the sampled blocks don't contain any EVM
+// transactions. The blocks that can't be fetched are skipped.
+//
+// NOTE: the blocks are fetched without holding the oracle lock, so that slow
+// queries don't block concurrent requests.
+func (b *Backend) suggestTipCapFromOracle() (*big.Int, error) {
+	blocks := int64(b.cfg.JSONRPC.GasPriceOracleBlocks)
+	if blocks <= 0 {
+		return nil, nil
+	}
+
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	height := int64(latest) //#nosec G701 -- checked for int overflow already
+
+	oracle := b.gasPriceOracle
+	oracle.mtx.Lock()
+	if oracle.lastTip != nil && oracle.lastHeight == height {
+		lastTip := new(big.Int).Set(oracle.lastTip)
+		oracle.mtx.Unlock()
+		return lastTip, nil
+	}
+
+	// only keep the blocks of the current window in the cache
+	blockTips := make(map[int64][]*big.Int, blocks)
+	for h := height; h > 0 && h > height-blocks; h-- {
+		if sampled, ok := oracle.blockTips[h]; ok {
+			blockTips[h] = sampled
+		}
+	}
+	oracle.mtx.Unlock()
+
+	var tips []*big.Int
+	for h := height; h > 0 && h > height-blocks; h-- {
+		sampled, ok := blockTips[h]
+		if !ok {
+			sampled, err = b.sampleBlockTips(h)
+			if err != nil {
+				b.logger.Debug("failed to sample the block tips", "height", h, "error", err.Error())
+				continue
+			}
+			blockTips[h] = sampled
+		}
+
+		tips = append(tips, sampled...)
+	}
+
+	oracle.mtx.Lock()
+	defer oracle.mtx.Unlock()
+
+	// a concurrent request may have already suggested a tip for a later block
+	if oracle.lastTip != nil && oracle.lastHeight > height {
+		return new(big.Int).Set(oracle.lastTip), nil
+	}
+
+	oracle.blockTips = blockTips
+
+	if len(tips) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(tips, func(i, j int) bool {
+		return tips[i].Cmp(tips[j]) < 0
+	})
+
+	oracle.lastHeight = height
+	oracle.lastTip = tips[(len(tips)-1)*b.cfg.JSONRPC.GasPriceOraclePercentile/100]
+
+	return new(big.Int).Set(oracle.lastTip), nil
+}
+
+// sampleBlockTips returns the lowest effective tips paid by the EVM
+// transactions of the block at the given height.
+func (b *Backend) sampleBlockTips(height int64) ([]*big.Int, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, fmt.Errorf("block not found for height %d", height)
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	tips := make([]*big.Int, 0, len(msgs))
+	for _, msg := range msgs {
+		tip := msg.AsTransaction().EffectiveGasTipValue(baseFee)
+		if tip == nil || tip.Sign() < 0 {
+			continue
+		}
+		tips = append(tips, tip)
+	}
+
+	sort.Slice(tips, func(i, j int) bool {
+		return tips[i].Cmp(tips[j]) < 0
+	})
+
+	if len(tips) > gpoSampleNumber {
+		tips = tips[:gpoSampleNumber]
+	}
+
+	return tips, nil
+}
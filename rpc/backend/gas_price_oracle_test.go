@@ -0,0 +1,153 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/evmos/evmos/v16/rpc/backend/mocks"
+	rpc "github.com/evmos/evmos/v16/rpc/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// buildDynamicFeeTx returns an encoded dynamic fee Ethereum transaction with
+// the given fee cap and tip cap
+func (suite *BackendTestSuite) buildDynamicFeeTx(nonce uint64, gasFeeCap, gasTipCap int64) tmtypes.Tx {
+	msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   suite.backend.chainID,
+		Nonce:     nonce,
+		To:        &common.Address{},
+		Amount:    big.NewInt(0),
+		GasLimit:  100000,
+		GasFeeCap: big.NewInt(gasFeeCap),
+		GasTipCap: big.NewInt(gasTipCap),
+	})
+	msgEthereumTx.From = suite.from.Hex()
+
+	txBuilder := suite.backend.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(msgEthereumTx))
+
+	bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	suite.Require().NoError(err)
+	return bz
+}
+
+func (suite *BackendTestSuite) TestSuggestGasTipCapFromOracle() {
+	baseFee := math.NewInt(100)
+
+	testCases := []struct {
+		name         string
+		blocks       int
+		percentile   int
+		txs          func() []tmtypes.Tx
+		expGasTipCap *big.Int
+	}{
+		{
+			"pass - oracle disabled, returns the max base fee delta",
+			0,
+			60,
+			func() []tmtypes.Tx { return nil },
+			big.NewInt(12),
+		},
+		{
+			"pass - no EVM txs in the recent blocks, returns the max base fee delta",
+			20,
+			60,
+			func() []tmtypes.Tx { return []tmtypes.Tx{} },
+			big.NewInt(12),
+		},
+		{
+			"pass - returns the sampled tip at the configured percentile",
+			20,
+			60,
+			func() []tmtypes.Tx {
+				return []tmtypes.Tx{
+					suite.buildDynamicFeeTx(0, 1000, 10),
+					suite.buildDynamicFeeTx(1, 1000, 30),
+					suite.buildDynamicFeeTx(2, 1000, 50),
+					// the effective tip is capped by the fee cap
+					suite.buildDynamicFeeTx(3, 105, 40),
+				}
+			},
+			big.NewInt(10),
+		},
+		{
+			"pass - only the lowest tips of each block are sampled",
+			20,
+			100,
+			func() []tmtypes.Tx {
+				return []tmtypes.Tx{
+					suite.buildDynamicFeeTx(0, 1000, 10),
+					suite.buildDynamicFeeTx(1, 1000, 30),
+					suite.buildDynamicFeeTx(2, 1000, 50),
+					suite.buildDynamicFeeTx(3, 105, 40),
+				}
+			},
+			big.NewInt(30),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.GasPriceOracleBlocks = tc.blocks
+			suite.backend.cfg.JSONRPC.GasPriceOraclePercentile = tc.percentile
+
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+
+			txs := tc.txs()
+			if txs != nil {
+				var header metadata.MD
+				RegisterParams(queryClient, &header, 1)
+				RegisterBaseFee(queryClient, baseFee)
+				_, err := RegisterBlockMultipleTxs(client, 1, txs)
+				suite.Require().NoError(err)
+
+				txResults := make([]*types.ResponseDeliverTx, len(txs))
+				for i := range txResults {
+					txResults[i] = &types.ResponseDeliverTx{Code: 0}
+				}
+				client.On("BlockResults", rpc.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+					Return(&tmrpctypes.ResultBlockResults{Height: 1, TxsResults: txResults}, nil)
+			}
+			// the max base fee delta is returned if there are no sampled tips
+			if len(txs) == 0 {
+				RegisterFeeMarketParams(feeMarketClient, 1)
+			}
+
+			gasTipCap, err := suite.backend.SuggestGasTipCap(baseFee.BigInt())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expGasTipCap, gasTipCap)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSuggestGasTipCapFromOracleSkipsFailedBlocks() {
+	suite.SetupTest() // reset test and queries
+	suite.backend.cfg.JSONRPC.GasPriceOracleBlocks = 20
+	suite.backend.cfg.JSONRPC.GasPriceOraclePercentile = 60
+
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+
+	var header metadata.MD
+	RegisterParams(queryClient, &header, 1)
+	RegisterBlockError(client, 1)
+	// the max base fee delta is returned since the only block can't be fetched
+	RegisterFeeMarketParams(feeMarketClient, 1)
+
+	gasTipCap, err := suite.backend.SuggestGasTipCap(big.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(12), gasTipCap)
+	suite.Require().Empty(suite.backend.gasPriceOracle.blockTips)
+}
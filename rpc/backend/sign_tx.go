@@ -98,7 +98,7 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
 	if rsp != nil && rsp.Code != 0 {
-		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+		err = toEthereumTxError(errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog))
 	}
 	if err != nil {
 		b.logger.Error("failed to broadcast tx", "error", err.Error())
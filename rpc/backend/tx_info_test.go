@@ -612,59 +612,3 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 		})
 	}
 }
-
-func (suite *BackendTestSuite) TestGetGasUsed() {
-	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
-	testCases := []struct {
-		name                     string
-		fixRevertGasRefundHeight int64
-		txResult                 *evmostypes.TxResult
-		price                    *big.Int
-		gas                      uint64
-		exp                      uint64
-	}{
-		{
-			"success txResult",
-			1,
-			&evmostypes.TxResult{
-				Height:  1,
-				Failed:  false,
-				GasUsed: 53026,
-			},
-			new(big.Int).SetUint64(0),
-			0,
-			53026,
-		},
-		{
-			"fail txResult before cap",
-			2,
-			&evmostypes.TxResult{
-				Height:  1,
-				Failed:  true,
-				GasUsed: 53026,
-			},
-			new(big.Int).SetUint64(200000),
-			5000000000000,
-			1000000000000000000,
-		},
-		{
-			"fail txResult after cap",
-			2,
-			&evmostypes.TxResult{
-				Height:  3,
-				Failed:  true,
-				GasUsed: 53026,
-			},
-			new(big.Int).SetUint64(200000),
-			5000000000000,
-			53026,
-		},
-	}
-	for _, tc := range testCases {
-		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
-			suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight = tc.fixRevertGasRefundHeight
-			suite.Require().Equal(tc.exp, suite.backend.GetGasUsed(tc.txResult, tc.price, tc.gas))
-			suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight = origin
-		})
-	}
-}
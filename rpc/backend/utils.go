@@ -4,6 +4,7 @@ package backend
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"sort"
@@ -16,7 +17,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -24,6 +25,7 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/evmos/evmos/v16/app/mempool"
 	"github.com/evmos/evmos/v16/rpc/types"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
 )
@@ -94,8 +96,19 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 			if err != nil {
 				continue
 			}
-			if sender == accAddr {
-				nonce++
+			if sender != accAddr {
+				continue
+			}
+
+			// NOTE: replaced txs remain in the CometBFT mempool until they are
+			// rechecked, so the pending nonce is computed from the tx nonces
+			// instead of the number of pending txs.
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				continue
+			}
+			if txNonce := txData.GetNonce(); txNonce >= nonce {
+				nonce = txNonce + 1
 			}
 		}
 	}
@@ -103,6 +116,17 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	return nonce, nil
 }
 
+// toEthereumTxError maps the errors returned when broadcasting a transaction
+// to the ones of the go-ethereum transaction pool, which are expected by the
+// Ethereum wallets (e.g. to speed up or cancel a pending transaction).
+func toEthereumTxError(err error) error {
+	if errors.Is(err, mempool.ErrReplacementUnderpriced) {
+		return core.ErrReplaceUnderpriced
+	}
+
+	return err
+}
+
 // output: targetOneFeeHistory
 func (b *Backend) processBlock(
 	tendermintBlock *tmrpctypes.ResultBlock,
@@ -119,15 +143,9 @@ func (b *Backend) processBlock(
 
 	// set basefee
 	targetOneFeeHistory.BaseFee = blockBaseFee
-	cfg := b.ChainConfig()
-	if cfg.IsLondon(big.NewInt(blockHeight + 1)) {
-		header, err := b.CurrentHeader()
-		if err != nil {
-			return err
-		}
-		targetOneFeeHistory.NextBaseFee = misc.CalcBaseFee(cfg, header)
-	} else {
-		targetOneFeeHistory.NextBaseFee = new(big.Int)
+	targetOneFeeHistory.NextBaseFee, err = b.nextBaseFee(blockHeight)
+	if err != nil {
+		return err
 	}
 	// set gas used ratio
 	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
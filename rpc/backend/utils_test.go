@@ -3,7 +3,12 @@ package backend
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/evmos/evmos/v16/app/mempool"
 )
 
 func mookProofs(num int, withData bool) *crypto.ProofOps {
@@ -50,3 +55,31 @@ func (suite *BackendTestSuite) TestGetHexProofs() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestToEthereumTxError() {
+	testCases := []struct {
+		name   string
+		err    error
+		expErr error
+	}{
+		{
+			"replacement transaction underpriced",
+			errorsmod.ABCIError(
+				mempool.ErrReplacementUnderpriced.Codespace(),
+				mempool.ErrReplacementUnderpriced.ABCICode(),
+				"sender, nonce 0",
+			),
+			core.ErrReplaceUnderpriced,
+		},
+		{
+			"other errors are not mapped",
+			errortypes.ErrInvalidSequence,
+			errortypes.ErrInvalidSequence,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.Require().Equal(tc.expErr, toEthereumTxError(tc.err))
+		})
+	}
+}
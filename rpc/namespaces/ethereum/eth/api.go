@@ -82,6 +82,7 @@ type EthereumAPI interface {
 	FeeHistory(blockCount rpc.DecimalOrHex, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
+	GetPrecompiles(activeOnly *bool) ([]rpctypes.PrecompileResult, error)
 
 	// Getting Uncles
 	//
@@ -338,6 +339,14 @@ func (e *PublicAPI) ChainId() (*hexutil.Big, error) { //nolint
 	return e.backend.ChainID()
 }
 
+// GetPrecompiles returns the registry of the precompiled contracts available in
+// the EVM. If activeOnly is true, only the precompiled contracts enabled in the
+// EVM parameters are returned.
+func (e *PublicAPI) GetPrecompiles(activeOnly *bool) ([]rpctypes.PrecompileResult, error) {
+	e.logger.Debug("eth_getPrecompiles")
+	return e.backend.GetPrecompiles(activeOnly != nil && *activeOnly)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Uncles															          ///
 ///////////////////////////////////////////////////////////////////////////////
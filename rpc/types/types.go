@@ -3,6 +3,7 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -84,6 +85,16 @@ type SignTransactionResult struct {
 	Tx  *ethtypes.Transaction `json:"tx"`
 }
 
+// PrecompileResult represents the registry entry of a precompiled contract.
+type PrecompileResult struct {
+	Name    string          `json:"name"`
+	Address common.Address  `json:"address"`
+	Version string          `json:"version"`
+	Active  bool            `json:"active"`
+	ABIHash *common.Hash    `json:"abiHash,omitempty"`
+	ABI     json.RawMessage `json:"abi,omitempty"`
+}
+
 type OneFeeHistory struct {
 	BaseFee, NextBaseFee *big.Int   // base fee for each block
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
@@ -53,12 +53,16 @@ const (
 	// DefaultEVMTracer is the default vm.Tracer type
 	DefaultEVMTracer = ""
 
-	// DefaultFixRevertGasRefundHeight is the default height at which to overwrite gas refund
-	DefaultFixRevertGasRefundHeight = 0
-
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultMempoolMaxTxs is the default maximum number of transactions in the app-side mempool,
+	// which is disabled by default
+	DefaultMempoolMaxTxs = -1
+
+	// DefaultMempoolPriceBump is the default minimum fee increase, in percent, required to replace a transaction
+	DefaultMempoolPriceBump uint64 = 10
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -68,6 +72,12 @@ const (
 	// DefaultFeeHistoryCap is the default cap for total number of blocks that can be fetched
 	DefaultFeeHistoryCap int32 = 100
 
+	// DefaultGasPriceOracleBlocks is the default number of recent blocks sampled by the gas price oracle
+	DefaultGasPriceOracleBlocks = 20
+
+	// DefaultGasPriceOraclePercentile is the default percentile of the sampled tips suggested by the gas price oracle
+	DefaultGasPriceOraclePercentile = 60
+
 	// DefaultLogsCap is the default cap of results returned from single 'eth_getLogs' query
 	DefaultLogsCap int32 = 10000
 
@@ -138,6 +148,12 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// MempoolMaxTxs defines the maximum number of transactions in the app-side mempool.
+	// A negative value disables the app-side mempool and a zero value makes it unbounded.
+	MempoolMaxTxs int `mapstructure:"mempool-max-txs"`
+	// MempoolPriceBump defines the minimum fee increase, in percent, required to replace
+	// a transaction of the app-side mempool with the same sender and nonce.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -178,8 +194,10 @@ type JSONRPCConfig struct {
 	EnableIndexer bool `mapstructure:"enable-indexer"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
-	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
-	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// GasPriceOracleBlocks defines the number of recent blocks sampled by the gas price oracle (0=disabled).
+	GasPriceOracleBlocks int `mapstructure:"gpo-blocks"`
+	// GasPriceOraclePercentile defines the percentile of the sampled effective tips suggested by the gas price oracle.
+	GasPriceOraclePercentile int `mapstructure:"gpo-percentile"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -244,8 +262,10 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:           DefaultEVMTracer,
+		MaxTxGasWanted:   DefaultMaxTxGasWanted,
+		MempoolMaxTxs:    DefaultMempoolMaxTxs,
+		MempoolPriceBump: DefaultMempoolPriceBump,
 	}
 }
 
@@ -288,7 +308,8 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
-		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		GasPriceOracleBlocks:     DefaultGasPriceOracleBlocks,
+		GasPriceOraclePercentile: DefaultGasPriceOraclePercentile,
 	}
 }
 
@@ -330,6 +351,14 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.GasPriceOracleBlocks < 0 {
+		return errors.New("JSON-RPC gas price oracle blocks cannot be negative")
+	}
+
+	if c.GasPriceOraclePercentile < 0 || c.GasPriceOraclePercentile > 100 {
+		return errors.New("JSON-RPC gas price oracle percentile must be between 0 and 100")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -22,6 +22,15 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# MempoolMaxTxs defines the maximum number of transactions in the app-side mempool, which orders
+# the transactions by priority while keeping the nonce order of each sender.
+# A negative value disables the app-side mempool (default) and a zero value makes it unbounded.
+mempool-max-txs = {{ .EVM.MempoolMaxTxs }}
+
+# MempoolPriceBump defines the minimum fee increase, in percent, required to replace a transaction
+# of the app-side mempool with a new one from the same sender and with the same nonce.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -83,8 +92,13 @@ enable-indexer = {{ .JSONRPC.EnableIndexer }}
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 
-# Upgrade height for fix of revert gas refund logic when transaction reverted.
-fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
+# GasPriceOracleBlocks defines the number of recent blocks sampled by the gas price oracle to suggest
+# the gas tip cap and gas price (0=disabled).
+gpo-blocks = {{ .JSONRPC.GasPriceOracleBlocks }}
+
+# GasPriceOraclePercentile defines the percentile of the effective tips sampled from the recent blocks
+# that is suggested by the gas price oracle.
+gpo-percentile = {{ .JSONRPC.GasPriceOraclePercentile }}
 
 ###############################################################################
 ###                             TLS Configuration                           ###
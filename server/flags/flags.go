@@ -56,13 +56,16 @@ const (
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
-	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCGasPriceOracleBlocks     = "json-rpc.gpo-blocks"
+	JSONRPCGasPriceOraclePercentile = "json-rpc.gpo-percentile"
 )
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer           = "evm.tracer"
+	EVMMaxTxGasWanted   = "evm.max-tx-gas-wanted"
+	EVMMempoolMaxTxs    = "evm.mempool-max-txs"
+	EVMMempoolPriceBump = "evm.mempool-price-bump"
 )
 
 // TLS flags
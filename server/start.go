@@ -49,6 +49,7 @@ import (
 	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	evmosmempool "github.com/evmos/evmos/v16/app/mempool"
 	"github.com/evmos/evmos/v16/cmd/dhivesd/opendb"
 	"github.com/evmos/evmos/v16/indexer"
 	ethdebug "github.com/evmos/evmos/v16/rpc/namespaces/ethereum/debug"
@@ -60,6 +61,12 @@ import (
 // DBOpener is a function to open `application.db`, potentially with customized options.
 type DBOpener func(opts types.AppOptions, rootDir string, backend dbm.BackendType) (dbm.DB, error)
 
+// cometMempoolSetter defines the application method used to register the
+// CometBFT mempool of the node in the app-side mempool.
+type cometMempoolSetter interface {
+	SetCometMempool(cometMempool evmosmempool.CometMempool)
+}
+
 // StartOptions defines options that can be customized in `StartCmd`
 type StartOptions struct {
 	AppCreator      types.AppCreator
@@ -200,9 +207,15 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
+	cmd.Flags().Int(srvflags.JSONRPCGasPriceOracleBlocks, config.DefaultGasPriceOracleBlocks, "Sets the number of recent blocks sampled by the gas price oracle (0=disabled)")     //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCGasPriceOraclePercentile, config.DefaultGasPriceOraclePercentile, "Sets the percentile of the sampled tips suggested by the gas price oracle") //nolint:lll
+
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
 
+	cmd.Flags().Int(srvflags.EVMMempoolMaxTxs, config.DefaultMempoolMaxTxs, "the maximum number of transactions in the app-side mempool (<0=disabled, 0=unbounded)")                           //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, config.DefaultMempoolPriceBump, "the minimum fee increase, in percent, required to replace a transaction with the same sender and nonce") //nolint:lll
+
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
 
@@ -375,6 +388,12 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 			return err
 		}
 
+		// drop the transactions replaced or evicted from the app-side mempool from
+		// the CometBFT mempool too
+		if setter, ok := app.(cometMempoolSetter); ok {
+			setter.SetCometMempool(tmNode.Mempool())
+		}
+
 		if err := tmNode.Start(); err != nil {
 			logger.Error("failed start tendermint server", "error", err.Error())
 			return err
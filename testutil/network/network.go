@@ -58,7 +58,9 @@ import (
 	"github.com/evmos/evmos/v16/encoding"
 	"github.com/evmos/evmos/v16/server/config"
 	evmostypes "github.com/evmos/evmos/v16/types"
+	"github.com/evmos/evmos/v16/utils"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	randomnesstypes "github.com/evmos/evmos/v16/x/randomness/types"
 )
 
 // package-wide network lock to only allow one test network at a time
@@ -127,6 +129,28 @@ func DefaultConfig() Config {
 	}
 }
 
+// RandomnessConfig returns a configuration for a local testnet that produces
+// randomness from the given seed. It uses the testnet chain ID supported by the
+// EVM, commits the blocks every second so that the randomness requests are
+// fulfilled quickly, and enables the JSON-RPC server to interact with the
+// randomness precompile.
+func RandomnessConfig(seed common.Hash) Config {
+	cfg := DefaultConfig()
+	cfg.ChainID = utils.TestnetChainID + "-1"
+	cfg.AppConstructor = NewAppConstructor(encoding.MakeConfig(app.ModuleBasics), cfg.ChainID)
+	cfg.TimeoutCommit = time.Second
+	cfg.NumValidators = 1
+	cfg.JSONRPCAddress = config.DefaultJSONRPCAddress
+
+	randomnessGenesis, err := json.Marshal(randomnesstypes.NewGenesisState(seed))
+	if err != nil {
+		panic(err)
+	}
+	cfg.GenesisState[randomnesstypes.ModuleName] = randomnessGenesis
+
+	return cfg
+}
+
 // NewAppConstructor returns a new Evmos AppConstructor
 func NewAppConstructor(encodingCfg params.EncodingConfig, chainID string) AppConstructor {
 	return func(val Validator) servertypes.Application {
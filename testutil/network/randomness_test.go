@@ -0,0 +1,56 @@
+//go:build norace
+// +build norace
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/precompiles/randomness"
+	"github.com/evmos/evmos/v16/testutil/network"
+	randomnesskeeper "github.com/evmos/evmos/v16/x/randomness/keeper"
+)
+
+func TestRandomnessNetwork(t *testing.T) {
+	nw, err := network.New(t, t.TempDir(), network.RandomnessConfig(common.HexToHash("0x1234")))
+	require.NoError(t, err)
+	defer nw.Cleanup()
+
+	_, err = nw.WaitForHeightWithTimeout(4, time.Minute)
+	require.NoError(t, err)
+
+	val := nw.Validators[0]
+	if val.JSONRPCClient == nil {
+		val.JSONRPCClient, err = ethclient.Dial(fmt.Sprintf("http://%s", val.AppConfig.JSONRPC.Address))
+		require.NoError(t, err)
+	}
+
+	// the keeper is not used to pack and unpack the calls
+	precompile, err := randomness.NewPrecompile(randomnesskeeper.Keeper{})
+	require.NoError(t, err)
+
+	to := precompile.Address()
+	randomnessOf := func(height uint64) common.Hash {
+		input, err := precompile.Pack(randomness.GetRandomnessMethod, height)
+		require.NoError(t, err)
+
+		bz, err := val.JSONRPCClient.CallContract(context.Background(), ethereum.CallMsg{To: &to, Data: input}, nil)
+		require.NoError(t, err)
+
+		out, err := precompile.Unpack(randomness.GetRandomnessMethod, bz)
+		require.NoError(t, err)
+		return common.Hash(out[0].([32]byte))
+	}
+
+	first, second := randomnessOf(2), randomnessOf(3)
+	require.NotEqual(t, common.Hash{}, first)
+	require.NotEqual(t, first, second, "expected a different randomness on each block")
+}
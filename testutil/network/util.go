@@ -221,11 +221,15 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 	inflationGenState.Params.MintDenom = cfg.BondDenom
 	cfg.GenesisState[inflationtypes.ModuleName] = cfg.Codec.MustMarshalJSON(&inflationGenState)
 
-	var crisisGenState crisistypes.GenesisState
-	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[crisistypes.ModuleName], &crisisGenState)
-
-	crisisGenState.ConstantFee.Denom = cfg.BondDenom
-	cfg.GenesisState[crisistypes.ModuleName] = cfg.Codec.MustMarshalJSON(&crisisGenState)
+	// NOTE: the crisis module is not registered on the app, so its genesis is
+	// only updated when provided on the configuration
+	if crisisGenesis, ok := cfg.GenesisState[crisistypes.ModuleName]; ok {
+		var crisisGenState crisistypes.GenesisState
+		cfg.Codec.MustUnmarshalJSON(crisisGenesis, &crisisGenState)
+
+		crisisGenState.ConstantFee.Denom = cfg.BondDenom
+		cfg.GenesisState[crisistypes.ModuleName] = cfg.Codec.MustMarshalJSON(&crisisGenState)
+	}
 
 	var evmGenState evmtypes.GenesisState
 	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[evmtypes.ModuleName], &evmGenState)
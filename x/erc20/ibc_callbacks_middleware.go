@@ -0,0 +1,74 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+
+	"github.com/evmos/evmos/v16/ibc"
+	"github.com/evmos/evmos/v16/x/erc20/keeper"
+)
+
+var _ porttypes.IBCModule = &IBCCallbacksMiddleware{}
+
+// IBCCallbacksMiddleware implements the ICS26 callbacks for the IBC packet
+// lifecycle callbacks middleware (ADR-008) given the erc20 keeper and the
+// underlying application. When the memo of a transfer sent by a contract
+// contains the source callback data, the contract is called back once the
+// packet is acknowledged or times out.
+type IBCCallbacksMiddleware struct {
+	*ibc.Module
+	keeper keeper.Keeper
+}
+
+// NewIBCCallbacksMiddleware creates a new IBCCallbacksMiddleware given the
+// keeper and underlying application
+func NewIBCCallbacksMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCCallbacksMiddleware {
+	return IBCCallbacksMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// It executes the underlying application acknowledgement logic and then calls
+// the onAcknowledgement method of the source callback contract. A failing
+// callback doesn't revert the acknowledgement.
+func (im IBCCallbacksMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	success := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && ack.Success()
+
+	im.keeper.OnAcknowledgementCallback(ctx, packet, acknowledgement, success)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// It executes the underlying application timeout logic and then calls the
+// onTimeout method of the source callback contract. A failing callback
+// doesn't revert the timeout.
+func (im IBCCallbacksMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutCallback(ctx, packet)
+	return nil
+}
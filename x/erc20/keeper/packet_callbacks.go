@@ -0,0 +1,158 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v16/ibc"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// OnAcknowledgementCallback calls the onAcknowledgement method of the source
+// callback contract of the packet, if any. Callback failures are only
+// reported through events, so they never prevent the packet acknowledgement.
+func (k Keeper) OnAcknowledgementCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	success bool,
+) {
+	k.executePacketCallback(
+		ctx, packet, types.OnAcknowledgementCallback,
+		packet.SourcePort, packet.SourceChannel, packet.Sequence, acknowledgement, success,
+	)
+}
+
+// OnTimeoutCallback calls the onTimeout method of the source callback contract
+// of the packet, if any. Callback failures are only reported through events,
+// so they never prevent the packet timeout.
+func (k Keeper) OnTimeoutCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) {
+	k.executePacketCallback(
+		ctx, packet, types.OnTimeoutCallback,
+		packet.SourcePort, packet.SourceChannel, packet.Sequence,
+	)
+}
+
+// executePacketCallback executes the callback method on the source callback
+// contract and emits an event with the result.
+func (k Keeper) executePacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	methodName string,
+	args ...interface{},
+) {
+	callback, found, err := ibc.GetSourceCallbackData(packet, types.MaxCallbackGasLimit)
+	if !found {
+		return
+	}
+
+	if err == nil {
+		err = k.callContract(ctx, callback, methodName, args...)
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyCallbackType, methodName),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, callback.ContractAddress.Hex()),
+		sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err != nil {
+		k.Logger(ctx).Debug(
+			"ibc packet callback failed",
+			"callback", methodName,
+			"contract", callback.ContractAddress.Hex(),
+			"sequence", packet.Sequence,
+			"error", err.Error(),
+		)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeIBCCallback, attrs...))
+}
+
+// callContract calls the given method on the callback contract with the
+// callback gas limit. The state changes are only committed if the call
+// succeeds, and the gas used is charged to the relayer. The module account
+// nonce is increased on success, since the EVM only increases it on contract
+// creations.
+//
+// NOTE: the callback contract must be the packet sender, so that contracts
+// are only called back for the packets they sent.
+func (k Keeper) callContract(
+	ctx sdk.Context,
+	callback ibc.CallbackData,
+	methodName string,
+	args ...interface{},
+) error {
+	if callback.ContractAddress != callback.SenderAddress {
+		return errorsmod.Wrapf(
+			ibc.ErrInvalidCallback,
+			"callback contract %s is not the packet sender %s", callback.ContractAddress, callback.SenderAddress,
+		)
+	}
+
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, callback.ContractAddress)
+	if account == nil || !account.IsContract() {
+		return errorsmod.Wrapf(ibc.ErrInvalidCallback, "address %s is not a contract", callback.ContractAddress)
+	}
+
+	method := types.CallbackMethods[methodName]
+	input, err := method.Inputs.Pack(args...)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrABIPack, err.Error())
+	}
+
+	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+	if err != nil {
+		return err
+	}
+
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&callback.ContractAddress,
+		nonce,
+		big.NewInt(0),     // amount
+		callback.GasLimit, // gasLimit
+		big.NewInt(0),     // gasFeeCap
+		big.NewInt(0),     // gasTipCap
+		big.NewInt(0),     // gasPrice
+		append(method.ID, input...),
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	res, err := k.evmKeeper.ApplyMessage(cacheCtx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, fmt.Sprintf("ibc %s callback", methodName))
+
+	if res.Failed() {
+		return errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	moduleAcc := k.accountKeeper.GetAccount(cacheCtx, types.ModuleAddress.Bytes())
+	if err := moduleAcc.SetSequence(nonce + 1); err != nil {
+		return err
+	}
+	k.accountKeeper.SetAccount(cacheCtx, moduleAcc)
+
+	writeFn()
+	return nil
+}
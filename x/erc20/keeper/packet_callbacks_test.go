@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/erc20/types"
+)
+
+var (
+	// storeAndStopCode stores 1 in the slot 0 and stops the execution
+	storeAndStopCode = common.FromHex("0x600160005500")
+	// storeAndRevertCode stores 1 in the slot 0 and reverts the execution
+	storeAndRevertCode = common.FromHex("0x600160005560006000fd")
+)
+
+func (suite *KeeperTestSuite) TestOnTimeoutCallback() {
+	var (
+		contract common.Address
+		sender   common.Address
+		memo     string
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expEvent   bool
+		expSuccess bool
+		expStored  bool
+	}{
+		{
+			"no callback - empty memo",
+			func() {
+				sender = tx.GenerateAddress()
+			},
+			false,
+			false,
+			false,
+		},
+		{
+			"fail - callback contract is not the packet sender",
+			func() {
+				contract = suite.setCode(storeAndStopCode)
+				sender = tx.GenerateAddress()
+			},
+			true,
+			false,
+			false,
+		},
+		{
+			"fail - callback address is not a contract",
+			func() {
+				contract = tx.GenerateAddress()
+				sender = contract
+			},
+			true,
+			false,
+			false,
+		},
+		{
+			"fail - callback reverts",
+			func() {
+				contract = suite.setCode(storeAndRevertCode)
+				sender = contract
+			},
+			true,
+			false,
+			false,
+		},
+		{
+			"pass - callback succeeds",
+			func() {
+				contract = suite.setCode(storeAndStopCode)
+				sender = contract
+			},
+			true,
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			contract = common.Address{}
+			memo = ""
+			tc.malleate()
+			if contract != (common.Address{}) {
+				memo = fmt.Sprintf(`{"src_callback":{"address":"%s","gas_limit":"100000"}}`, contract.Hex())
+			}
+
+			packet := channeltypes.Packet{
+				Sequence:      1,
+				SourcePort:    transfertypes.PortID,
+				SourceChannel: "channel-0",
+				Data: transfertypes.ModuleCdc.MustMarshalJSON(
+					&transfertypes.FungibleTokenPacketData{
+						Denom:    "aevmos",
+						Amount:   "1000",
+						Sender:   sdk.AccAddress(sender.Bytes()).String(),
+						Receiver: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+						Memo:     memo,
+					},
+				),
+			}
+
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			nonce, err := suite.app.AccountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+			suite.Require().NoError(err)
+
+			suite.app.Erc20Keeper.OnTimeoutCallback(ctx, packet)
+
+			events := ctx.EventManager().Events()
+			var callbackEvent *sdk.Event
+			for i := range events {
+				if events[i].Type == types.EventTypeIBCCallback {
+					callbackEvent = &events[i]
+				}
+			}
+
+			if !tc.expEvent {
+				suite.Require().Nil(callbackEvent)
+				return
+			}
+
+			suite.Require().NotNil(callbackEvent)
+			success, found := callbackEvent.GetAttribute(types.AttributeKeySuccess)
+			suite.Require().True(found)
+			suite.Require().Equal(fmt.Sprintf("%t", tc.expSuccess), success.Value)
+
+			stored := suite.app.EvmKeeper.GetState(ctx, contract, common.Hash{})
+			suite.Require().Equal(tc.expStored, stored == common.BigToHash(common.Big1))
+
+			expNonce := nonce
+			if tc.expSuccess {
+				expNonce++
+			}
+			newNonce, err := suite.app.AccountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
+			suite.Require().NoError(err)
+			suite.Require().Equal(expNonce, newNonce)
+		})
+	}
+}
+
+// setCode sets the given runtime code on a new address and returns it.
+func (suite *KeeperTestSuite) setCode(code []byte) common.Address {
+	addr := tx.GenerateAddress()
+	stateDB := suite.StateDB()
+	stateDB.SetCode(addr, code)
+	suite.Require().NoError(stateDB.Commit())
+	return addr
+}
@@ -0,0 +1,56 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// MaxCallbackGasLimit defines the maximum gas limit of the IBC packet
+	// callbacks executed on the source contracts.
+	MaxCallbackGasLimit uint64 = 1_000_000
+
+	// OnAcknowledgementCallback defines the name of the contract method called
+	// when a packet is acknowledged.
+	OnAcknowledgementCallback = "onAcknowledgement"
+	// OnTimeoutCallback defines the name of the contract method called when a
+	// packet times out.
+	OnTimeoutCallback = "onTimeout"
+)
+
+// CallbackMethods defines the methods of the IBC packet callbacks interface
+// that contracts must implement to be called back:
+//
+//	onAcknowledgement(string sourcePort, string sourceChannel, uint64 sequence, bytes acknowledgement, bool success)
+//	onTimeout(string sourcePort, string sourceChannel, uint64 sequence)
+var CallbackMethods map[string]abi.Method
+
+func init() {
+	stringType, _ := abi.NewType("string", "", nil)
+	uint64Type, _ := abi.NewType("uint64", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	boolType, _ := abi.NewType("bool", "", nil)
+
+	packetArgs := abi.Arguments{
+		{Name: "sourcePort", Type: stringType},
+		{Name: "sourceChannel", Type: stringType},
+		{Name: "sequence", Type: uint64Type},
+	}
+
+	ackArgs := append(abi.Arguments{}, packetArgs...)
+	ackArgs = append(ackArgs,
+		abi.Argument{Name: "acknowledgement", Type: bytesType},
+		abi.Argument{Name: "success", Type: boolType},
+	)
+
+	CallbackMethods = map[string]abi.Method{
+		OnAcknowledgementCallback: abi.NewMethod(
+			OnAcknowledgementCallback, OnAcknowledgementCallback, abi.Function, "nonpayable", false, false, ackArgs, nil,
+		),
+		OnTimeoutCallback: abi.NewMethod(
+			OnTimeoutCallback, OnTimeoutCallback, abi.Function, "nonpayable", false, false, packetArgs, nil,
+		),
+	}
+}
@@ -20,10 +20,16 @@ const (
 	EventTypeRegisterCoin          = "register_coin"
 	EventTypeRegisterERC20         = "register_erc20"
 	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+	EventTypeIBCCallback           = "ibc_callback"
 
-	AttributeKeyCosmosCoin = "cosmos_coin"
-	AttributeKeyERC20Token = "erc20_token" // #nosec
-	AttributeKeyReceiver   = "receiver"
+	AttributeKeyCosmosCoin      = "cosmos_coin"
+	AttributeKeyERC20Token      = "erc20_token" // #nosec
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeyCallbackType    = "callback_type"
+	AttributeKeyContractAddress = "contract_address"
+	AttributeKeyPacketSequence  = "packet_sequence"
+	AttributeKeySuccess         = "success"
+	AttributeKeyError           = "error"
 
 	// ERC20EventTransfer defines the transfer event for ERC20
 	ERC20EventTransfer = "Transfer"
@@ -12,6 +12,8 @@ import (
 	"github.com/evmos/evmos/v16/x/evm/types"
 )
 
+const flagActiveOnly = "active-only"
+
 // GetQueryCmd returns the parent command for all x/bank CLi query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetPrecompilesCmd(),
 	)
 	return cmd
 }
@@ -134,3 +137,39 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPrecompilesCmd queries the registry of the precompiled contracts
+func GetPrecompilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "precompiles",
+		Short: "Get the registry of the precompiled contracts",
+		Long:  "Get the name, address, version and ABI of the precompiled contracts available in the EVM.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			activeOnly, err := cmd.Flags().GetBool(flagActiveOnly)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PrecompileRegistry(cmd.Context(), &types.QueryPrecompileRegistryRequest{
+				ActiveOnly: activeOnly,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagActiveOnly, false, "Only return the precompiled contracts enabled in the EVM parameters")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
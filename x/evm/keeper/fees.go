@@ -11,11 +11,13 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	"github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // CheckSenderBalance validates that the tx cost value is positive and that the
@@ -42,12 +44,16 @@ func CheckSenderBalance(
 	return nil
 }
 
-// DeductTxCostsFromUserBalance deducts the fees from the user balance. Returns an
-// error if the specified sender address does not exist or the account balance is not sufficient.
+// DeductTxCostsFromUserBalance deducts the fees from the user balance and forwards them
+// to the fee collector. The fees can be denominated either in the EVM denom or in one of
+// the accepted fee denoms of the fee market, and are multiplied by the gas price multiplier
+// of the called contract, if any. Returns an error if the specified sender address does not
+// exist or the account balance is not sufficient.
 func (k *Keeper) DeductTxCostsFromUserBalance(
 	ctx sdk.Context,
 	fees sdk.Coins,
 	from common.Address,
+	to *common.Address,
 ) error {
 	// fetch sender account
 	signerAcc, err := authante.GetSignerAcc(ctx, k.accountKeeper, from.Bytes())
@@ -55,6 +61,9 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 		return errorsmod.Wrapf(err, "account not found for sender %s", from)
 	}
 
+	// apply the discount or surcharge of the called contract
+	fees = feemarkettypes.ApplyGasPriceMultiplier(fees, k.feeMarketKeeper.GetGasPriceMultiplier(ctx, to))
+
 	// deduct the full gas cost from the user balance
 	if err := authante.DeductFees(k.bankKeeper, ctx, signerAcc, fees); err != nil {
 		return errorsmod.Wrapf(err, "failed to deduct full gas cost %s from the user %s balance", fees, from)
@@ -63,6 +72,21 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 	return nil
 }
 
+// GetTxFeeDenomTransient returns the accepted fee denom used to pay the fees of
+// the Ethereum transaction with the given sender and nonce. It returns an empty
+// string if the fees were paid in the EVM denom.
+func (k Keeper) GetTxFeeDenomTransient(ctx sdk.Context, from common.Address, nonce uint64) string {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeDenom)
+	return string(store.Get(types.TransientFeeDenomKey(from, nonce)))
+}
+
+// SetTxFeeDenomTransient sets the accepted fee denom used to pay the fees of
+// the Ethereum transaction with the given sender and nonce.
+func (k Keeper) SetTxFeeDenomTransient(ctx sdk.Context, from common.Address, nonce uint64, denom string) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeDenom)
+	store.Set(types.TransientFeeDenomKey(from, nonce), []byte(denom))
+}
+
 // VerifyFee is used to return the fee for the given transaction data in sdk.Coins. It checks that the
 // gas limit is not reached, the gas limit is higher than the intrinsic gas and that the
 // base fee is higher than the gas fee cap.
@@ -5,11 +5,14 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v16/testutil"
 	"github.com/evmos/evmos/v16/x/evm/keeper"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 func (suite *KeeperTestSuite) TestCheckSenderBalance() {
@@ -527,7 +530,7 @@ func (suite *KeeperTestSuite) TestVerifyFeeAndDeductTxCostsFromUserBalance() {
 				suite.Require().Nil(fees, "invalid test %d passed. fees value must be nil - '%s'", i, tc.name)
 			}
 
-			err = suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, common.HexToAddress(tx.From))
+			err = suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, common.HexToAddress(tx.From), txData.GetTo())
 			if tc.expectPassDeduct {
 				suite.Require().NoError(err, "valid test %d failed - '%s'", i, tc.name)
 			} else {
@@ -537,3 +540,63 @@ func (suite *KeeperTestSuite) TestVerifyFeeAndDeductTxCostsFromUserBalance() {
 	}
 	suite.enableFeemarket = false // reset flag
 }
+
+func (suite *KeeperTestSuite) TestDeductTxCostsFromUserBalanceInFeeDenom() {
+	suite.SetupTest()
+
+	feeMarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	feeMarketParams.FeeDenoms = []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom("uusdc", sdkmath.LegacyNewDecWithPrec(5, 1))}
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams))
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, suite.address.Bytes(), fees))
+
+	err := suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, suite.address, nil)
+	suite.Require().NoError(err)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), "uusdc")
+	suite.Require().True(balance.IsZero())
+
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().Equal(fees[0], suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, "uusdc"))
+}
+
+func (suite *KeeperTestSuite) TestDeductTxCostsFromUserBalanceWithGasPriceMultiplier() {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name       string
+		to         *common.Address
+		multiplier sdkmath.LegacyDec
+		expFees    sdkmath.Int
+	}{
+		{"contract creation", nil, sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.NewInt(101)},
+		{"discounted contract", &contract, sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.NewInt(51)},
+		{"surcharged contract", &contract, sdkmath.LegacyNewDec(2), sdkmath.NewInt(202)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			feeMarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			feeMarketParams.ContractGasPriceMultipliers = []feemarkettypes.ContractGasPriceMultiplier{
+				feemarkettypes.NewContractGasPriceMultiplier(contract, tc.multiplier),
+			}
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams))
+
+			fees := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 101))
+			suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, suite.address.Bytes(), fees.MulInt(sdkmath.NewInt(2))))
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), evmtypes.DefaultEVMDenom).Amount
+
+			err := suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, suite.address, tc.to)
+			suite.Require().NoError(err)
+
+			// the multiplied fees are rounded up
+			suite.Require().Equal(
+				balance.Sub(tc.expFees).String(),
+				suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), evmtypes.DefaultEVMDenom).Amount.String(),
+			)
+		})
+	}
+}
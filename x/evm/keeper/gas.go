@@ -15,6 +15,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // GetEthIntrinsicGas returns the intrinsic gas cost for the transaction
@@ -27,7 +28,7 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
+// consumed in the transaction. The leftover gas is refunded in the denom used to pay the fees. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
@@ -39,12 +40,18 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// negative refund errors
 		return errorsmod.Wrapf(types.ErrInvalidRefund, "refunded amount value cannot be negative %d", remaining.Int64())
 	case 1:
-		// positive amount refund
-		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		// positive amount refund, in the accepted fee denom used to pay the fees, if any
+		refundedCoins, err := k.gasFeeCoins(ctx, msg, remaining, denom)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to refund %d leftover gas", leftoverGas)
+		}
+		if refundedCoins.IsZero() {
+			return nil
+		}
 
 		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -56,6 +63,54 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// DistributeFees distributes the fees paid for the gas used by the message according to the EVM fee split of the
+// fee market. It must be called after the leftover gas is refunded, so that only the fees that were actually paid
+// are distributed. The fees are distributed in the denom used to pay them, like the refund.
+func (k *Keeper) DistributeFees(ctx sdk.Context, msg core.Message, gasUsed uint64, denom string) error {
+	fees, err := k.GasUsedFeeCoins(ctx, msg, gasUsed, denom)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to distribute the fees of %d gas used", gasUsed)
+	}
+
+	return k.feeMarketKeeper.DistributeTxFees(ctx, feemarkettypes.TxClassEVM, fees)
+}
+
+// GasUsedFeeCoins returns the fees paid for the gas used by the message, multiplied by the gas price multiplier of the
+// called contract, in the accepted fee denom used to pay the fees of the message, or in the given EVM denom if the fees
+// were paid in the EVM denom.
+func (k *Keeper) GasUsedFeeCoins(ctx sdk.Context, msg core.Message, gasUsed uint64, denom string) (sdk.Coins, error) {
+	paid := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), msg.GasPrice())
+	if paid.Sign() <= 0 {
+		return sdk.Coins{}, nil
+	}
+
+	return k.gasFeeCoins(ctx, msg, paid, denom)
+}
+
+// gasFeeCoins returns the given amount of fees, multiplied by the gas price multiplier of the called contract, in the
+// accepted fee denom used to pay the fees of the message, or in the given EVM denom if the fees were paid in the EVM
+// denom. The result is rounded down.
+func (k *Keeper) gasFeeCoins(ctx sdk.Context, msg core.Message, amount *big.Int, denom string) (sdk.Coins, error) {
+	multipliedAmt := sdkmath.LegacyNewDecFromBigInt(amount).Mul(k.feeMarketKeeper.GetGasPriceMultiplier(ctx, msg.To()))
+
+	feeDenom := k.GetTxFeeDenomTransient(ctx, msg.From(), msg.Nonce())
+	if feeDenom == "" {
+		return sdk.Coins{sdk.NewCoin(denom, multipliedAmt.TruncateInt())}, nil
+	}
+
+	rate, err := k.feeMarketKeeper.GetConversionRate(ctx, feeDenom)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to convert the fees to %s", feeDenom)
+	}
+
+	convertedAmt := multipliedAmt.Mul(rate).TruncateInt()
+	if !convertedAmt.IsPositive() {
+		return sdk.Coins{}, nil
+	}
+
+	return sdk.Coins{sdk.NewCoin(feeDenom, convertedAmt)}, nil
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
@@ -704,6 +704,19 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// PrecompileRegistry implements the Query/PrecompileRegistry gRPC method
+func (k Keeper) PrecompileRegistry(c context.Context, req *types.QueryPrecompileRegistryRequest) (*types.QueryPrecompileRegistryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPrecompileRegistryResponse{
+		Precompiles: k.GetPrecompileRegistry(ctx, req.ActiveOnly),
+	}, nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -1445,3 +1446,90 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryPrecompileRegistry() {
+	stakingAddr := "0x0000000000000000000000000000000000000800"
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		activeOnly  bool
+		expStaking  bool
+		expAllTotal bool
+	}{
+		{
+			"pass - all available precompiles",
+			func() {},
+			false,
+			true,
+			true,
+		},
+		{
+			"pass - active precompiles with default params",
+			func() {},
+			true,
+			true,
+			true,
+		},
+		{
+			"pass - inactive precompile is filtered out",
+			func() {
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				activePrecompiles := make([]string, 0, len(params.ActivePrecompiles))
+				for _, addr := range params.ActivePrecompiles {
+					if addr != stakingAddr {
+						activePrecompiles = append(activePrecompiles, addr)
+					}
+				}
+				params.ActivePrecompiles = activePrecompiles
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+			},
+			true,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			res, err := suite.queryClient.PrecompileRegistry(sdk.WrapSDKContext(suite.ctx), &types.QueryPrecompileRegistryRequest{
+				ActiveOnly: tc.activeOnly,
+			})
+			suite.Require().NoError(err)
+
+			available := suite.app.EvmKeeper.GetAvailablePrecompileAddrs()
+			if tc.expAllTotal {
+				suite.Require().Len(res.Precompiles, len(available))
+			} else {
+				suite.Require().Len(res.Precompiles, len(available)-1)
+			}
+
+			var staking *types.PrecompileInfo
+			for i, info := range res.Precompiles {
+				if i > 0 {
+					prev := common.HexToAddress(res.Precompiles[i-1].Address)
+					suite.Require().Equal(-1, bytes.Compare(prev.Bytes(), common.HexToAddress(info.Address).Bytes()))
+				}
+				suite.Require().NotEmpty(info.Name)
+				if info.Address == stakingAddr {
+					staking = &res.Precompiles[i]
+				}
+			}
+
+			if !tc.expStaking {
+				suite.Require().Nil(staking)
+				return
+			}
+
+			suite.Require().NotNil(staking)
+			suite.Require().Equal("staking", staking.Name)
+			suite.Require().Equal("v2", staking.Version)
+			suite.Require().True(staking.Active)
+			suite.Require().NotEmpty(staking.Abi)
+			suite.Require().Equal(crypto.Keccak256Hash([]byte(staking.Abi)).Hex(), staking.AbiHash)
+		})
+	}
+}
@@ -64,6 +64,9 @@ type Keeper struct {
 	// Some these precompiled contracts might not be active depending on the EVM
 	// parameters.
 	precompiles map[common.Address]vm.PrecompiledContract
+	// precompileRegistry defines the registry entries (name, version and ABI) of
+	// the available precompiled smart contracts.
+	precompileRegistry map[common.Address]types.PrecompileInfo
 }
 
 // NewKeeper generates new evm module keeper
@@ -381,3 +384,30 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	k.SetTransientGasUsed(ctx, result)
 	return result, nil
 }
+
+// GetBlockGasUsedTransient returns the gas used by the eth msgs included in the current block.
+func (k Keeper) GetBlockGasUsedTransient(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientBlockGasUsed)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetBlockGasUsedTransient sets the gas used by the eth msgs included in the current block.
+func (k Keeper) SetBlockGasUsedTransient(ctx sdk.Context, gasUsed uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	bz := sdk.Uint64ToBigEndian(gasUsed)
+	store.Set(types.KeyPrefixTransientBlockGasUsed, bz)
+}
+
+// AddBlockGasUsedTransient accumulate gas used by each eth msgs included in the current block.
+func (k Keeper) AddBlockGasUsedTransient(ctx sdk.Context, gasUsed uint64) (uint64, error) {
+	result := k.GetBlockGasUsedTransient(ctx) + gasUsed
+	if result < gasUsed {
+		return 0, errorsmod.Wrap(types.ErrGasOverflow, "transient block gas used")
+	}
+	k.SetBlockGasUsedTransient(ctx, result)
+	return result, nil
+}
@@ -15,24 +15,41 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	clientkeeper "github.com/cosmos/ibc-go/v7/modules/core/02-client/keeper"
+	connectionkeeper "github.com/cosmos/ibc-go/v7/modules/core/03-connection/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
+	authzprecompile "github.com/evmos/evmos/v16/precompiles/authz"
 	bankprecompile "github.com/evmos/evmos/v16/precompiles/bank"
+	bls12381precompile "github.com/evmos/evmos/v16/precompiles/bls12381"
 	distprecompile "github.com/evmos/evmos/v16/precompiles/distribution"
+	"github.com/evmos/evmos/v16/precompiles/ed25519"
 	erc20precompile "github.com/evmos/evmos/v16/precompiles/erc20"
+	feegrantprecompile "github.com/evmos/evmos/v16/precompiles/feegrant"
+	ibcprecompile "github.com/evmos/evmos/v16/precompiles/ibc"
 	ics20precompile "github.com/evmos/evmos/v16/precompiles/ics20"
 	osmosisoutpost "github.com/evmos/evmos/v16/precompiles/outposts/osmosis"
+	routeroutpost "github.com/evmos/evmos/v16/precompiles/outposts/router"
 	strideoutpost "github.com/evmos/evmos/v16/precompiles/outposts/stride"
 	"github.com/evmos/evmos/v16/precompiles/p256"
+	randomnessprecompile "github.com/evmos/evmos/v16/precompiles/randomness"
+	revenueprecompile "github.com/evmos/evmos/v16/precompiles/revenue"
 	stakingprecompile "github.com/evmos/evmos/v16/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v16/precompiles/vesting"
 	erc20Keeper "github.com/evmos/evmos/v16/x/erc20/keeper"
+	"github.com/evmos/evmos/v16/x/evm/types"
 	transferkeeper "github.com/evmos/evmos/v16/x/ibc/transfer/keeper"
+	outpostskeeper "github.com/evmos/evmos/v16/x/outposts/keeper"
+	randomnesskeeper "github.com/evmos/evmos/v16/x/randomness/keeper"
+	revenuekeeper "github.com/evmos/evmos/v16/x/revenue/v1/keeper"
 	vestingkeeper "github.com/evmos/evmos/v16/x/vesting/keeper"
 )
 
@@ -40,6 +57,7 @@ import (
 // NOTE: this should only be used during initialization of the Keeper.
 func AvailablePrecompiles(
 	chainID string,
+	cdc codec.Codec,
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
 	bankKeeper bankkeeper.Keeper,
@@ -47,7 +65,13 @@ func AvailablePrecompiles(
 	vestingKeeper vestingkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
+	clientKeeper clientkeeper.Keeper,
+	connectionKeeper connectionkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	revenueKeeper revenuekeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	randomnessKeeper randomnesskeeper.Keeper,
+	outpostsKeeper outpostskeeper.Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -55,6 +79,15 @@ func AvailablePrecompiles(
 	// secp256r1 precompile as per EIP-7212
 	p256Precompile := &p256.Precompile{}
 
+	// Ed25519 signature verification precompile
+	ed25519Precompile := &ed25519.Precompile{}
+
+	// BLS12-381 signature verification and pairing precompile as per EIP-2537
+	bls12381Precompile, err := bls12381precompile.NewPrecompile()
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate bls12381 precompile: %w", err))
+	}
+
 	bech32Precompile, err := bech32.NewPrecompile(6000)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate bech32 precompile: %w", err))
@@ -65,7 +98,7 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to instantiate staking precompile: %w", err))
 	}
 
-	distributionPrecompile, err := distprecompile.NewPrecompile(distributionKeeper, stakingKeeper, authzKeeper)
+	distributionPrecompile, err := distprecompile.NewPrecompile(distributionKeeper, stakingKeeper, bankKeeper, authzKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate distribution precompile: %w", err))
 	}
@@ -75,6 +108,11 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to instantiate ICS20 precompile: %w", err))
 	}
 
+	ibcPrecompile, err := ibcprecompile.NewPrecompile(clientKeeper, connectionKeeper, channelKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate IBC precompile: %w", err))
+	}
+
 	vestingPrecompile, err := vestingprecompile.NewPrecompile(vestingKeeper, authzKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate vesting precompile: %w", err))
@@ -85,6 +123,26 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
 
+	revenuePrecompile, err := revenueprecompile.NewPrecompile(revenueKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate revenue precompile: %w", err))
+	}
+
+	authzPrecompile, err := authzprecompile.NewPrecompile(authzKeeper, distributionKeeper, cdc)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
+	randomnessPrecompile, err := randomnessprecompile.NewPrecompile(randomnessKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate randomness precompile: %w", err))
+	}
+
 	var WEVMOSAddress common.Address
 	if utils.IsMainnet(chainID) {
 		WEVMOSAddress = common.HexToAddress(erc20precompile.WEVMOSContractMainnet)
@@ -116,9 +174,22 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to instantiate osmosis outpost: %w", err))
 	}
 
+	routerOutpost, err := routeroutpost.NewPrecompile(
+		authzKeeper,
+		transferKeeper,
+		stakingKeeper,
+		erc20Keeper,
+		outpostsKeeper,
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate router outpost: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
+	precompiles[ed25519Precompile.Address()] = ed25519Precompile
+	precompiles[bls12381Precompile.Address()] = bls12381Precompile
 
 	// Stateful precompiles
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
@@ -126,10 +197,16 @@ func AvailablePrecompiles(
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
 	precompiles[bankPrecompile.Address()] = bankPrecompile
+	precompiles[revenuePrecompile.Address()] = revenuePrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
+	precompiles[randomnessPrecompile.Address()] = randomnessPrecompile
+	precompiles[ibcPrecompile.Address()] = ibcPrecompile
 
 	// Outposts
 	precompiles[strideOutpost.Address()] = strideOutpost
 	precompiles[osmosisOutpost.Address()] = osmosisOutpost
+	precompiles[routerOutpost.Address()] = routerOutpost
 
 	return precompiles
 }
@@ -144,7 +221,17 @@ func (k *Keeper) WithPrecompiles(precompiles map[common.Address]vm.PrecompiledCo
 		panic("empty precompiled contract map")
 	}
 
+	registry := make(map[common.Address]types.PrecompileInfo, len(precompiles))
+	for address, precompile := range precompiles {
+		info, err := types.NewPrecompileInfo(precompile)
+		if err != nil {
+			panic(err)
+		}
+		registry[address] = info
+	}
+
 	k.precompiles = precompiles
+	k.precompileRegistry = registry
 	return k
 }
 
@@ -175,6 +262,7 @@ func (k *Keeper) AddEVMExtensions(ctx sdk.Context, precompiles ...vm.Precompiled
 
 	addresses := make([]string, len(precompiles))
 	precompilesMap := maps.Clone(k.precompiles)
+	registry := maps.Clone(k.precompileRegistry)
 
 	for i, precompile := range precompiles {
 		// add to active precompiles
@@ -186,6 +274,12 @@ func (k *Keeper) AddEVMExtensions(ctx sdk.Context, precompiles ...vm.Precompiled
 			return fmt.Errorf("precompile already registered: %s", address)
 		}
 		precompilesMap[address] = precompile
+
+		info, err := types.NewPrecompileInfo(precompile)
+		if err != nil {
+			return err
+		}
+		registry[address] = info
 	}
 
 	params.ActivePrecompiles = append(params.ActivePrecompiles, addresses...)
@@ -196,8 +290,9 @@ func (k *Keeper) AddEVMExtensions(ctx sdk.Context, precompiles ...vm.Precompiled
 		return err
 	}
 
-	// update the pointer to the map with the newly added EVM Extensions
+	// update the pointer to the maps with the newly added EVM Extensions
 	k.precompiles = precompilesMap
+	k.precompileRegistry = registry
 	return nil
 }
 
@@ -228,3 +323,27 @@ func (k Keeper) GetAvailablePrecompileAddrs() []common.Address {
 
 	return addresses
 }
+
+// GetPrecompileRegistry returns the registry entries of the available precompiled
+// contracts sorted by address. The Ethereum precompiled contracts are always
+// active, while the active flag of the other entries is set from the current
+// EVM parameters. If activeOnly is true, only the active precompiled contracts
+// are returned.
+func (k Keeper) GetPrecompileRegistry(ctx sdk.Context, activeOnly bool) []types.PrecompileInfo {
+	activePrecompiles := append(
+		slices.Clone(vm.PrecompiledAddressesBerlin),
+		k.GetParams(ctx).GetActivePrecompilesAddrs()...,
+	)
+
+	registry := make([]types.PrecompileInfo, 0, len(k.precompileRegistry))
+	for _, address := range k.GetAvailablePrecompileAddrs() {
+		info := k.precompileRegistry[address]
+		info.Active = slices.Contains(activePrecompiles, address)
+		if activeOnly && !info.Active {
+			continue
+		}
+		registry = append(registry, info)
+	}
+
+	return registry
+}
@@ -22,7 +22,6 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/params"
 )
 
 // NewEVM generates a go-ethereum VM from the provided Message fields and the chain parameters
@@ -46,7 +45,7 @@ func (k *Keeper) NewEVM(
 		Transfer:    core.Transfer,
 		GetHash:     k.GetHashFn(ctx),
 		Coinbase:    cfg.CoinBase,
-		GasLimit:    evmostypes.BlockGasLimit(ctx),
+		GasLimit:    k.feeMarketKeeper.GetMaxEVMBlockGas(ctx),
 		BlockNumber: big.NewInt(ctx.BlockHeight()),
 		Time:        big.NewInt(ctx.BlockHeader().Time.Unix()),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
@@ -174,6 +173,12 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
 	}
 
+	// charge the reverted transactions at least the share of the gas limit
+	// defined by the gas refund params, so that less leftover gas is refunded
+	if res.Failed() {
+		res.GasUsed = cfg.Params.GasRefund.RevertedTxGasUsed(msg.Gas(), res.GasUsed)
+	}
+
 	logs := types.LogsToEthereum(res.Logs)
 
 	// Compute block bloom filter
@@ -235,6 +240,11 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
 	}
 
+	// distribute the fees paid for the gas used according to the EVM fee split
+	if err = k.DistributeFees(ctx, msg, res.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to distribute the fees paid by sender %s", msg.From())
+	}
+
 	if len(receipt.Logs) > 0 {
 		// Update transient block bloom filter
 		k.SetBlockBloomTransient(ctx, receipt.Bloom.Big())
@@ -248,6 +258,12 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
 	}
 
+	// keep track of the gas used by the EVM transactions of the block, which is
+	// bounded by the max EVM block gas of the fee market
+	if _, err := k.AddBlockGasUsedTransient(ctx, res.GasUsed); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to add transient block gas used")
+	}
+
 	// reset the gas meter for current cosmos transaction
 	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
 	return res, nil
@@ -396,12 +412,9 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
 	}
 
-	refundQuotient := params.RefundQuotient
-
-	// After EIP-3529: refunds are capped to gasUsed / 5
-	if isLondon {
-		refundQuotient = params.RefundQuotientEIP3529
-	}
+	// the refund quotient is governed by the gas refund params, defaulting to the
+	// one of the active fork (after EIP-3529: refunds are capped to gasUsed / 5)
+	refundQuotient := cfg.Params.GasRefund.EffectiveRefundQuotient(isLondon)
 
 	// calculate gas refund
 	if msg.Gas() < leftoverGas {
@@ -12,15 +12,18 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v16/testutil"
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/x/evm/keeper"
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 	"github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 func (suite *KeeperTestSuite) TestGetHashFn() {
@@ -459,6 +462,138 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestRefundGasInFeeDenom() {
+	suite.SetupTest()
+
+	feeMarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	feeMarketParams.FeeDenoms = []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom("uusdc", sdkmath.LegacyNewDecWithPrec(5, 1))}
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams))
+
+	keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	ethCfg := keeperParams.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	m, err := newNativeMessage(
+		suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		suite.ctx.BlockHeight(),
+		suite.address,
+		ethCfg,
+		suite.signer,
+		signer,
+		ethtypes.AccessListTxType,
+		nil,
+		nil,
+	)
+	suite.Require().NoError(err)
+
+	leftoverGas := uint64(1000)
+	refund := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), m.GasPrice())
+	expRefund := sdkmath.LegacyNewDecFromBigInt(refund).Mul(sdkmath.LegacyNewDecWithPrec(5, 1)).TruncateInt()
+	err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("uusdc", expRefund)))
+	suite.Require().NoError(err)
+
+	suite.app.EvmKeeper.SetTxFeeDenomTransient(suite.ctx, suite.address, m.Nonce(), "uusdc")
+
+	evmDenomBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), types.DefaultEVMDenom)
+	err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, leftoverGas, types.DefaultEVMDenom)
+	suite.Require().NoError(err)
+
+	// the leftover gas is refunded in the fee denom used to pay the fees
+	suite.Require().Equal(expRefund, suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), "uusdc").Amount)
+	suite.Require().Equal(evmDenomBalance, suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), types.DefaultEVMDenom))
+}
+
+func (suite *KeeperTestSuite) TestRefundGasWithGasPriceMultiplier() {
+	suite.SetupTest()
+
+	keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	ethCfg := keeperParams.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	m, err := newNativeMessage(
+		suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		suite.ctx.BlockHeight(),
+		suite.address,
+		ethCfg,
+		suite.signer,
+		signer,
+		ethtypes.AccessListTxType,
+		nil,
+		nil,
+	)
+	suite.Require().NoError(err)
+
+	multiplier := sdkmath.LegacyNewDecWithPrec(5, 1)
+	feeMarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	feeMarketParams.ContractGasPriceMultipliers = []feemarkettypes.ContractGasPriceMultiplier{
+		feemarkettypes.NewContractGasPriceMultiplier(*m.To(), multiplier),
+	}
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams))
+
+	leftoverGas := uint64(1000)
+	refund := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), m.GasPrice())
+	expRefund := sdkmath.LegacyNewDecFromBigInt(refund).Mul(multiplier).TruncateInt()
+	err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(types.DefaultEVMDenom, expRefund)))
+	suite.Require().NoError(err)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), types.DefaultEVMDenom).Amount
+	err = suite.app.EvmKeeper.RefundGas(suite.ctx, m, leftoverGas, types.DefaultEVMDenom)
+	suite.Require().NoError(err)
+
+	// the leftover gas is refunded at the discounted gas price of the called contract
+	suite.Require().Equal(
+		balance.Add(expRefund).String(),
+		suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), types.DefaultEVMDenom).Amount.String(),
+	)
+}
+
+func (suite *KeeperTestSuite) TestDistributeFees() {
+	suite.SetupTest()
+
+	feeMarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	feeMarketParams.EVMFeeSplit = feemarkettypes.NewFeeSplit(
+		sdkmath.LegacyNewDecWithPrec(5, 1),
+		sdkmath.LegacyZeroDec(),
+		sdkmath.LegacyNewDecWithPrec(5, 1),
+		sdkmath.LegacyZeroDec(),
+	)
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams))
+
+	keeperParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	ethCfg := keeperParams.ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	m, err := newNativeMessage(
+		suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		suite.ctx.BlockHeight(),
+		suite.address,
+		ethCfg,
+		suite.signer,
+		signer,
+		ethtypes.AccessListTxType,
+		nil,
+		nil,
+	)
+	suite.Require().NoError(err)
+
+	gasUsed := uint64(1000)
+	paid := sdkmath.NewIntFromBigInt(new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), m.GasPrice()))
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(types.DefaultEVMDenom, paid)))
+	suite.Require().NoError(err)
+	feeCollectorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, types.DefaultEVMDenom).Amount
+
+	err = suite.app.EvmKeeper.DistributeFees(suite.ctx, m, gasUsed, types.DefaultEVMDenom)
+	suite.Require().NoError(err)
+
+	// half of the paid fees are burned and the other half is kept by the validators
+	burned := paid.QuoRaw(2)
+	suite.Require().Equal(
+		feeCollectorBalance.Sub(burned).String(),
+		suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, types.DefaultEVMDenom).Amount.String(),
+	)
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
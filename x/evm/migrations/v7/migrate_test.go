@@ -0,0 +1,41 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/encoding"
+	v7 "github.com/evmos/evmos/v16/x/evm/migrations/v7"
+	"github.com/evmos/evmos/v16/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	// params stored before the gas refund params were introduced
+	v6Params := types.DefaultParams()
+	v6Params.GasRefund = types.GasRefundConfig{}
+	kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&v6Params))
+
+	require.NoError(t, v7.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(kvStore.Get(types.KeyPrefixParams), &params)
+
+	require.Equal(t, v6Params.EvmDenom, params.EvmDenom)
+	require.Equal(t, v6Params.ActivePrecompiles, params.ActivePrecompiles)
+	require.Equal(t, v6Params.EVMChannels, params.EVMChannels)
+	require.Equal(t, types.DefaultGasRefundConfig(), params.GasRefund)
+}
@@ -21,6 +21,7 @@ import (
 	"math/big"
 	"sort"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -137,6 +138,12 @@ type (
 		address *common.Address
 		slot    *common.Hash
 	}
+
+	// Changes to the context by stateful precompiles
+	contextBranchChange struct {
+		prevCtx      sdk.Context
+		prevBranches int
+	}
 )
 
 func (ch createObjectChange) Revert(s *StateDB) {
@@ -239,3 +246,12 @@ func (ch accessListAddSlotChange) Revert(s *StateDB) {
 func (ch accessListAddSlotChange) Dirtied() *common.Address {
 	return nil
 }
+
+func (ch contextBranchChange) Revert(s *StateDB) {
+	s.ctx = ch.prevCtx
+	s.ctxBranches = s.ctxBranches[:ch.prevBranches]
+}
+
+func (ch contextBranchChange) Dirtied() *common.Address {
+	return nil
+}
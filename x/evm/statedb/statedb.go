@@ -8,6 +8,7 @@ import (
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -32,7 +33,14 @@ var _ vm.StateDB = &StateDB{}
 // * Accounts
 type StateDB struct {
 	keeper Keeper
-	ctx    sdk.Context
+	// Context of the transaction, to which the context branches are written
+	// on Commit.
+	rootCtx sdk.Context
+	ctx     sdk.Context
+
+	// Cache multistores of the context branches created by stateful
+	// precompiles, from the oldest to the newest one.
+	ctxBranches []storetypes.CacheMultiStore
 
 	// Journal of state modifications. This is the backbone of
 	// Snapshot and RevertToSnapshot.
@@ -58,6 +66,7 @@ type StateDB struct {
 func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 	return &StateDB{
 		keeper:       keeper,
+		rootCtx:      ctx,
 		ctx:          ctx,
 		stateObjects: make(map[common.Address]*stateObject),
 		journal:      newJournal(),
@@ -77,6 +86,29 @@ func (s *StateDB) GetContext() sdk.Context {
 	return s.ctx
 }
 
+// BranchContext branches the transaction Context into a new cache context,
+// which becomes the StateDB context, and returns it. Stateful precompiles
+// execute their Cosmos SDK state transitions on the branched context, so that
+// they are discarded when the EVM reverts to a snapshot taken before the
+// branch. The branches are written to the transaction Context on Commit.
+func (s *StateDB) BranchContext() sdk.Context {
+	s.journal.append(contextBranchChange{
+		prevCtx:      s.ctx,
+		prevBranches: len(s.ctxBranches),
+	})
+
+	cms := s.ctx.MultiStore().CacheMultiStore()
+	s.ctxBranches = append(s.ctxBranches, cms)
+	s.ctx = s.ctx.WithMultiStore(cms)
+	return s.ctx
+}
+
+// AppendJournalEntry appends a new entry to the state journal, which is
+// reverted together with the EVM state changes.
+func (s *StateDB) AppendJournalEntry(entry JournalEntry) {
+	s.journal.append(entry)
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.journal.append(addLogChange{})
@@ -440,9 +472,11 @@ func (s *StateDB) RevertToSnapshot(revid int) {
 	s.validRevisions = s.validRevisions[:idx]
 }
 
-// Commit writes the dirty states to keeper
-// the StateDB object should be discarded after committed.
-func (s *StateDB) Commit() error {
+// Flush writes the dirty states to the current context without writing the
+// context branches, so that stateful precompiles see the up-to-date EVM state
+// through the keepers. Unlike Commit, the StateDB can still be used afterwards
+// and the flushed states are discarded if the EVM reverts the context branch.
+func (s *StateDB) Flush() error {
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
 		if obj.suicided {
@@ -468,3 +502,21 @@ func (s *StateDB) Commit() error {
 	}
 	return nil
 }
+
+// Commit writes the dirty states to keeper
+// the StateDB object should be discarded after committed.
+func (s *StateDB) Commit() error {
+	if err := s.Flush(); err != nil {
+		return err
+	}
+
+	// write the context branches from the newest to the oldest one, so that
+	// all the changes end up in the transaction context
+	for i := len(s.ctxBranches) - 1; i >= 0; i-- {
+		s.ctxBranches[i].Write()
+	}
+	s.ctxBranches = nil
+	s.ctx = s.rootCtx
+
+	return nil
+}
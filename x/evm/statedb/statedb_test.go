@@ -4,6 +4,8 @@ import (
 	"math/big"
 	"testing"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -385,6 +387,35 @@ func (suite *StateDBTestSuite) TestNestedSnapshot() {
 	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+func (suite *StateDBTestSuite) TestBranchContext() {
+	storeKey := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	key1, key2 := []byte("key1"), []byte("key2")
+	value := []byte("value")
+
+	db := statedb.New(ctx, NewMockKeeper(), emptyTxConfig)
+
+	branchCtx := db.BranchContext()
+	branchCtx.KVStore(storeKey).Set(key1, value)
+	suite.Require().Equal(branchCtx, db.GetContext())
+
+	rev := db.Snapshot()
+	db.BranchContext().KVStore(storeKey).Set(key2, value)
+	suite.Require().True(db.GetContext().KVStore(storeKey).Has(key1))
+	suite.Require().True(db.GetContext().KVStore(storeKey).Has(key2))
+
+	// reverting discards the changes of the newest branch only
+	db.RevertToSnapshot(rev)
+	suite.Require().True(db.GetContext().KVStore(storeKey).Has(key1))
+	suite.Require().False(db.GetContext().KVStore(storeKey).Has(key2))
+
+	// the branches are only written on commit
+	suite.Require().False(ctx.KVStore(storeKey).Has(key1))
+	suite.Require().NoError(db.Commit())
+	suite.Require().True(ctx.KVStore(storeKey).Has(key1))
+	suite.Require().False(ctx.KVStore(storeKey).Has(key2))
+}
+
 func (suite *StateDBTestSuite) TestInvalidSnapshotId() {
 	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
 	suite.Require().Panics(func() {
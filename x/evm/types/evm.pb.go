@@ -45,6 +45,8 @@ type Params struct {
 	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty"`
 	// evm_channels is the list of channel identifiers from EVM compatible chains
 	EVMChannels []string `protobuf:"bytes,8,rep,name=evm_channels,json=evmChannels,proto3" json:"evm_channels,omitempty"`
+	// gas_refund defines the gas refund rules applied to the EVM transactions
+	GasRefund GasRefundConfig `protobuf:"bytes,9,opt,name=gas_refund,json=gasRefund,proto3" json:"gas_refund"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -136,6 +138,66 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetGasRefund() GasRefundConfig {
+	if m != nil {
+		return m.GasRefund
+	}
+	return GasRefundConfig{}
+}
+
+// GasRefundConfig defines the gas refund rules applied to the EVM transactions.
+type GasRefundConfig struct {
+	// refund_quotient caps the refund of the gas refund counter (e.g. storage
+	// clears) to gas_used / refund_quotient. A zero value uses the quotient of the
+	// active fork (2, or 5 after EIP-3529).
+	RefundQuotient uint64 `protobuf:"varint,1,opt,name=refund_quotient,json=refundQuotient,proto3" json:"refund_quotient,omitempty"`
+	// reverted_tx_min_gas_multiplier defines the minimum share of the gas limit
+	// charged to the sender of a reverted transaction. A zero value refunds all
+	// the leftover gas of reverted transactions, while a value of 1 charges their
+	// whole gas limit.
+	RevertedTxMinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=reverted_tx_min_gas_multiplier,json=revertedTxMinGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reverted_tx_min_gas_multiplier"`
+}
+
+func (m *GasRefundConfig) Reset()         { *m = GasRefundConfig{} }
+func (m *GasRefundConfig) String() string { return proto.CompactTextString(m) }
+func (*GasRefundConfig) ProtoMessage()    {}
+func (*GasRefundConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *GasRefundConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasRefundConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasRefundConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasRefundConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRefundConfig.Merge(m, src)
+}
+func (m *GasRefundConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasRefundConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRefundConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasRefundConfig proto.InternalMessageInfo
+
+func (m *GasRefundConfig) GetRefundQuotient() uint64 {
+	if m != nil {
+		return m.RefundQuotient
+	}
+	return 0
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -184,7 +246,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +301,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +358,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,7 +434,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +549,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,7 +590,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +650,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,8 +756,103 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// PrecompileInfo defines the registry entry of a precompiled contract available
+// in the EVM.
+type PrecompileInfo struct {
+	// name is the name of the precompiled contract
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the hex address of the precompiled contract
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// version is the version of the precompiled contract interface
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// abi_hash is the hex keccak256 hash of the ABI JSON. It is empty for
+	// precompiled contracts without ABI.
+	AbiHash string `protobuf:"bytes,4,opt,name=abi_hash,json=abiHash,proto3" json:"abi_hash,omitempty"`
+	// abi is the ABI JSON of the precompiled contract. It is empty for
+	// precompiled contracts without ABI.
+	Abi string `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty"`
+	// active defines if the precompiled contract is enabled in the EVM parameters
+	Active bool `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *PrecompileInfo) Reset()         { *m = PrecompileInfo{} }
+func (m *PrecompileInfo) String() string { return proto.CompactTextString(m) }
+func (*PrecompileInfo) ProtoMessage()    {}
+func (*PrecompileInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
+}
+func (m *PrecompileInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileInfo.Merge(m, src)
+}
+func (m *PrecompileInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileInfo proto.InternalMessageInfo
+
+func (m *PrecompileInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PrecompileInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PrecompileInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *PrecompileInfo) GetAbiHash() string {
+	if m != nil {
+		return m.AbiHash
+	}
+	return ""
+}
+
+func (m *PrecompileInfo) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *PrecompileInfo) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*GasRefundConfig)(nil), "ethermint.evm.v1.GasRefundConfig")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
@@ -703,116 +860,128 @@ func init() {
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+	proto.RegisterType((*PrecompileInfo)(nil), "ethermint.evm.v1.PrecompileInfo")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0xcd, 0x4f, 0x23, 0xc9,
-	0x15, 0x07, 0x6c, 0xa0, 0x5d, 0x36, 0x76, 0x53, 0x18, 0xd6, 0x3b, 0xa3, 0xd0, 0xa4, 0x0f, 0x11,
-	0x91, 0x76, 0x61, 0x60, 0xc2, 0xee, 0x68, 0x57, 0xf9, 0x98, 0x9e, 0x61, 0x13, 0xc8, 0xec, 0x06,
-	0x15, 0x6c, 0xa2, 0x44, 0x89, 0x5a, 0xe5, 0xee, 0xda, 0x76, 0x2f, 0xdd, 0x5d, 0x56, 0x55, 0xb5,
-	0xc7, 0xce, 0x5f, 0x10, 0x29, 0x97, 0xfc, 0x09, 0xfb, 0xe7, 0xac, 0x72, 0x9a, 0x63, 0x94, 0x43,
-	0x2b, 0x62, 0x6e, 0x28, 0x27, 0xee, 0x91, 0xa2, 0xfa, 0x70, 0xfb, 0x03, 0x42, 0x7c, 0x81, 0xfa,
-	0xbd, 0x8f, 0xdf, 0xab, 0xf7, 0xea, 0xb5, 0x5f, 0x15, 0x78, 0x42, 0x44, 0x8f, 0xb0, 0x34, 0xce,
-	0xc4, 0x21, 0x19, 0xa4, 0x87, 0x83, 0x23, 0xf9, 0xef, 0xa0, 0xcf, 0xa8, 0xa0, 0xd0, 0x2e, 0x75,
-	0x07, 0x52, 0x38, 0x38, 0x7a, 0xd2, 0x8e, 0x68, 0x44, 0x95, 0xf2, 0x50, 0xae, 0xb4, 0x9d, 0xfb,
-	0xef, 0x0a, 0x58, 0xbb, 0xc0, 0x0c, 0xa7, 0x1c, 0x1e, 0x81, 0x1a, 0x19, 0xa4, 0x7e, 0x48, 0x32,
-	0x9a, 0x76, 0x96, 0xf7, 0x96, 0xf7, 0x6b, 0x5e, 0xfb, 0xae, 0x70, 0xec, 0x11, 0x4e, 0x93, 0xcf,
-	0xdc, 0x52, 0xe5, 0x22, 0x8b, 0x0c, 0xd2, 0xd7, 0x72, 0x09, 0x7f, 0x0a, 0x36, 0x48, 0x86, 0xbb,
-	0x09, 0xf1, 0x03, 0x46, 0xb0, 0x20, 0x9d, 0x95, 0xbd, 0xe5, 0x7d, 0xcb, 0xeb, 0xdc, 0x15, 0x4e,
-	0xdb, 0xb8, 0x4d, 0xab, 0x5d, 0xd4, 0xd0, 0xf8, 0x95, 0x82, 0xf0, 0x53, 0x50, 0x1f, 0xeb, 0x71,
-	0x92, 0x74, 0x2a, 0xca, 0x79, 0xe7, 0xae, 0x70, 0xe0, 0xac, 0x33, 0x4e, 0x12, 0x17, 0x01, 0xe3,
-	0x8a, 0x93, 0x04, 0xbe, 0x04, 0x80, 0x0c, 0x05, 0xc3, 0x3e, 0x89, 0xfb, 0xbc, 0x53, 0xdd, 0xab,
-	0xec, 0x57, 0x3c, 0xf7, 0xa6, 0x70, 0x6a, 0xa7, 0x52, 0x7a, 0x7a, 0x76, 0xc1, 0xef, 0x0a, 0x67,
-	0xd3, 0x90, 0x94, 0x86, 0x2e, 0xaa, 0x29, 0x70, 0x1a, 0xf7, 0x39, 0xfc, 0x13, 0x68, 0x04, 0x3d,
-	0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe2, 0xa8, 0xb3, 0xba, 0xb7, 0xbc, 0x5f, 0x3f, 0xfe, 0xc1,
-	0xc1, 0x7c, 0xdd, 0x0e, 0x5e, 0x49, 0xab, 0x57, 0xca, 0xc8, 0x7b, 0xfa, 0x7d, 0xe1, 0x2c, 0xdd,
-	0x15, 0xce, 0x96, 0xa6, 0x9e, 0x26, 0x70, 0x51, 0x3d, 0x98, 0x58, 0xc2, 0x63, 0xb0, 0x8d, 0x93,
-	0x84, 0xbe, 0xf5, 0xf3, 0x4c, 0x16, 0x9a, 0x04, 0x82, 0x84, 0xbe, 0x18, 0xf2, 0xce, 0x9a, 0x4c,
-	0x12, 0x6d, 0x29, 0xe5, 0xd7, 0x13, 0xdd, 0xd5, 0x90, 0xc3, 0x8f, 0x01, 0xc4, 0x81, 0x88, 0x07,
-	0xc4, 0xef, 0x33, 0x12, 0xd0, 0xb4, 0x1f, 0x27, 0x84, 0x77, 0xd6, 0xf7, 0x2a, 0xfb, 0x35, 0xb4,
-	0xa9, 0x35, 0x17, 0x13, 0x05, 0x3c, 0x06, 0x0d, 0x79, 0x28, 0x41, 0x0f, 0x67, 0x19, 0x49, 0x78,
-	0xc7, 0x92, 0x86, 0x5e, 0xeb, 0xa6, 0x70, 0xea, 0xa7, 0xbf, 0xfd, 0xf2, 0x95, 0x11, 0xa3, 0x3a,
-	0x19, 0xa4, 0x63, 0xe0, 0xfe, 0xa7, 0x09, 0xea, 0x53, 0x09, 0xc1, 0x3f, 0x82, 0x56, 0x8f, 0xa6,
-	0x84, 0x0b, 0x82, 0x43, 0xbf, 0x9b, 0xd0, 0xe0, 0xda, 0x9c, 0xfc, 0xf3, 0x7f, 0x16, 0xce, 0x76,
-	0x40, 0x79, 0x4a, 0x39, 0x0f, 0xaf, 0x0f, 0x62, 0x7a, 0x98, 0x62, 0xd1, 0x3b, 0x38, 0xcb, 0xc4,
-	0x5d, 0xe1, 0xec, 0xe8, 0xf4, 0xe7, 0x3c, 0x5d, 0xd4, 0x2c, 0x25, 0x9e, 0x14, 0xc0, 0x1e, 0x68,
-	0x86, 0x98, 0xfa, 0xdf, 0x50, 0x76, 0x6d, 0xc8, 0x57, 0x14, 0xb9, 0xf7, 0x3f, 0xc9, 0x6f, 0x0a,
-	0xa7, 0xf1, 0xfa, 0xe5, 0x6f, 0xbe, 0xa0, 0xec, 0x5a, 0x51, 0xdc, 0x15, 0xce, 0xb6, 0x0e, 0x36,
-	0x4b, 0xe4, 0xa2, 0x46, 0x88, 0x69, 0x69, 0x06, 0x7f, 0x07, 0xec, 0xd2, 0x80, 0xe7, 0xfd, 0x3e,
-	0x65, 0xc2, 0xb4, 0xd3, 0xc7, 0x37, 0x85, 0xd3, 0x34, 0x94, 0x97, 0x5a, 0x73, 0x57, 0x38, 0x1f,
-	0xcc, 0x91, 0x1a, 0x1f, 0x17, 0x35, 0x0d, 0xad, 0x31, 0x85, 0x5d, 0xd0, 0x20, 0x71, 0xff, 0xe8,
-	0xe4, 0x99, 0x49, 0xa0, 0xaa, 0x12, 0xf8, 0xf9, 0x63, 0x09, 0xd4, 0x4f, 0xcf, 0x2e, 0x8e, 0x4e,
-	0x9e, 0x8d, 0xf7, 0x6f, 0x7a, 0x65, 0x9a, 0xc5, 0x45, 0x75, 0x0d, 0xf5, 0xe6, 0xcf, 0x80, 0x81,
-	0x7e, 0x0f, 0xf3, 0x9e, 0xea, 0xc4, 0x9a, 0xb7, 0x7f, 0x53, 0x38, 0x40, 0x33, 0xfd, 0x0a, 0xf3,
-	0xde, 0xa4, 0xea, 0xdd, 0xd1, 0x9f, 0x71, 0x26, 0xe2, 0x3c, 0x1d, 0x73, 0x01, 0xed, 0x2c, 0xad,
-	0xca, 0xed, 0x9e, 0x98, 0xed, 0xae, 0x2d, 0xba, 0xdd, 0x93, 0x87, 0xb6, 0x7b, 0x32, 0xbb, 0x5d,
-	0x6d, 0x53, 0xc6, 0x78, 0x61, 0x62, 0xac, 0x2f, 0x1a, 0xe3, 0xc5, 0x43, 0x31, 0x5e, 0xcc, 0xc6,
-	0xd0, 0x36, 0xb2, 0x2f, 0xe7, 0xf2, 0xec, 0x58, 0x0b, 0xf7, 0xe5, 0xbd, 0x0a, 0x35, 0x4b, 0x89,
-	0x66, 0xbf, 0x06, 0xed, 0x80, 0x66, 0x5c, 0x48, 0x59, 0x46, 0xfb, 0x09, 0x31, 0x21, 0x6a, 0x2a,
-	0xc4, 0x8b, 0xc7, 0x42, 0x3c, 0x35, 0x5f, 0xfe, 0x03, 0xee, 0x2e, 0xda, 0x9a, 0x15, 0xeb, 0x60,
-	0x3e, 0xb0, 0xfb, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x4c, 0x20, 0xa0, 0x02, 0xfd, 0xe4, 0xb1,
-	0x40, 0xa6, 0x43, 0xe7, 0x5d, 0x5d, 0xd4, 0x9a, 0x88, 0x74, 0x80, 0xdf, 0x83, 0x66, 0x2c, 0xa3,
-	0x76, 0xf3, 0xc4, 0xd0, 0xd7, 0x15, 0xfd, 0xf1, 0x63, 0xf4, 0xe6, 0xab, 0x9a, 0x75, 0x74, 0xd1,
-	0xc6, 0x58, 0xa0, 0xa9, 0x43, 0x00, 0xd3, 0x3c, 0x66, 0x7e, 0x94, 0xe0, 0x20, 0x26, 0xcc, 0xd0,
-	0x37, 0x14, 0xfd, 0x27, 0x8f, 0xd1, 0x7f, 0xa8, 0xe9, 0xef, 0x3b, 0xbb, 0xc8, 0x96, 0xc2, 0x5f,
-	0x6a, 0x99, 0x8e, 0x72, 0x09, 0x1a, 0x5d, 0xc2, 0x92, 0x38, 0x33, 0xfc, 0x1b, 0x8a, 0xff, 0xd9,
-	0x63, 0xfc, 0xa6, 0x83, 0xa6, 0xdd, 0x5c, 0x54, 0xd7, 0xb0, 0x24, 0x4d, 0x68, 0x16, 0xd2, 0x31,
-	0xe9, 0xe6, 0xc2, 0xa4, 0xd3, 0x6e, 0x2e, 0xaa, 0x6b, 0xa8, 0x49, 0x23, 0xb0, 0x85, 0x19, 0xa3,
-	0x6f, 0xe7, 0x0a, 0x02, 0x15, 0xf7, 0xa7, 0x8f, 0x71, 0x3f, 0xd1, 0xdc, 0x0f, 0x78, 0xbb, 0x68,
-	0x53, 0x49, 0x67, 0x4a, 0x12, 0x02, 0x18, 0x31, 0x3c, 0x9a, 0x8b, 0xd3, 0x5e, 0xb8, 0xf0, 0xf7,
-	0x9d, 0x5d, 0x64, 0x4b, 0xe1, 0x4c, 0x94, 0x6f, 0x41, 0x3b, 0x25, 0x2c, 0x22, 0x7e, 0x46, 0x04,
-	0xef, 0x27, 0xb1, 0x30, 0x71, 0xb6, 0x17, 0xfe, 0x0e, 0x1e, 0x72, 0x77, 0x11, 0x54, 0xe2, 0xaf,
-	0x8c, 0xb4, 0xec, 0x52, 0xde, 0xc3, 0x59, 0xd4, 0xc3, 0xb1, 0x89, 0xb2, 0xb3, 0x70, 0x97, 0xce,
-	0x3a, 0xba, 0x68, 0x63, 0x2c, 0x28, 0x8f, 0x3a, 0xc0, 0x59, 0x90, 0x8f, 0x8f, 0xfa, 0x83, 0x85,
-	0x8f, 0x7a, 0xda, 0x4d, 0x0e, 0x70, 0x05, 0x15, 0xe9, 0x79, 0xd5, 0x6a, 0xda, 0xad, 0xf3, 0xaa,
-	0xd5, 0xb2, 0xed, 0xf3, 0xaa, 0x65, 0xdb, 0x9b, 0xe7, 0x55, 0x6b, 0xcb, 0x6e, 0xa3, 0x8d, 0x11,
-	0x4d, 0xa8, 0x3f, 0x78, 0xae, 0x9d, 0x50, 0x9d, 0xbc, 0xc5, 0xdc, 0xfc, 0xd0, 0xa0, 0x66, 0x80,
-	0x05, 0x4e, 0x46, 0xdc, 0x14, 0x02, 0xd9, 0xba, 0x3c, 0x53, 0x63, 0xeb, 0x10, 0xac, 0x5e, 0x0a,
-	0x79, 0xf5, 0xb1, 0x41, 0xe5, 0x9a, 0x8c, 0xf4, 0xb0, 0x45, 0x72, 0x09, 0xdb, 0x60, 0x75, 0x80,
-	0x93, 0x5c, 0xdf, 0xa1, 0x6a, 0x48, 0x03, 0xf7, 0x02, 0xb4, 0xae, 0x18, 0xce, 0xb8, 0x1c, 0xff,
-	0x34, 0x7b, 0x43, 0x23, 0x0e, 0x21, 0xa8, 0xaa, 0x39, 0xa1, 0x7d, 0xd5, 0x1a, 0xfe, 0x18, 0x54,
-	0x13, 0x1a, 0xf1, 0xce, 0xca, 0x5e, 0x65, 0xbf, 0x7e, 0xbc, 0x7d, 0xff, 0x16, 0xf3, 0x86, 0x46,
-	0x48, 0x99, 0xb8, 0x7f, 0x5f, 0x01, 0x95, 0x37, 0x34, 0x82, 0x1d, 0xb0, 0x8e, 0xc3, 0x90, 0x11,
-	0xce, 0x0d, 0xd3, 0x18, 0xc2, 0x1d, 0xb0, 0x26, 0x68, 0x3f, 0x0e, 0x34, 0x5d, 0x0d, 0x19, 0x24,
-	0x03, 0x87, 0x58, 0x60, 0x35, 0x58, 0x1b, 0x48, 0xad, 0xe5, 0x25, 0x44, 0x65, 0xe6, 0x67, 0x79,
-	0xda, 0x25, 0x4c, 0xcd, 0xc7, 0xaa, 0xd7, 0xba, 0x2d, 0x9c, 0xba, 0x92, 0x7f, 0xa5, 0xc4, 0x68,
-	0x1a, 0xc0, 0x8f, 0xc0, 0xba, 0x18, 0x4e, 0xcf, 0xba, 0xad, 0xdb, 0xc2, 0x69, 0x89, 0x49, 0x9a,
-	0x72, 0x94, 0xa1, 0x35, 0x31, 0x54, 0x23, 0xed, 0x10, 0x58, 0x62, 0xe8, 0xc7, 0x59, 0x48, 0x86,
-	0x6a, 0x9c, 0x55, 0xbd, 0xf6, 0x6d, 0xe1, 0xd8, 0x53, 0xe6, 0x67, 0x52, 0x87, 0xd6, 0xc5, 0x50,
-	0x2d, 0xe0, 0x47, 0x00, 0xe8, 0x2d, 0xa9, 0x08, 0x7a, 0x3a, 0x6d, 0xdc, 0x16, 0x4e, 0x4d, 0x49,
-	0x15, 0xf7, 0x64, 0x09, 0x5d, 0xb0, 0xaa, 0xb9, 0x2d, 0xc5, 0xdd, 0xb8, 0x2d, 0x1c, 0x2b, 0xa1,
-	0x91, 0xe6, 0xd4, 0x2a, 0x59, 0x2a, 0x46, 0x52, 0x3a, 0x20, 0xa1, 0x1a, 0x11, 0x16, 0x1a, 0x43,
-	0xf7, 0xaf, 0x2b, 0xc0, 0xba, 0x1a, 0x22, 0xc2, 0xf3, 0x44, 0xc0, 0x2f, 0x80, 0x1d, 0xd0, 0x4c,
-	0x30, 0x1c, 0x08, 0x7f, 0xa6, 0xb4, 0xde, 0xd3, 0xc9, 0x0f, 0xfa, 0xbc, 0x85, 0x8b, 0x5a, 0x63,
-	0xd1, 0x4b, 0x53, 0xff, 0x36, 0x58, 0xed, 0x26, 0x94, 0xa6, 0xaa, 0x13, 0x1a, 0x48, 0x03, 0x88,
-	0x54, 0xd5, 0xd4, 0x29, 0x57, 0xd4, 0x5d, 0xf5, 0x87, 0xf7, 0x4f, 0x79, 0xae, 0x55, 0xbc, 0x1d,
-	0x73, 0x5f, 0x6d, 0xea, 0xd8, 0xc6, 0xdf, 0x95, 0xb5, 0x55, 0xad, 0x64, 0x83, 0x0a, 0x23, 0x42,
-	0x1d, 0x5a, 0x03, 0xc9, 0x25, 0x7c, 0x02, 0x2c, 0x46, 0x06, 0x84, 0x09, 0x12, 0xaa, 0xc3, 0xb1,
-	0x50, 0x89, 0xe1, 0x87, 0xc0, 0x8a, 0x30, 0xf7, 0x73, 0x4e, 0x42, 0x7d, 0x12, 0x68, 0x3d, 0xc2,
-	0xfc, 0x6b, 0x4e, 0xc2, 0xcf, 0xaa, 0x7f, 0xf9, 0xce, 0x59, 0x72, 0x31, 0xa8, 0xbf, 0x0c, 0x02,
-	0xc2, 0xf9, 0x55, 0xde, 0x4f, 0xc8, 0x23, 0x1d, 0x76, 0x0c, 0x1a, 0x5c, 0x50, 0x86, 0x23, 0xe2,
-	0x5f, 0x93, 0x91, 0xe9, 0x33, 0xdd, 0x35, 0x46, 0xfe, 0x6b, 0x32, 0xe2, 0x68, 0x1a, 0x98, 0x10,
-	0xdf, 0x55, 0x41, 0xfd, 0x8a, 0xe1, 0x80, 0x98, 0x0b, 0xac, 0xec, 0x55, 0x09, 0x99, 0x09, 0x61,
-	0x90, 0x8c, 0x2d, 0xe2, 0x94, 0xd0, 0x5c, 0x98, 0xef, 0x69, 0x0c, 0xa5, 0x07, 0x23, 0x64, 0x48,
-	0x02, 0x55, 0xc6, 0x2a, 0x32, 0x08, 0x9e, 0x80, 0x8d, 0x30, 0xe6, 0xea, 0xc1, 0xc1, 0x05, 0x0e,
-	0xae, 0x75, 0xfa, 0x9e, 0x7d, 0x5b, 0x38, 0x0d, 0xa3, 0xb8, 0x94, 0x72, 0x34, 0x83, 0xe0, 0xe7,
-	0xa0, 0x35, 0x71, 0x53, 0xbb, 0xd5, 0x57, 0x7c, 0x0f, 0xde, 0x16, 0x4e, 0xb3, 0x34, 0x55, 0x1a,
-	0x34, 0x87, 0xe5, 0x49, 0x87, 0xa4, 0x9b, 0x47, 0xaa, 0xf9, 0x2c, 0xa4, 0x81, 0x94, 0x26, 0x71,
-	0x1a, 0x0b, 0xd5, 0x6c, 0xab, 0x48, 0x03, 0xf8, 0x39, 0xa8, 0xd1, 0x01, 0x61, 0x2c, 0x0e, 0x09,
-	0x57, 0x17, 0x88, 0xff, 0xf7, 0x5a, 0x41, 0x13, 0x7b, 0x99, 0x9c, 0x79, 0x4c, 0xa5, 0x24, 0xa5,
-	0x6c, 0xa4, 0xae, 0x08, 0x26, 0x39, 0xad, 0xf8, 0x52, 0xc9, 0xd1, 0x0c, 0x82, 0x1e, 0x80, 0xc6,
-	0x8d, 0x11, 0x91, 0xb3, 0xcc, 0x57, 0xdf, 0x7f, 0x43, 0xf9, 0xaa, 0xaf, 0x50, 0x6b, 0x91, 0x52,
-	0xbe, 0xc6, 0x02, 0xa3, 0x7b, 0x12, 0xf8, 0x33, 0x00, 0xf5, 0x99, 0xf8, 0xdf, 0x72, 0x5a, 0x3e,
-	0xb7, 0xf4, 0x8c, 0x57, 0xf1, 0xb5, 0xd6, 0xec, 0xd9, 0xd6, 0xe8, 0x9c, 0x53, 0x93, 0xc5, 0x79,
-	0xd5, 0xaa, 0xda, 0xab, 0xe7, 0x55, 0x6b, 0xdd, 0xb6, 0xca, 0xfa, 0x99, 0x2c, 0xd0, 0xd6, 0x18,
-	0x4f, 0x6d, 0xcf, 0xfb, 0xc5, 0xf7, 0x37, 0xbb, 0xcb, 0xef, 0x6e, 0x76, 0x97, 0xff, 0x75, 0xb3,
-	0xbb, 0xfc, 0xb7, 0xf7, 0xbb, 0x4b, 0xef, 0xde, 0xef, 0x2e, 0xfd, 0xe3, 0xfd, 0xee, 0xd2, 0x1f,
-	0x7e, 0x14, 0xc5, 0xa2, 0x97, 0x77, 0x0f, 0x02, 0x9a, 0xca, 0xa7, 0x32, 0xe5, 0xe6, 0xef, 0xe0,
-	0xe8, 0x93, 0xc3, 0xa1, 0x7a, 0x43, 0x8b, 0x51, 0x9f, 0xf0, 0xee, 0x9a, 0x7a, 0x1b, 0x3f, 0xff,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0x0c, 0x86, 0x61, 0x61, 0x0f, 0x00, 0x00,
+	// 1839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xdd, 0x4e, 0x23, 0xc9,
+	0x15, 0x06, 0xdc, 0x40, 0xbb, 0x6c, 0xec, 0xa6, 0x30, 0xac, 0x97, 0x51, 0x68, 0xd2, 0x91, 0x12,
+	0x22, 0xed, 0xc2, 0xc0, 0x84, 0xdd, 0xd1, 0xae, 0xf2, 0x33, 0x9e, 0x61, 0x26, 0x90, 0x99, 0x0d,
+	0xa9, 0x61, 0x13, 0x25, 0x4a, 0xd4, 0x2a, 0x77, 0xd7, 0xb4, 0x7b, 0xe9, 0xee, 0x72, 0xaa, 0xaa,
+	0x3d, 0x76, 0x9e, 0x20, 0x52, 0x6e, 0xf2, 0x02, 0x91, 0x56, 0xca, 0x2b, 0xe4, 0x21, 0x56, 0xb9,
+	0xda, 0xcb, 0x68, 0x2f, 0x5a, 0x11, 0x73, 0xc7, 0x25, 0xf7, 0x91, 0xa2, 0xfa, 0xf1, 0x2f, 0x0c,
+	0xf2, 0x0d, 0xd4, 0x77, 0x7e, 0xbe, 0x53, 0xe7, 0xd4, 0xa9, 0xae, 0x2a, 0x83, 0x6d, 0x22, 0x3a,
+	0x84, 0xa5, 0x71, 0x26, 0x0e, 0x48, 0x2f, 0x3d, 0xe8, 0x1d, 0xca, 0x7f, 0xfb, 0x5d, 0x46, 0x05,
+	0x85, 0xce, 0x48, 0xb7, 0x2f, 0x85, 0xbd, 0xc3, 0xed, 0x46, 0x44, 0x23, 0xaa, 0x94, 0x07, 0x72,
+	0xa4, 0xed, 0xbc, 0x7f, 0x59, 0x60, 0xe5, 0x1c, 0x33, 0x9c, 0x72, 0x78, 0x08, 0xca, 0xa4, 0x97,
+	0xfa, 0x21, 0xc9, 0x68, 0xda, 0x5c, 0xdc, 0x5d, 0xdc, 0x2b, 0xb7, 0x1a, 0x37, 0x85, 0xeb, 0x0c,
+	0x70, 0x9a, 0x7c, 0xe6, 0x8d, 0x54, 0x1e, 0xb2, 0x49, 0x2f, 0x7d, 0x26, 0x87, 0xf0, 0xa7, 0x60,
+	0x8d, 0x64, 0xb8, 0x9d, 0x10, 0x3f, 0x60, 0x04, 0x0b, 0xd2, 0x5c, 0xda, 0x5d, 0xdc, 0xb3, 0x5b,
+	0xcd, 0x9b, 0xc2, 0x6d, 0x18, 0xb7, 0x49, 0xb5, 0x87, 0xaa, 0x1a, 0x3f, 0x55, 0x10, 0x7e, 0x0a,
+	0x2a, 0x43, 0x3d, 0x4e, 0x92, 0x66, 0x49, 0x39, 0x6f, 0xdd, 0x14, 0x2e, 0x9c, 0x76, 0xc6, 0x49,
+	0xe2, 0x21, 0x60, 0x5c, 0x71, 0x92, 0xc0, 0x27, 0x00, 0x90, 0xbe, 0x60, 0xd8, 0x27, 0x71, 0x97,
+	0x37, 0xad, 0xdd, 0xd2, 0x5e, 0xa9, 0xe5, 0x5d, 0x15, 0x6e, 0xf9, 0x44, 0x4a, 0x4f, 0x4e, 0xcf,
+	0xf9, 0x4d, 0xe1, 0xae, 0x1b, 0x92, 0x91, 0xa1, 0x87, 0xca, 0x0a, 0x9c, 0xc4, 0x5d, 0x0e, 0xff,
+	0x04, 0xaa, 0x41, 0x07, 0xc7, 0x99, 0x1f, 0xd0, 0xec, 0x4d, 0x1c, 0x35, 0x97, 0x77, 0x17, 0xf7,
+	0x2a, 0x47, 0xdf, 0xdb, 0x9f, 0xad, 0xdb, 0xfe, 0x53, 0x69, 0xf5, 0x54, 0x19, 0xb5, 0x1e, 0x7c,
+	0x53, 0xb8, 0x0b, 0x37, 0x85, 0xbb, 0xa1, 0xa9, 0x27, 0x09, 0x3c, 0x54, 0x09, 0xc6, 0x96, 0xf0,
+	0x08, 0x6c, 0xe2, 0x24, 0xa1, 0x6f, 0xfd, 0x3c, 0x93, 0x85, 0x26, 0x81, 0x20, 0xa1, 0x2f, 0xfa,
+	0xbc, 0xb9, 0x22, 0x93, 0x44, 0x1b, 0x4a, 0xf9, 0xe5, 0x58, 0x77, 0xd1, 0xe7, 0xf0, 0x63, 0x00,
+	0x71, 0x20, 0xe2, 0x1e, 0xf1, 0xbb, 0x8c, 0x04, 0x34, 0xed, 0xc6, 0x09, 0xe1, 0xcd, 0xd5, 0xdd,
+	0xd2, 0x5e, 0x19, 0xad, 0x6b, 0xcd, 0xf9, 0x58, 0x01, 0x8f, 0x40, 0x55, 0x2e, 0x4a, 0xd0, 0xc1,
+	0x59, 0x46, 0x12, 0xde, 0xb4, 0xa5, 0x61, 0xab, 0x7e, 0x55, 0xb8, 0x95, 0x93, 0xdf, 0xbe, 0x7a,
+	0x6a, 0xc4, 0xa8, 0x42, 0x7a, 0xe9, 0x10, 0xc0, 0xe7, 0x00, 0x44, 0x98, 0xfb, 0x8c, 0xbc, 0xc9,
+	0xb3, 0xb0, 0x59, 0x56, 0x39, 0x7f, 0xff, 0x76, 0xce, 0x2f, 0x30, 0x47, 0xca, 0xc4, 0xe4, 0x6d,
+	0xc9, 0xbc, 0x51, 0x39, 0x1a, 0x8a, 0xbd, 0x7f, 0x2e, 0x82, 0xfa, 0x8c, 0x11, 0xfc, 0x11, 0xa8,
+	0x6b, 0x5e, 0xff, 0xcf, 0x39, 0x15, 0x31, 0xc9, 0x84, 0xea, 0x22, 0x0b, 0xd5, 0xb4, 0xf8, 0x37,
+	0x46, 0x0a, 0x23, 0xb0, 0xc3, 0x48, 0x8f, 0x30, 0x5d, 0x12, 0x3f, 0x8d, 0x33, 0x5f, 0x4e, 0x2a,
+	0xcd, 0x13, 0x11, 0x77, 0x93, 0x98, 0x30, 0xd5, 0x46, 0xe5, 0xd6, 0x0f, 0x64, 0xd4, 0xef, 0x0a,
+	0xf7, 0x41, 0x40, 0x79, 0x4a, 0x39, 0x0f, 0x2f, 0xf7, 0x63, 0x7a, 0x90, 0x62, 0xd1, 0xd9, 0x7f,
+	0x49, 0x22, 0x1c, 0x0c, 0x9e, 0x91, 0x00, 0x6d, 0x0f, 0xa9, 0x2e, 0xfa, 0xaf, 0xe2, 0xec, 0x05,
+	0xe6, 0xaf, 0x46, 0x34, 0xde, 0xff, 0x6a, 0xa0, 0x32, 0xb1, 0x7c, 0xf0, 0x8f, 0xa0, 0xde, 0xa1,
+	0x29, 0xe1, 0x82, 0xe0, 0xd0, 0x6f, 0x27, 0x34, 0xb8, 0x34, 0x7d, 0xfe, 0xe8, 0xbb, 0xc2, 0xdd,
+	0xbc, 0x1d, 0xe5, 0x34, 0x13, 0x37, 0x85, 0xbb, 0xa5, 0x17, 0x7b, 0xc6, 0xd3, 0x43, 0xb5, 0x91,
+	0xa4, 0x25, 0x05, 0xb0, 0x03, 0x6a, 0x21, 0xa6, 0xfe, 0x1b, 0xca, 0x2e, 0x0d, 0xb9, 0x4e, 0xa3,
+	0xf5, 0x5e, 0xf2, 0xab, 0xc2, 0xad, 0x3e, 0x7b, 0xf2, 0xeb, 0xe7, 0x94, 0x5d, 0x2a, 0x8a, 0x9b,
+	0xc2, 0xdd, 0xd4, 0xc1, 0xa6, 0x89, 0x3c, 0x54, 0x0d, 0x31, 0x1d, 0x99, 0xc1, 0xdf, 0x01, 0x67,
+	0x64, 0xc0, 0xf3, 0x6e, 0x97, 0x32, 0x61, 0x36, 0xcf, 0xc7, 0x57, 0x85, 0x5b, 0x33, 0x94, 0xaf,
+	0xb5, 0xe6, 0xa6, 0x70, 0x3f, 0x98, 0x21, 0x35, 0x3e, 0x1e, 0xaa, 0x19, 0x5a, 0x63, 0x0a, 0xdb,
+	0xa0, 0x4a, 0xe2, 0xee, 0xe1, 0xf1, 0x43, 0x93, 0x80, 0xa5, 0x12, 0xf8, 0xf9, 0x7d, 0x09, 0x54,
+	0x4e, 0x4e, 0xcf, 0x0f, 0x8f, 0x1f, 0x0e, 0xe7, 0x6f, 0x76, 0xc6, 0x24, 0x8b, 0x87, 0x2a, 0x1a,
+	0xea, 0xc9, 0x9f, 0x02, 0x03, 0xfd, 0x0e, 0xe6, 0x1d, 0xb5, 0xef, 0xca, 0xad, 0xbd, 0xab, 0xc2,
+	0x05, 0x9a, 0xe9, 0x97, 0x98, 0x77, 0xc6, 0x55, 0x6f, 0x0f, 0xfe, 0x82, 0x33, 0x11, 0xe7, 0xe9,
+	0x90, 0x0b, 0x68, 0x67, 0x69, 0x35, 0x9a, 0xee, 0xb1, 0x99, 0xee, 0xca, 0xbc, 0xd3, 0x3d, 0xbe,
+	0x6b, 0xba, 0xc7, 0xd3, 0xd3, 0xd5, 0x36, 0xa3, 0x18, 0x8f, 0x4d, 0x8c, 0xd5, 0x79, 0x63, 0x3c,
+	0xbe, 0x2b, 0xc6, 0xe3, 0xe9, 0x18, 0xda, 0x46, 0xf6, 0xe5, 0x4c, 0x9e, 0x4d, 0x7b, 0xee, 0xbe,
+	0xbc, 0x55, 0xa1, 0xda, 0x48, 0xa2, 0xd9, 0x2f, 0x41, 0x23, 0xa0, 0x19, 0x17, 0x52, 0x96, 0xd1,
+	0x6e, 0x42, 0x4c, 0x88, 0xb2, 0x0a, 0xf1, 0xf8, 0xbe, 0x10, 0x0f, 0xcc, 0x77, 0xee, 0x0e, 0x77,
+	0x0f, 0x6d, 0x4c, 0x8b, 0x75, 0x30, 0x1f, 0x38, 0x5d, 0x22, 0x08, 0xe3, 0xed, 0x9c, 0x45, 0x26,
+	0x10, 0x50, 0x81, 0x7e, 0x72, 0x5f, 0x20, 0xd3, 0xa1, 0xb3, 0xae, 0x1e, 0xaa, 0x8f, 0x45, 0x3a,
+	0xc0, 0xef, 0x41, 0x2d, 0x96, 0x51, 0xdb, 0x79, 0x62, 0xe8, 0x2b, 0x8a, 0xfe, 0xe8, 0x3e, 0x7a,
+	0xb3, 0xab, 0xa6, 0x1d, 0x3d, 0xb4, 0x36, 0x14, 0x68, 0xea, 0x10, 0xc0, 0x34, 0x8f, 0x99, 0x1f,
+	0x25, 0x38, 0x88, 0x09, 0x33, 0xf4, 0x55, 0x45, 0xff, 0xc9, 0x7d, 0xf4, 0x1f, 0x6a, 0xfa, 0xdb,
+	0xce, 0x1e, 0x72, 0xa4, 0xf0, 0x85, 0x96, 0xe9, 0x28, 0xaf, 0x41, 0xb5, 0x4d, 0x58, 0x12, 0x67,
+	0x86, 0x7f, 0x4d, 0xf1, 0x3f, 0xbc, 0x8f, 0xdf, 0x74, 0xd0, 0xa4, 0x9b, 0x87, 0x2a, 0x1a, 0x8e,
+	0x48, 0x13, 0x9a, 0x85, 0x74, 0x48, 0xba, 0x3e, 0x37, 0xe9, 0xa4, 0x9b, 0x87, 0x2a, 0x1a, 0x6a,
+	0xd2, 0x08, 0x6c, 0x60, 0xc6, 0xe8, 0xdb, 0x99, 0x82, 0x40, 0xc5, 0xfd, 0xe9, 0x7d, 0xdc, 0xdb,
+	0x9a, 0xfb, 0x0e, 0x6f, 0x0f, 0xad, 0x2b, 0xe9, 0x54, 0x49, 0x42, 0x00, 0x23, 0x86, 0x07, 0x33,
+	0x71, 0x1a, 0x73, 0x17, 0xfe, 0xb6, 0xb3, 0x87, 0x1c, 0x29, 0x9c, 0x8a, 0xf2, 0x15, 0x68, 0xa4,
+	0x84, 0x45, 0xc4, 0xcf, 0x88, 0xe0, 0xdd, 0x24, 0x16, 0x26, 0xce, 0xe6, 0xdc, 0xfb, 0xe0, 0x2e,
+	0x77, 0x0f, 0x41, 0x25, 0xfe, 0xc2, 0x48, 0x47, 0x5d, 0xca, 0x3b, 0x38, 0x8b, 0x3a, 0x38, 0x36,
+	0x51, 0xb6, 0xe6, 0xee, 0xd2, 0x69, 0x47, 0x0f, 0xad, 0x0d, 0x05, 0xa3, 0xa5, 0x0e, 0x70, 0x16,
+	0xe4, 0xc3, 0xa5, 0xfe, 0x60, 0xee, 0xa5, 0x9e, 0x74, 0x93, 0xd7, 0x15, 0x05, 0x15, 0xe9, 0x99,
+	0x65, 0xd7, 0x9c, 0xfa, 0x99, 0x65, 0xd7, 0x1d, 0xe7, 0xcc, 0xb2, 0x1d, 0x67, 0xfd, 0xcc, 0xb2,
+	0x37, 0x9c, 0x06, 0x5a, 0x1b, 0xd0, 0x84, 0xfa, 0xbd, 0x47, 0xda, 0x09, 0x55, 0xc8, 0x5b, 0xcc,
+	0xcd, 0x87, 0x06, 0xd5, 0x02, 0x2c, 0x70, 0x32, 0xe0, 0xa6, 0x10, 0xc8, 0xd1, 0xe5, 0x99, 0x38,
+	0xb6, 0x0e, 0xc0, 0xf2, 0x6b, 0x21, 0x2f, 0x7a, 0x0e, 0x28, 0x5d, 0x92, 0x81, 0x3e, 0x6c, 0x91,
+	0x1c, 0xc2, 0x06, 0x58, 0xee, 0xe1, 0x24, 0xd7, 0x37, 0xc6, 0x32, 0xd2, 0xc0, 0x3b, 0x07, 0xf5,
+	0x0b, 0x86, 0x33, 0x2e, 0x2f, 0x3b, 0x34, 0x7b, 0x49, 0x23, 0x0e, 0x21, 0xb0, 0xd4, 0x39, 0xa1,
+	0x7d, 0xd5, 0x18, 0xfe, 0x18, 0x58, 0x09, 0x8d, 0x78, 0x73, 0x69, 0xb7, 0xb4, 0x57, 0x39, 0xda,
+	0xbc, 0x7d, 0x7f, 0x79, 0x49, 0x23, 0xa4, 0x4c, 0xbc, 0x7f, 0x2f, 0x81, 0xd2, 0x4b, 0x1a, 0xc1,
+	0x26, 0x58, 0xc5, 0x61, 0xc8, 0x08, 0xe7, 0x86, 0x69, 0x08, 0xe1, 0x16, 0x58, 0x11, 0xb4, 0x1b,
+	0x07, 0x9a, 0xae, 0x8c, 0x0c, 0x92, 0x81, 0x43, 0x2c, 0xb0, 0x3a, 0x58, 0xab, 0x48, 0x8d, 0xe5,
+	0x95, 0x4b, 0x65, 0xe6, 0x67, 0x79, 0xda, 0x26, 0x4c, 0x9d, 0x8f, 0x56, 0xab, 0x7e, 0x5d, 0xb8,
+	0x15, 0x25, 0xff, 0x42, 0x89, 0xd1, 0x24, 0x80, 0x1f, 0x81, 0x55, 0xd1, 0x9f, 0x3c, 0xeb, 0x36,
+	0xae, 0x0b, 0xb7, 0x2e, 0xc6, 0x69, 0xca, 0xa3, 0x0c, 0xad, 0x88, 0xbe, 0xfc, 0x0f, 0x0f, 0x80,
+	0x2d, 0xfa, 0x7e, 0x9c, 0x85, 0xa4, 0xaf, 0x8e, 0x33, 0xab, 0xd5, 0xb8, 0x2e, 0x5c, 0x67, 0xc2,
+	0xfc, 0x54, 0xea, 0xd0, 0xaa, 0xe8, 0xab, 0x01, 0xfc, 0x08, 0x00, 0x3d, 0x25, 0x15, 0x41, 0x9f,
+	0x4e, 0x6b, 0xd7, 0x85, 0x5b, 0x56, 0x52, 0xc5, 0x3d, 0x1e, 0x42, 0x0f, 0x2c, 0x6b, 0x6e, 0x5b,
+	0x71, 0x57, 0xaf, 0x0b, 0xd7, 0x4e, 0x68, 0xa4, 0x39, 0xb5, 0x4a, 0x96, 0x8a, 0x91, 0x94, 0xf6,
+	0x88, 0xbe, 0x20, 0xda, 0x68, 0x08, 0xbd, 0xbf, 0x2d, 0x01, 0xfb, 0xa2, 0x8f, 0x08, 0xcf, 0x13,
+	0x01, 0x9f, 0x03, 0x27, 0xa0, 0x99, 0x60, 0x38, 0x10, 0xfe, 0x54, 0x69, 0x5b, 0x0f, 0xc6, 0x1f,
+	0xf4, 0x59, 0x0b, 0x0f, 0xd5, 0x87, 0xa2, 0x27, 0xa6, 0xfe, 0x0d, 0xb0, 0xdc, 0x4e, 0x28, 0x4d,
+	0x55, 0x27, 0x54, 0x91, 0x06, 0x10, 0xa9, 0xaa, 0xa9, 0x55, 0x2e, 0xbd, 0xef, 0x96, 0x3a, 0xd3,
+	0x2a, 0xad, 0x2d, 0x73, 0x3b, 0xaf, 0xe9, 0xd8, 0xc6, 0xdf, 0x93, 0xb5, 0x55, 0xad, 0xe4, 0x80,
+	0x12, 0x23, 0x42, 0x2d, 0x5a, 0x15, 0xc9, 0x21, 0xdc, 0x06, 0xf6, 0xf0, 0xfa, 0xa8, 0x16, 0xc7,
+	0x46, 0x23, 0x0c, 0x3f, 0x04, 0xb6, 0xbc, 0x95, 0xe6, 0x9c, 0x84, 0x7a, 0x25, 0xd0, 0x6a, 0x84,
+	0xf9, 0x97, 0x9c, 0x84, 0x9f, 0x59, 0x7f, 0xfd, 0xda, 0x5d, 0xf0, 0x30, 0xa8, 0x3c, 0x09, 0x02,
+	0xc2, 0xf9, 0x45, 0xde, 0x4d, 0xc8, 0x3d, 0x1d, 0x76, 0x04, 0xaa, 0x5c, 0x50, 0x86, 0x23, 0xe2,
+	0x5f, 0x92, 0x81, 0xe9, 0x33, 0xdd, 0x35, 0x46, 0xfe, 0x2b, 0x32, 0xe0, 0x68, 0x12, 0x98, 0x10,
+	0x5f, 0x5b, 0xa0, 0x72, 0xc1, 0x70, 0x40, 0xcc, 0x05, 0x56, 0xf6, 0xaa, 0x84, 0xcc, 0x84, 0x30,
+	0x48, 0xc6, 0x16, 0x71, 0x4a, 0x68, 0x2e, 0xcc, 0x7e, 0x1a, 0x42, 0xe9, 0xc1, 0x08, 0xe9, 0x93,
+	0x40, 0x95, 0xd1, 0x42, 0x06, 0xc1, 0x63, 0xb0, 0x16, 0xc6, 0x5c, 0x3d, 0xaf, 0xb8, 0xc0, 0xc1,
+	0xa5, 0x4e, 0xbf, 0xe5, 0x5c, 0x17, 0x6e, 0xd5, 0x28, 0x5e, 0x4b, 0x39, 0x9a, 0x42, 0xf0, 0x73,
+	0x50, 0x1f, 0xbb, 0xa9, 0xd9, 0xea, 0x07, 0x4d, 0x0b, 0x5e, 0x17, 0x6e, 0x6d, 0x64, 0xaa, 0x34,
+	0x68, 0x06, 0xcb, 0x95, 0x0e, 0x49, 0x3b, 0x8f, 0x54, 0xf3, 0xd9, 0x48, 0x03, 0x29, 0x4d, 0xe2,
+	0x34, 0x16, 0xaa, 0xd9, 0x96, 0x91, 0x06, 0xf0, 0x73, 0x50, 0xa6, 0x3d, 0xc2, 0x58, 0x1c, 0x12,
+	0xde, 0x04, 0x73, 0xbc, 0xcd, 0xd0, 0xd8, 0x5e, 0x26, 0x67, 0x9e, 0x8e, 0x29, 0x49, 0x29, 0x1b,
+	0x34, 0x2b, 0xe3, 0xe4, 0xb4, 0xe2, 0x95, 0x92, 0xa3, 0x29, 0x04, 0x5b, 0x00, 0x1a, 0x37, 0x46,
+	0x44, 0xce, 0x32, 0x5f, 0xed, 0xff, 0xaa, 0xf2, 0x55, 0xbb, 0x50, 0x6b, 0x91, 0x52, 0x3e, 0xc3,
+	0x02, 0xa3, 0x5b, 0x12, 0xf8, 0x33, 0x00, 0xf5, 0x9a, 0xf8, 0x5f, 0x71, 0x3a, 0x7a, 0x5c, 0xea,
+	0x33, 0x5e, 0xc5, 0xd7, 0x5a, 0x33, 0x67, 0x47, 0xa3, 0x33, 0x4e, 0x4d, 0x16, 0x67, 0x96, 0x6d,
+	0x39, 0xcb, 0x67, 0x96, 0xbd, 0xea, 0xd8, 0xa3, 0xfa, 0x99, 0x2c, 0xd0, 0xc6, 0x10, 0x4f, 0x4c,
+	0xcf, 0xfb, 0xc7, 0x22, 0xa8, 0x8d, 0x5f, 0x85, 0xa7, 0xd9, 0x1b, 0x2a, 0xbf, 0x5c, 0x19, 0x4e,
+	0xc9, 0xf0, 0x93, 0x29, 0xc7, 0x93, 0xdd, 0xb9, 0x34, 0xdd, 0x9d, 0x4d, 0xb0, 0xda, 0x23, 0x8c,
+	0xc7, 0x34, 0x53, 0x2d, 0x52, 0x46, 0x43, 0x28, 0x77, 0x00, 0x6e, 0xc7, 0xfa, 0xc3, 0x62, 0x19,
+	0xa7, 0x76, 0xac, 0xbe, 0x23, 0x0e, 0x28, 0xe1, 0x76, 0xac, 0x3f, 0x68, 0x48, 0x0e, 0x65, 0xa3,
+	0xe9, 0x27, 0xaa, 0x79, 0xe1, 0x1a, 0xd4, 0xfa, 0xc5, 0x37, 0x57, 0x3b, 0x8b, 0xdf, 0x5e, 0xed,
+	0x2c, 0xfe, 0xf7, 0x6a, 0x67, 0xf1, 0xef, 0xef, 0x76, 0x16, 0xbe, 0x7d, 0xb7, 0xb3, 0xf0, 0x9f,
+	0x77, 0x3b, 0x0b, 0x7f, 0xf8, 0x61, 0x14, 0x8b, 0x4e, 0xde, 0xde, 0x0f, 0x68, 0x2a, 0x7f, 0xb8,
+	0xa0, 0xdc, 0xfc, 0xed, 0x1d, 0x7e, 0x72, 0xd0, 0x97, 0xe3, 0x03, 0x31, 0xe8, 0x12, 0xde, 0x5e,
+	0x51, 0xbf, 0x54, 0x3c, 0xfa, 0xff, 0x00, 0x45, 0x7d, 0xa9, 0xec, 0xef, 0x10, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -835,6 +1004,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.GasRefund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if len(m.EVMChannels) > 0 {
 		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EVMChannels[iNdEx])
@@ -874,21 +1053,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x2a
 	if len(m.ExtraEIPs) > 0 {
-		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
+		var j3 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvm(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvm(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -922,6 +1101,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasRefundConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasRefundConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasRefundConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RevertedTxMinGasMultiplier.Size()
+		i -= size
+		if _, err := m.RevertedTxMinGasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RefundQuotient != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.RefundQuotient))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1568,6 +1785,74 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PrecompileInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AbiHash) > 0 {
+		i -= len(m.AbiHash)
+		copy(dAtA[i:], m.AbiHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.AbiHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvm(v)
 	base := offset
@@ -1619,6 +1904,22 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	l = m.GasRefund.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *GasRefundConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RefundQuotient != 0 {
+		n += 1 + sovEvm(uint64(m.RefundQuotient))
+	}
+	l = m.RevertedTxMinGasMultiplier.Size()
+	n += 1 + l + sovEvm(uint64(l))
 	return n
 }
 
@@ -1879,6 +2180,38 @@ func (m *TraceConfig) Size() (n int) {
 	return n
 }
 
+func (m *PrecompileInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.AbiHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2179,6 +2512,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.EVMChannels = append(m.EVMChannels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasRefund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2200,7 +2566,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+func (m *GasRefundConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2223,17 +2589,17 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasRefundConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasRefundConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundQuotient", wireType)
 			}
-			var stringLen uint64
+			m.RefundQuotient = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2243,7 +2609,110 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RefundQuotient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedTxMinGasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RevertedTxMinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4089,6 +4558,236 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PrecompileInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbiHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbiHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
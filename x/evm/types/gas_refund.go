@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// NewGasRefundConfig creates a new GasRefundConfig instance
+func NewGasRefundConfig(refundQuotient uint64, revertedTxMinGasMultiplier sdkmath.LegacyDec) GasRefundConfig {
+	return GasRefundConfig{
+		RefundQuotient:             refundQuotient,
+		RevertedTxMinGasMultiplier: revertedTxMinGasMultiplier,
+	}
+}
+
+// DefaultGasRefundConfig returns the default gas refund rules, which use the
+// refund quotient of the active fork and refund all the leftover gas of the
+// reverted transactions.
+func DefaultGasRefundConfig() GasRefundConfig {
+	return NewGasRefundConfig(0, sdkmath.LegacyZeroDec())
+}
+
+// Validate performs a stateless validation of the gas refund rules. A nil
+// reverted transaction min gas multiplier is accepted for the params stored
+// before it was introduced and is equivalent to zero.
+func (c GasRefundConfig) Validate() error {
+	multiplier := c.RevertedTxMinGasMultiplier
+	if multiplier.IsNil() {
+		return nil
+	}
+
+	if multiplier.IsNegative() || multiplier.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("reverted tx min gas multiplier must be between 0 and 1: %s", multiplier)
+	}
+
+	return nil
+}
+
+// EffectiveRefundQuotient returns the quotient that caps the refund of the gas refund
+// counter, falling back to the quotient of the active fork when it isn't set.
+func (c GasRefundConfig) EffectiveRefundQuotient(isLondon bool) uint64 {
+	switch {
+	case c.RefundQuotient != 0:
+		return c.RefundQuotient
+	case isLondon:
+		// After EIP-3529: refunds are capped to gasUsed / 5
+		return params.RefundQuotientEIP3529
+	default:
+		return params.RefundQuotient
+	}
+}
+
+// RevertedTxGasUsed returns the gas charged to the sender of a reverted
+// transaction, which is at least the share of the gas limit defined by the
+// reverted transaction min gas multiplier.
+func (c GasRefundConfig) RevertedTxGasUsed(gasLimit, gasUsed uint64) uint64 {
+	multiplier := c.RevertedTxMinGasMultiplier
+	if multiplier.IsNil() || !multiplier.IsPositive() {
+		return gasUsed
+	}
+
+	minGasUsed := multiplier.MulInt(sdkmath.NewIntFromUint64(gasLimit)).TruncateInt().Uint64()
+	if minGasUsed > gasUsed {
+		return minGasUsed
+	}
+	return gasUsed
+}
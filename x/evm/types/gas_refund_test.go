@@ -0,0 +1,91 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasRefundConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      GasRefundConfig
+		errContains string
+	}{
+		{
+			"default",
+			DefaultGasRefundConfig(),
+			"",
+		},
+		{
+			"nil multiplier",
+			GasRefundConfig{RefundQuotient: 2},
+			"",
+		},
+		{
+			"full gas limit charged",
+			NewGasRefundConfig(5, sdkmath.LegacyOneDec()),
+			"",
+		},
+		{
+			"negative multiplier",
+			NewGasRefundConfig(0, sdkmath.LegacyNewDec(-1)),
+			"reverted tx min gas multiplier must be between 0 and 1",
+		},
+		{
+			"multiplier greater than 1",
+			NewGasRefundConfig(0, sdkmath.LegacyNewDecWithPrec(11, 1)),
+			"reverted tx min gas multiplier must be between 0 and 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.config.Validate()
+		if tc.errContains == "" {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorContains(t, err, tc.errContains, tc.name)
+		}
+	}
+}
+
+func TestEffectiveRefundQuotient(t *testing.T) {
+	testCases := []struct {
+		name     string
+		config   GasRefundConfig
+		isLondon bool
+		exp      uint64
+	}{
+		{"default - pre London", DefaultGasRefundConfig(), false, ethparams.RefundQuotient},
+		{"default - London", DefaultGasRefundConfig(), true, ethparams.RefundQuotientEIP3529},
+		{"custom - pre London", NewGasRefundConfig(10, sdkmath.LegacyZeroDec()), false, 10},
+		{"custom - London", NewGasRefundConfig(10, sdkmath.LegacyZeroDec()), true, 10},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.exp, tc.config.EffectiveRefundQuotient(tc.isLondon), tc.name)
+	}
+}
+
+func TestRevertedTxGasUsed(t *testing.T) {
+	testCases := []struct {
+		name     string
+		config   GasRefundConfig
+		gasLimit uint64
+		gasUsed  uint64
+		exp      uint64
+	}{
+		{"default - gas used is charged", DefaultGasRefundConfig(), 100_000, 30_000, 30_000},
+		{"nil multiplier - gas used is charged", GasRefundConfig{}, 100_000, 30_000, 30_000},
+		{"min gas greater than gas used", NewGasRefundConfig(0, sdkmath.LegacyNewDecWithPrec(5, 1)), 100_000, 30_000, 50_000},
+		{"min gas lower than gas used", NewGasRefundConfig(0, sdkmath.LegacyNewDecWithPrec(2, 1)), 100_000, 30_000, 30_000},
+		{"full gas limit charged", NewGasRefundConfig(0, sdkmath.LegacyOneDec()), 100_000, 30_000, 100_000},
+		{"min gas rounded down", NewGasRefundConfig(0, sdkmath.LegacyNewDecWithPrec(1, 1)), 100_005, 0, 10_000},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.exp, tc.config.RevertedTxGasUsed(tc.gasLimit, tc.gasUsed), tc.name)
+	}
+}
@@ -5,12 +5,14 @@ package types
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
@@ -50,6 +52,10 @@ type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	CalculateBaseFee(ctx sdk.Context) *big.Int
+	GetConversionRate(ctx sdk.Context, denom string) (sdkmath.LegacyDec, error)
+	DistributeTxFees(ctx sdk.Context, txClass string, fees sdk.Coins) error
+	GetGasPriceMultiplier(ctx sdk.Context, contract *common.Address) sdkmath.LegacyDec
+	GetMaxEVMBlockGas(ctx sdk.Context) uint64
 }
 
 // Event Hooks
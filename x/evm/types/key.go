@@ -3,6 +3,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -36,6 +37,8 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientFeeDenom
+	prefixTransientBlockGasUsed
 )
 
 // KVStore key prefixes
@@ -47,12 +50,20 @@ var (
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom        = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex      = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize      = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed      = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientFeeDenom     = []byte{prefixTransientFeeDenom}
+	KeyPrefixTransientBlockGasUsed = []byte{prefixTransientBlockGasUsed}
 )
 
+// TransientFeeDenomKey returns the key of the denom used to pay the fees of the
+// Ethereum transaction with the given sender and nonce.
+func TransientFeeDenomKey(from common.Address, nonce uint64) []byte {
+	return append(from.Bytes(), sdk.Uint64ToBigEndian(nonce)...)
+}
+
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
 func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
@@ -34,14 +34,22 @@ var (
 	// AvailableEVMExtensions defines the default active precompiles
 	AvailableEVMExtensions = []string{
 		p256.PrecompileAddress,                       // P256 precompile
+		"0x0000000000000000000000000000000000000101", // Ed25519 precompile
+		"0x0000000000000000000000000000000000000102", // BLS12-381 precompile
 		"0x0000000000000000000000000000000000000400", // Bech32 precompile
 		"0x0000000000000000000000000000000000000800", // Staking precompile
 		"0x0000000000000000000000000000000000000801", // Distribution precompile
 		"0x0000000000000000000000000000000000000802", // ICS20 transfer precompile
 		"0x0000000000000000000000000000000000000803", // Vesting precompile
 		"0x0000000000000000000000000000000000000804", // Bank precompile
+		"0x0000000000000000000000000000000000000805", // Revenue precompile
+		"0x0000000000000000000000000000000000000806", // Authz precompile
+		"0x0000000000000000000000000000000000000807", // Feegrant precompile
+		"0x0000000000000000000000000000000000000808", // Randomness precompile
+		"0x0000000000000000000000000000000000000809", // IBC precompile
 		"0x0000000000000000000000000000000000000900", // Stride outpost
 		"0x0000000000000000000000000000000000000901", // Osmosis outpost
+		"0x0000000000000000000000000000000000000902", // Router outpost
 	}
 	// DefaultExtraEIPs defines the default extra EIPs to be included
 	// On v15, EIP 3855 was enabled
@@ -90,6 +98,7 @@ func DefaultParams() Params {
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
 		ActivePrecompiles:   AvailableEVMExtensions,
 		EVMChannels:         DefaultEVMChannels,
+		GasRefund:           DefaultGasRefundConfig(),
 	}
 }
 
@@ -141,6 +150,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.GasRefund.Validate(); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
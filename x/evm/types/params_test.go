@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -59,6 +60,14 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "invalid gas refund",
+			params: Params{
+				EvmDenom:  DefaultEVMDenom,
+				GasRefund: NewGasRefundConfig(0, sdkmath.LegacyNewDec(2)),
+			},
+			errContains: "reverted tx min gas multiplier must be between 0 and 1",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,213 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// DefaultPrecompileVersion defines the version of the precompiled contracts
+// that don't report their own metadata.
+const DefaultPrecompileVersion = "v1"
+
+// PrecompileWithABI defines the interface of the precompiled contracts that
+// expose a Solidity ABI.
+type PrecompileWithABI interface {
+	GetABI() abi.ABI
+}
+
+// PrecompileWithMetadata defines the interface of the precompiled contracts
+// that report their own name and interface version. The version has to be
+// bumped every time the ABI of the precompiled contract changes.
+type PrecompileWithMetadata interface {
+	PrecompileName() string
+	PrecompileVersion() string
+}
+
+// PrecompileMetadata defines the name and the interface version of a
+// precompiled contract.
+type PrecompileMetadata struct {
+	Name    string
+	Version string
+}
+
+// ethereumPrecompilesNames maps the address of the precompiled contracts
+// defined by go-ethereum, which don't report their own metadata, to their
+// names.
+var ethereumPrecompilesNames = map[common.Address]string{
+	common.BytesToAddress([]byte{0x01}): "ecrecover",
+	common.BytesToAddress([]byte{0x02}): "sha256",
+	common.BytesToAddress([]byte{0x03}): "ripemd160",
+	common.BytesToAddress([]byte{0x04}): "identity",
+	common.BytesToAddress([]byte{0x05}): "modexp",
+	common.BytesToAddress([]byte{0x06}): "bn256Add",
+	common.BytesToAddress([]byte{0x07}): "bn256ScalarMul",
+	common.BytesToAddress([]byte{0x08}): "bn256Pairing",
+	common.BytesToAddress([]byte{0x09}): "blake2f",
+}
+
+// GetPrecompileMetadata returns the metadata of the given precompiled
+// contract. The metadata is reported by the precompiled contract itself if it
+// implements the PrecompileWithMetadata interface. Otherwise, the go-ethereum
+// precompiled contracts are named after their address and the rest after the
+// Go package that implements them.
+func GetPrecompileMetadata(precompile vm.PrecompiledContract) PrecompileMetadata {
+	if withMetadata, ok := precompile.(PrecompileWithMetadata); ok {
+		return PrecompileMetadata{
+			Name:    withMetadata.PrecompileName(),
+			Version: withMetadata.PrecompileVersion(),
+		}
+	}
+
+	if name, ok := ethereumPrecompilesNames[precompile.Address()]; ok {
+		return PrecompileMetadata{Name: name, Version: DefaultPrecompileVersion}
+	}
+
+	t := reflect.TypeOf(precompile)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	pkgPath := t.PkgPath()
+	name := pkgPath[strings.LastIndex(pkgPath, "/")+1:]
+
+	return PrecompileMetadata{Name: name, Version: DefaultPrecompileVersion}
+}
+
+// NewPrecompileInfo creates the registry entry of the given precompiled
+// contract. The ABI JSON is only set for the precompiled contracts that
+// implement the PrecompileWithABI interface.
+func NewPrecompileInfo(precompile vm.PrecompiledContract) (PrecompileInfo, error) {
+	metadata := GetPrecompileMetadata(precompile)
+
+	info := PrecompileInfo{
+		Name:    metadata.Name,
+		Address: precompile.Address().Hex(),
+		Version: metadata.Version,
+	}
+
+	withABI, ok := precompile.(PrecompileWithABI)
+	if !ok {
+		return info, nil
+	}
+
+	abiJSON, err := MarshalABI(withABI.GetABI())
+	if err != nil {
+		return PrecompileInfo{}, fmt.Errorf("failed to marshal the ABI of precompile %s: %w", info.Address, err)
+	}
+
+	info.Abi = string(abiJSON)
+	info.AbiHash = crypto.Keccak256Hash(abiJSON).Hex()
+	return info, nil
+}
+
+// MarshalABI encodes the functions and events of the given ABI into their
+// canonical JSON representation. The entries are sorted by type (events first)
+// and name and their keys are sorted alphabetically, so that the encoding does
+// not depend on the formatting of the source ABI file.
+func MarshalABI(contractABI abi.ABI) ([]byte, error) {
+	type abiEntry struct {
+		sortKey string
+		value   map[string]interface{}
+	}
+
+	entries := make([]abiEntry, 0, len(contractABI.Events)+len(contractABI.Methods))
+
+	// events are sorted before functions and overloaded functions by signature
+	for _, event := range contractABI.Events {
+		entries = append(entries, abiEntry{
+			sortKey: "0" + event.Sig,
+			value: map[string]interface{}{
+				"type":      "event",
+				"name":      event.RawName,
+				"anonymous": event.Anonymous,
+				"inputs":    marshalABIArguments(event.Inputs, true),
+			},
+		})
+	}
+
+	for _, method := range contractABI.Methods {
+		entries = append(entries, abiEntry{
+			sortKey: "1" + method.Sig,
+			value: map[string]interface{}{
+				"type":            "function",
+				"name":            method.RawName,
+				"stateMutability": method.StateMutability,
+				"inputs":          marshalABIArguments(method.Inputs, false),
+				"outputs":         marshalABIArguments(method.Outputs, false),
+			},
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].sortKey < entries[j].sortKey
+	})
+
+	values := make([]map[string]interface{}, len(entries))
+	for i, entry := range entries {
+		values[i] = entry.value
+	}
+
+	return json.Marshal(values)
+}
+
+// marshalABIArguments returns the JSON representation of the given ABI
+// arguments.
+func marshalABIArguments(args abi.Arguments, isEvent bool) []map[string]interface{} {
+	res := make([]map[string]interface{}, len(args))
+	for i, arg := range args {
+		res[i] = marshalABIType(arg.Name, arg.Type)
+		if isEvent {
+			res[i]["indexed"] = arg.Indexed
+		}
+	}
+	return res
+}
+
+// marshalABIType returns the JSON representation of a named ABI type,
+// including the components of tuples and arrays of tuples.
+func marshalABIType(name string, t abi.Type) map[string]interface{} {
+	res := map[string]interface{}{
+		"name": name,
+		"type": abiTypeName(t),
+	}
+
+	elem := t
+	for (elem.T == abi.SliceTy || elem.T == abi.ArrayTy) && elem.Elem != nil {
+		elem = *elem.Elem
+	}
+
+	if elem.T == abi.TupleTy {
+		components := make([]map[string]interface{}, len(elem.TupleElems))
+		for i, tupleElem := range elem.TupleElems {
+			components[i] = marshalABIType(elem.TupleRawNames[i], *tupleElem)
+		}
+		res["components"] = components
+	}
+
+	return res
+}
+
+// abiTypeName returns the name of the ABI type as used in the ABI JSON, where
+// tuples are not expanded.
+func abiTypeName(t abi.Type) string {
+	switch t.T {
+	case abi.TupleTy:
+		return "tuple"
+	case abi.SliceTy:
+		return abiTypeName(*t.Elem) + "[]"
+	case abi.ArrayTy:
+		return fmt.Sprintf("%s[%d]", abiTypeName(*t.Elem), t.Size)
+	default:
+		return t.String()
+	}
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const testABI = `[
+  {
+    "type": "function",
+    "name": "transfer",
+    "stateMutability": "nonpayable",
+    "inputs": [
+      {"name": "to", "type": "address"},
+      {
+        "name": "coins",
+        "type": "tuple[]",
+        "components": [
+          {"name": "denom", "type": "string"},
+          {"name": "amount", "type": "uint256"}
+        ]
+      }
+    ],
+    "outputs": [{"name": "success", "type": "bool"}]
+  },
+  {
+    "type": "event",
+    "name": "Transfer",
+    "anonymous": false,
+    "inputs": [
+      {"name": "from", "type": "address", "indexed": true},
+      {"name": "amounts", "type": "uint256[2]", "indexed": false}
+    ]
+  }
+]`
+
+type testPrecompile struct {
+	vm.PrecompiledContract
+	abi.ABI
+	address common.Address
+}
+
+func (p testPrecompile) Address() common.Address { return p.address }
+
+func (p testPrecompile) GetABI() abi.ABI { return p.ABI }
+
+type testPrecompileWithMetadata struct {
+	testPrecompile
+}
+
+func (testPrecompileWithMetadata) PrecompileName() string { return "bank" }
+
+func (testPrecompileWithMetadata) PrecompileVersion() string { return "v2" }
+
+func TestMarshalABI(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+
+	bz, err := MarshalABI(contractABI)
+	require.NoError(t, err)
+
+	var entries []map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &entries))
+	require.Len(t, entries, 2)
+	require.Equal(t, "event", entries[0]["type"])
+	require.Equal(t, "function", entries[1]["type"])
+	require.Contains(t, string(bz), `{"components":[{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],"name":"coins","type":"tuple[]"}`)
+	require.Contains(t, string(bz), `{"indexed":false,"name":"amounts","type":"uint256[2]"}`)
+
+	// the encoding is a valid ABI that encodes to the same JSON
+	decodedABI, err := abi.JSON(strings.NewReader(string(bz)))
+	require.NoError(t, err)
+	require.Equal(t, contractABI.Methods["transfer"].Sig, decodedABI.Methods["transfer"].Sig)
+	require.Equal(t, contractABI.Events["Transfer"].ID, decodedABI.Events["Transfer"].ID)
+
+	reencoded, err := MarshalABI(decodedABI)
+	require.NoError(t, err)
+	require.Equal(t, bz, reencoded)
+}
+
+func TestNewPrecompileInfo(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		precompile vm.PrecompiledContract
+		expName    string
+		expVersion string
+		expABI     bool
+	}{
+		{
+			"ethereum precompile without ABI",
+			vm.PrecompiledContractsBerlin[common.BytesToAddress([]byte{0x01})],
+			"ecrecover",
+			DefaultPrecompileVersion,
+			false,
+		},
+		{
+			"precompile reporting its metadata",
+			testPrecompileWithMetadata{testPrecompile{ABI: contractABI, address: common.HexToAddress("0x0000000000000000000000000000000000000804")}},
+			"bank",
+			"v2",
+			true,
+		},
+		{
+			"unknown precompile named after its package",
+			testPrecompile{ABI: contractABI, address: common.HexToAddress("0x1000000000000000000000000000000000000001")},
+			"types",
+			DefaultPrecompileVersion,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			info, err := NewPrecompileInfo(tc.precompile)
+			require.NoError(t, err)
+			require.Equal(t, tc.expName, info.Name)
+			require.Equal(t, tc.precompile.Address().Hex(), info.Address)
+			require.Equal(t, tc.expVersion, info.Version)
+
+			if !tc.expABI {
+				require.Empty(t, info.Abi)
+				require.Empty(t, info.AbiHash)
+				return
+			}
+
+			require.Equal(t, crypto.Keccak256Hash([]byte(info.Abi)).Hex(), info.AbiHash)
+		})
+	}
+}
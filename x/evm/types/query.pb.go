@@ -1303,6 +1303,101 @@ func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
 
+// QueryPrecompileRegistryRequest defines the request type for querying the
+// registry of the precompiled contracts.
+type QueryPrecompileRegistryRequest struct {
+	// active_only defines if only the precompiled contracts enabled in the EVM
+	// parameters are returned
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+}
+
+func (m *QueryPrecompileRegistryRequest) Reset()         { *m = QueryPrecompileRegistryRequest{} }
+func (m *QueryPrecompileRegistryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrecompileRegistryRequest) ProtoMessage()    {}
+func (*QueryPrecompileRegistryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{24}
+}
+func (m *QueryPrecompileRegistryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrecompileRegistryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrecompileRegistryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrecompileRegistryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrecompileRegistryRequest.Merge(m, src)
+}
+func (m *QueryPrecompileRegistryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrecompileRegistryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrecompileRegistryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrecompileRegistryRequest proto.InternalMessageInfo
+
+func (m *QueryPrecompileRegistryRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
+	}
+	return false
+}
+
+// QueryPrecompileRegistryResponse defines the response type for querying the
+// registry of the precompiled contracts.
+type QueryPrecompileRegistryResponse struct {
+	// precompiles is the list of precompiled contracts sorted by address
+	Precompiles []PrecompileInfo `protobuf:"bytes,1,rep,name=precompiles,proto3" json:"precompiles"`
+}
+
+func (m *QueryPrecompileRegistryResponse) Reset()         { *m = QueryPrecompileRegistryResponse{} }
+func (m *QueryPrecompileRegistryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrecompileRegistryResponse) ProtoMessage()    {}
+func (*QueryPrecompileRegistryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{25}
+}
+func (m *QueryPrecompileRegistryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrecompileRegistryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrecompileRegistryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrecompileRegistryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrecompileRegistryResponse.Merge(m, src)
+}
+func (m *QueryPrecompileRegistryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrecompileRegistryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrecompileRegistryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrecompileRegistryResponse proto.InternalMessageInfo
+
+func (m *QueryPrecompileRegistryResponse) GetPrecompiles() []PrecompileInfo {
+	if m != nil {
+		return m.Precompiles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
@@ -1328,104 +1423,111 @@ func init() {
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryPrecompileRegistryRequest)(nil), "ethermint.evm.v1.QueryPrecompileRegistryRequest")
+	proto.RegisterType((*QueryPrecompileRegistryResponse)(nil), "ethermint.evm.v1.QueryPrecompileRegistryResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0xf0, 0x77, 0x08, 0xe0, 0x2c, 0x89, 0x1d, 0xf6, 0xdb,
-	0xc4, 0x86, 0xc2, 0x2e, 0x49, 0xab, 0x48, 0xed, 0xa5, 0x60, 0x0b, 0x28, 0x05, 0x2a, 0xea, 0x46,
-	0x3d, 0x54, 0xaa, 0xac, 0xf1, 0x7a, 0x58, 0x5b, 0xf1, 0xee, 0x98, 0x9d, 0xb1, 0xe5, 0x80, 0x38,
-	0x14, 0xa1, 0xfe, 0x50, 0x2f, 0x48, 0xbd, 0xf5, 0xc4, 0xbd, 0xb7, 0x5e, 0x7a, 0xec, 0x95, 0x23,
-	0x52, 0x2f, 0x55, 0x0f, 0xb4, 0x22, 0x3d, 0xf4, 0x6f, 0xe8, 0xa9, 0x9a, 0x1f, 0x6b, 0xef, 0xda,
-	0xde, 0x38, 0x54, 0xf4, 0xd6, 0xd3, 0xee, 0xcc, 0xbc, 0x79, 0x9f, 0xcf, 0xbc, 0xf7, 0x66, 0xde,
-	0x07, 0xac, 0x62, 0xd6, 0xc4, 0xbe, 0xdb, 0xf2, 0x98, 0x85, 0x7b, 0xae, 0xd5, 0xdb, 0xb2, 0xee,
-	0x75, 0xb1, 0xbf, 0x6f, 0x76, 0x7c, 0xc2, 0x08, 0xcc, 0x0e, 0x56, 0x4d, 0xdc, 0x73, 0xcd, 0xde,
-	0x96, 0x7e, 0xde, 0x26, 0xd4, 0x25, 0xd4, 0xaa, 0x23, 0x8a, 0xa5, 0xa9, 0xd5, 0xdb, 0xaa, 0x63,
-	0x86, 0xb6, 0xac, 0x0e, 0x72, 0x5a, 0x1e, 0x62, 0x2d, 0xe2, 0xc9, 0xdd, 0xba, 0x3e, 0xe6, 0x9b,
-	0x3b, 0x91, 0x6b, 0x2b, 0x63, 0x6b, 0xac, 0xaf, 0x96, 0x96, 0x1d, 0xe2, 0x10, 0xf1, 0x6b, 0xf1,
-	0x3f, 0x35, 0xbb, 0xea, 0x10, 0xe2, 0xb4, 0xb1, 0x85, 0x3a, 0x2d, 0x0b, 0x79, 0x1e, 0x61, 0x02,
-	0x89, 0xaa, 0xd5, 0x82, 0x5a, 0x15, 0xa3, 0x7a, 0xf7, 0xae, 0xc5, 0x5a, 0x2e, 0xa6, 0x0c, 0xb9,
-	0x1d, 0x69, 0x60, 0xbc, 0x03, 0x4e, 0x7c, 0xc4, 0xd9, 0x5e, 0xb1, 0x6d, 0xd2, 0xf5, 0x58, 0x15,
-	0xdf, 0xeb, 0x62, 0xca, 0x60, 0x0e, 0xa4, 0x50, 0xa3, 0xe1, 0x63, 0x4a, 0x73, 0xda, 0xba, 0x56,
-	0x5a, 0xa8, 0x06, 0xc3, 0x77, 0xd3, 0x5f, 0x3d, 0x2d, 0xcc, 0xfc, 0xf9, 0xb4, 0x30, 0x63, 0xd8,
-	0x60, 0x39, 0xba, 0x95, 0x76, 0x88, 0x47, 0x31, 0xdf, 0x5b, 0x47, 0x6d, 0xe4, 0xd9, 0x38, 0xd8,
-	0xab, 0x86, 0xf0, 0x0c, 0x58, 0xb0, 0x49, 0x03, 0xd7, 0x9a, 0x88, 0x36, 0x73, 0xb3, 0x62, 0x2d,
-	0xcd, 0x27, 0xde, 0x47, 0xb4, 0x09, 0x97, 0xc1, 0x9c, 0x47, 0xf8, 0xa6, 0xc4, 0xba, 0x56, 0x4a,
-	0x56, 0xe5, 0xc0, 0x78, 0x0f, 0xac, 0x08, 0x90, 0x8a, 0x08, 0xef, 0x3f, 0x60, 0xf9, 0x85, 0x06,
-	0xf4, 0x49, 0x1e, 0x14, 0xd9, 0x0d, 0x70, 0x4c, 0x66, 0xae, 0x16, 0xf5, 0xb4, 0x24, 0x67, 0xaf,
-	0xc8, 0x49, 0xa8, 0x83, 0x34, 0xe5, 0xa0, 0x9c, 0xdf, 0xac, 0xe0, 0x37, 0x18, 0x73, 0x17, 0x48,
-	0x7a, 0xad, 0x79, 0x5d, 0xb7, 0x8e, 0x7d, 0x75, 0x82, 0x25, 0x35, 0xfb, 0xa1, 0x98, 0x34, 0x6e,
-	0x82, 0x55, 0xc1, 0xe3, 0x13, 0xd4, 0x6e, 0x35, 0x10, 0x23, 0xfe, 0xc8, 0x61, 0xce, 0x82, 0x45,
-	0x9b, 0x78, 0xa3, 0x3c, 0x32, 0x7c, 0xee, 0xca, 0xd8, 0xa9, 0xbe, 0xd1, 0xc0, 0x5a, 0x8c, 0x37,
-	0x75, 0xb0, 0x22, 0x38, 0x1e, 0xb0, 0x8a, 0x7a, 0x0c, 0xc8, 0xbe, 0xc6, 0xa3, 0x05, 0x45, 0x54,
-	0x96, 0x79, 0x7e, 0x95, 0xf4, 0x5c, 0x52, 0x45, 0x34, 0xd8, 0x3a, 0xad, 0x88, 0x8c, 0x9b, 0x0a,
-	0xec, 0x63, 0x46, 0x7c, 0xe4, 0x4c, 0x07, 0x83, 0x59, 0x90, 0xd8, 0xc3, 0xfb, 0xaa, 0xde, 0xf8,
-	0x6f, 0x08, 0xfe, 0x82, 0x82, 0x1f, 0x38, 0x53, 0xf0, 0xcb, 0x60, 0xae, 0x87, 0xda, 0xdd, 0x00,
-	0x5c, 0x0e, 0x8c, 0x1d, 0x90, 0x55, 0xa5, 0xd4, 0x78, 0xa5, 0x43, 0x16, 0xc1, 0xff, 0x42, 0xfb,
-	0x14, 0x04, 0x04, 0x49, 0x5e, 0xfb, 0x62, 0xd7, 0x62, 0x55, 0xfc, 0x1b, 0xf7, 0x01, 0x14, 0x86,
-	0xbb, 0xfd, 0x5b, 0xc4, 0xa1, 0x01, 0x04, 0x04, 0x49, 0x71, 0x63, 0xa4, 0x7f, 0xf1, 0x0f, 0xaf,
-	0x01, 0x30, 0x7c, 0x57, 0xc4, 0xd9, 0x32, 0xdb, 0x9b, 0xa6, 0x2c, 0x5a, 0x93, 0x3f, 0x42, 0xa6,
-	0x7c, 0xaf, 0xd4, 0x23, 0x64, 0xde, 0x19, 0x86, 0xaa, 0x1a, 0xda, 0x19, 0x22, 0xf9, 0xb5, 0xa6,
-	0x02, 0x1b, 0x80, 0x2b, 0x9e, 0xe7, 0x40, 0xb2, 0x4d, 0x1c, 0x7e, 0xba, 0x44, 0x29, 0xb3, 0x7d,
-	0xd2, 0x1c, 0x7d, 0xfa, 0xcc, 0x5b, 0xc4, 0xa9, 0x0a, 0x13, 0x78, 0x7d, 0x02, 0xa9, 0xe2, 0x54,
-	0x52, 0x12, 0x27, 0xcc, 0xca, 0x58, 0x56, 0x71, 0xb8, 0x83, 0x7c, 0xe4, 0x06, 0x71, 0x30, 0x6e,
-	0x2b, 0x82, 0xc1, 0xac, 0x22, 0xb8, 0x03, 0xe6, 0x3b, 0x62, 0x46, 0x04, 0x28, 0xb3, 0x9d, 0x1b,
-	0xa7, 0x28, 0x77, 0x94, 0x93, 0xcf, 0x5e, 0x14, 0x66, 0xaa, 0xca, 0xda, 0xf8, 0x51, 0x03, 0xc7,
-	0xae, 0xb2, 0x66, 0x05, 0xb5, 0xdb, 0xa1, 0x48, 0x23, 0xdf, 0xa1, 0x41, 0x4e, 0xf8, 0x3f, 0x3c,
-	0x0d, 0x52, 0x0e, 0xa2, 0x35, 0x1b, 0x75, 0xd4, 0xf5, 0x98, 0x77, 0x10, 0xad, 0xa0, 0x0e, 0xfc,
-	0x0c, 0x64, 0x3b, 0x3e, 0xe9, 0x10, 0x8a, 0xfd, 0xc1, 0x15, 0xe3, 0xd7, 0x63, 0xb1, 0xbc, 0xfd,
-	0xd7, 0x8b, 0x82, 0xe9, 0xb4, 0x58, 0xb3, 0x5b, 0x37, 0x6d, 0xe2, 0x5a, 0xaa, 0x37, 0xc8, 0xcf,
-	0x45, 0xda, 0xd8, 0xb3, 0xd8, 0x7e, 0x07, 0x53, 0xb3, 0x32, 0xbc, 0xdb, 0xd5, 0xe3, 0x81, 0xaf,
-	0xe0, 0x5e, 0xae, 0x80, 0xb4, 0xdd, 0x44, 0x2d, 0xaf, 0xd6, 0x6a, 0xe4, 0x92, 0xeb, 0x5a, 0x29,
-	0x51, 0x4d, 0x89, 0xf1, 0x8d, 0x86, 0x51, 0x04, 0x27, 0xae, 0x52, 0xd6, 0x72, 0x11, 0xc3, 0xd7,
-	0xd1, 0x30, 0x10, 0x59, 0x90, 0x70, 0x90, 0x24, 0x9f, 0xac, 0xf2, 0x5f, 0xe3, 0x71, 0x32, 0xc8,
-	0xa9, 0x8f, 0x6c, 0xbc, 0xdb, 0x0f, 0xce, 0xb9, 0x05, 0x12, 0x2e, 0x75, 0x54, 0xbc, 0x0a, 0xe3,
-	0xf1, 0xba, 0x4d, 0x9d, 0xab, 0x7c, 0x0e, 0x77, 0xdd, 0xdd, 0x7e, 0x95, 0xdb, 0xc2, 0xcb, 0x60,
-	0x91, 0x71, 0x27, 0x35, 0x9b, 0x78, 0x77, 0x5b, 0x8e, 0x38, 0x69, 0x66, 0x7b, 0x6d, 0x7c, 0xaf,
-	0x80, 0xaa, 0x08, 0xa3, 0x6a, 0x86, 0x0d, 0x07, 0xb0, 0x02, 0x16, 0x3b, 0x3e, 0x6e, 0x60, 0x1b,
-	0x53, 0x4a, 0x7c, 0x9a, 0x4b, 0x8a, 0x82, 0x9a, 0x8a, 0x1e, 0xd9, 0xc4, 0x5f, 0xc9, 0x7a, 0x9b,
-	0xd8, 0x7b, 0xc1, 0x7b, 0x34, 0x27, 0x22, 0x93, 0x11, 0x73, 0xf2, 0x35, 0x82, 0x6b, 0x00, 0x48,
-	0x13, 0x71, 0x69, 0xe6, 0xc5, 0xa5, 0x59, 0x10, 0x33, 0xa2, 0xcf, 0x54, 0x82, 0x65, 0xde, 0x0a,
-	0x73, 0x29, 0x71, 0x0c, 0xdd, 0x94, 0x7d, 0xd2, 0x0c, 0xfa, 0xa4, 0xb9, 0x1b, 0xf4, 0xc9, 0x72,
-	0x9a, 0x17, 0xcd, 0x93, 0xdf, 0x0a, 0x9a, 0x72, 0xc2, 0x57, 0x26, 0xe6, 0x3e, 0xfd, 0xef, 0xe4,
-	0x7e, 0x21, 0x92, 0x7b, 0x68, 0x80, 0x25, 0x49, 0xdf, 0x45, 0xfd, 0x1a, 0x4f, 0x37, 0x08, 0x45,
-	0xe0, 0x36, 0xea, 0x5f, 0x47, 0xf4, 0x83, 0x64, 0x7a, 0x36, 0x9b, 0xa8, 0xa6, 0x59, 0xbf, 0xd6,
-	0xf2, 0x1a, 0xb8, 0x6f, 0x9c, 0x57, 0xaf, 0xdc, 0xa0, 0x0a, 0x86, 0x4f, 0x50, 0x03, 0x31, 0x14,
-	0x94, 0x3b, 0xff, 0x37, 0x7e, 0x48, 0x80, 0x53, 0x43, 0xe3, 0x32, 0xf7, 0x1a, 0xaa, 0x1a, 0xd6,
-	0x0f, 0x1e, 0x82, 0xe9, 0x55, 0xc3, 0xfa, 0xf4, 0x35, 0x54, 0xcd, 0x7f, 0x09, 0x9f, 0x9e, 0x70,
-	0xe3, 0x22, 0x38, 0x3d, 0x96, 0xb3, 0x43, 0x72, 0x7c, 0x72, 0xd0, 0xaf, 0x29, 0xbe, 0x86, 0x83,
-	0xbe, 0x60, 0xdc, 0x1a, 0xf4, 0x62, 0x35, 0xad, 0x5c, 0xbc, 0x0d, 0xd2, 0xfc, 0xf1, 0xae, 0xdd,
-	0xc5, 0xaa, 0x1f, 0x96, 0x57, 0x7e, 0x7d, 0x51, 0x38, 0x29, 0x4f, 0x48, 0x1b, 0x7b, 0x66, 0x8b,
-	0x58, 0x2e, 0x62, 0x4d, 0xf3, 0x86, 0xc7, 0x78, 0x9f, 0x16, 0xbb, 0xb7, 0x7f, 0x5a, 0x04, 0x73,
-	0xc2, 0x1d, 0xfc, 0x5c, 0x03, 0x29, 0x25, 0x4f, 0xe0, 0xc6, 0x78, 0xea, 0x27, 0xe8, 0x4f, 0x7d,
-	0x73, 0x9a, 0x99, 0xa4, 0x66, 0x14, 0x1f, 0xfd, 0xfc, 0xc7, 0xb7, 0xb3, 0x67, 0x61, 0x81, 0xab,
-	0x65, 0x42, 0x03, 0xcd, 0xac, 0xe4, 0x89, 0xf5, 0x40, 0xa5, 0xea, 0x21, 0xfc, 0x4e, 0x03, 0x4b,
-	0x11, 0x05, 0x08, 0xdf, 0x8c, 0x81, 0x98, 0xa4, 0x34, 0xf5, 0x0b, 0x47, 0x33, 0x56, 0xac, 0x4c,
-	0xc1, 0xaa, 0x04, 0x37, 0xa3, 0xac, 0x02, 0xa1, 0x39, 0x46, 0xee, 0x7b, 0x0d, 0x64, 0x47, 0x85,
-	0x1c, 0x34, 0x63, 0x20, 0x63, 0xf4, 0xa3, 0x6e, 0x1d, 0xd9, 0x5e, 0xb1, 0xdc, 0x11, 0x2c, 0x2f,
-	0x41, 0x33, 0xca, 0xb2, 0x17, 0xd8, 0x0f, 0x89, 0x86, 0x75, 0xe9, 0x43, 0xf8, 0x48, 0x03, 0x29,
-	0x25, 0xd7, 0x62, 0xd3, 0x19, 0x55, 0x82, 0xb1, 0xe9, 0x1c, 0x51, 0x7d, 0x46, 0x49, 0x50, 0x32,
-	0xe0, 0x7a, 0x94, 0x92, 0x92, 0x7e, 0x34, 0x14, 0xb2, 0x2f, 0x35, 0x90, 0x52, 0xa2, 0x2d, 0x96,
-	0x44, 0x54, 0x21, 0xc6, 0x92, 0x18, 0xd1, 0x7e, 0xc6, 0x45, 0x41, 0xa2, 0x08, 0x37, 0xa2, 0x24,
-	0xa8, 0x34, 0x1b, 0x72, 0xb0, 0x1e, 0xec, 0xe1, 0xfd, 0x87, 0xb0, 0x07, 0x92, 0x5c, 0xd7, 0x41,
-	0x23, 0xb6, 0x44, 0x06, 0x62, 0x51, 0xff, 0xff, 0xa1, 0x36, 0x0a, 0x7f, 0x43, 0xe0, 0x17, 0xe0,
-	0xda, 0x68, 0xf5, 0x34, 0x22, 0x11, 0xa0, 0x60, 0x5e, 0xca, 0x1a, 0xf8, 0x46, 0x8c, 0xd7, 0x88,
-	0x7a, 0xd2, 0x37, 0xa6, 0x58, 0x29, 0xf4, 0x55, 0x81, 0x7e, 0x0a, 0x2e, 0x47, 0xd1, 0xa5, 0x66,
-	0x82, 0x0c, 0xa4, 0x94, 0x64, 0x82, 0xeb, 0xe3, 0xfe, 0xa2, 0x6a, 0x4a, 0x2f, 0x4e, 0x6b, 0x11,
-	0x01, 0x66, 0x5e, 0x60, 0xe6, 0xe0, 0xa9, 0x28, 0x26, 0x66, 0xcd, 0x9a, 0xcd, 0xa1, 0xee, 0x83,
-	0x4c, 0x48, 0xef, 0x1c, 0x01, 0x79, 0xc2, 0x59, 0x27, 0x08, 0x26, 0xc3, 0x10, 0xb8, 0xab, 0x50,
-	0x1f, 0xc1, 0x55, 0xa6, 0xfc, 0xb5, 0x85, 0x7d, 0x90, 0x52, 0x6d, 0x33, 0xb6, 0xce, 0xa2, 0xe2,
-	0x2a, 0xb6, 0xce, 0x46, 0xba, 0x6f, 0xdc, 0xa9, 0x65, 0xbf, 0x64, 0x7d, 0xf8, 0x58, 0x03, 0x60,
-	0xf8, 0xa0, 0xc3, 0xd2, 0x61, 0x6e, 0xc3, 0x7d, 0x5a, 0x3f, 0x77, 0x04, 0x4b, 0xc5, 0xe1, 0xac,
-	0xe0, 0x70, 0x06, 0xae, 0x4c, 0xe2, 0x20, 0x3a, 0x0c, 0x0f, 0x80, 0x6a, 0x08, 0x87, 0xdc, 0xf6,
-	0x70, 0x1f, 0x39, 0xe4, 0xb6, 0x47, 0xfa, 0x4a, 0x5c, 0x00, 0x82, 0x5e, 0x53, 0xbe, 0xfc, 0xec,
-	0x65, 0x5e, 0x7b, 0xfe, 0x32, 0xaf, 0xfd, 0xfe, 0x32, 0xaf, 0x3d, 0x39, 0xc8, 0xcf, 0x3c, 0x3f,
-	0xc8, 0xcf, 0xfc, 0x72, 0x90, 0x9f, 0xf9, 0x74, 0x33, 0xd4, 0x6f, 0x07, 0x7b, 0x09, 0xb5, 0x7a,
-	0x5b, 0x3b, 0x56, 0x5f, 0xf8, 0x11, 0x3d, 0xb7, 0x3e, 0x2f, 0xda, 0xfb, 0x5b, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0xc6, 0xc7, 0x39, 0x16, 0xce, 0x11, 0x00, 0x00,
+	// 1551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x3c, 0x27, 0x6d, 0xbe, 0x13, 0xb7, 0x75, 0xb6, 0x89, 0x9d, 0xee,
+	0x97, 0x38, 0x69, 0x69, 0x77, 0x9b, 0x80, 0x22, 0xc1, 0x85, 0x26, 0x51, 0x7f, 0xd1, 0x16, 0x8a,
+	0x89, 0x38, 0x20, 0x21, 0x6b, 0xbc, 0x9e, 0xac, 0x57, 0xb1, 0x77, 0xdc, 0x9d, 0xb1, 0xe5, 0xb4,
+	0xea, 0x81, 0xaa, 0xe2, 0x87, 0xb8, 0x54, 0xe2, 0x86, 0x38, 0xf4, 0xce, 0x8d, 0x0b, 0xff, 0x42,
+	0x8f, 0x95, 0xb8, 0x20, 0x0e, 0x01, 0xb5, 0x1c, 0xf8, 0x1b, 0x38, 0xa1, 0x99, 0x9d, 0xb5, 0x77,
+	0x63, 0x3b, 0x4e, 0x51, 0xb9, 0x71, 0xda, 0x9d, 0x99, 0xf7, 0xde, 0xe7, 0x33, 0x6f, 0xde, 0xcc,
+	0xfb, 0xc0, 0x02, 0xe1, 0x35, 0xe2, 0x37, 0x5c, 0x8f, 0x5b, 0xa4, 0xdd, 0xb0, 0xda, 0x6b, 0xd6,
+	0xbd, 0x16, 0xf1, 0xf7, 0xcd, 0xa6, 0x4f, 0x39, 0x45, 0xb3, 0xdd, 0x55, 0x93, 0xb4, 0x1b, 0x66,
+	0x7b, 0x4d, 0xbf, 0x60, 0x53, 0xd6, 0xa0, 0xcc, 0xaa, 0x60, 0x46, 0x02, 0x53, 0xab, 0xbd, 0x56,
+	0x21, 0x1c, 0xaf, 0x59, 0x4d, 0xec, 0xb8, 0x1e, 0xe6, 0x2e, 0xf5, 0x02, 0x6f, 0x5d, 0xef, 0x8b,
+	0x2d, 0x82, 0x04, 0x6b, 0xf3, 0x7d, 0x6b, 0xbc, 0xa3, 0x96, 0xb2, 0x0e, 0x75, 0xa8, 0xfc, 0xb5,
+	0xc4, 0x9f, 0x9a, 0x5d, 0x70, 0x28, 0x75, 0xea, 0xc4, 0xc2, 0x4d, 0xd7, 0xc2, 0x9e, 0x47, 0xb9,
+	0x44, 0x62, 0x6a, 0xb5, 0xa0, 0x56, 0xe5, 0xa8, 0xd2, 0xda, 0xb5, 0xb8, 0xdb, 0x20, 0x8c, 0xe3,
+	0x46, 0x33, 0x30, 0x30, 0xde, 0x81, 0xb9, 0x8f, 0x04, 0xdb, 0x4d, 0xdb, 0xa6, 0x2d, 0x8f, 0x97,
+	0xc8, 0xbd, 0x16, 0x61, 0x1c, 0xe5, 0x20, 0x85, 0xab, 0x55, 0x9f, 0x30, 0x96, 0xd3, 0x96, 0xb4,
+	0xd5, 0xa9, 0x52, 0x38, 0x7c, 0x37, 0xfd, 0xd5, 0xd3, 0xc2, 0xd8, 0x9f, 0x4f, 0x0b, 0x63, 0x86,
+	0x0d, 0xd9, 0xb8, 0x2b, 0x6b, 0x52, 0x8f, 0x11, 0xe1, 0x5b, 0xc1, 0x75, 0xec, 0xd9, 0x24, 0xf4,
+	0x55, 0x43, 0x74, 0x16, 0xa6, 0x6c, 0x5a, 0x25, 0xe5, 0x1a, 0x66, 0xb5, 0xdc, 0xb8, 0x5c, 0x4b,
+	0x8b, 0x89, 0x1b, 0x98, 0xd5, 0x50, 0x16, 0x26, 0x3c, 0x2a, 0x9c, 0x12, 0x4b, 0xda, 0x6a, 0xb2,
+	0x14, 0x0c, 0x8c, 0xf7, 0x60, 0x5e, 0x82, 0x6c, 0xcb, 0xf4, 0xfe, 0x03, 0x96, 0x5f, 0x68, 0xa0,
+	0x0f, 0x8a, 0xa0, 0xc8, 0x2e, 0xc3, 0x89, 0xe0, 0xe4, 0xca, 0xf1, 0x48, 0x33, 0xc1, 0xec, 0x66,
+	0x30, 0x89, 0x74, 0x48, 0x33, 0x01, 0x2a, 0xf8, 0x8d, 0x4b, 0x7e, 0xdd, 0xb1, 0x08, 0x81, 0x83,
+	0xa8, 0x65, 0xaf, 0xd5, 0xa8, 0x10, 0x5f, 0xed, 0x60, 0x46, 0xcd, 0x7e, 0x20, 0x27, 0x8d, 0x5b,
+	0xb0, 0x20, 0x79, 0x7c, 0x82, 0xeb, 0x6e, 0x15, 0x73, 0xea, 0x1f, 0xda, 0xcc, 0x39, 0x98, 0xb6,
+	0xa9, 0x77, 0x98, 0x47, 0x46, 0xcc, 0x6d, 0xf6, 0xed, 0xea, 0x1b, 0x0d, 0x16, 0x87, 0x44, 0x53,
+	0x1b, 0x5b, 0x81, 0x93, 0x21, 0xab, 0x78, 0xc4, 0x90, 0xec, 0x6b, 0xdc, 0x5a, 0x58, 0x44, 0x5b,
+	0xc1, 0x39, 0xbf, 0xca, 0xf1, 0x5c, 0x86, 0x6c, 0xdc, 0x75, 0x54, 0x11, 0x19, 0xb7, 0x14, 0xd8,
+	0xc7, 0x9c, 0xfa, 0xd8, 0x19, 0x0d, 0x86, 0x66, 0x21, 0xb1, 0x47, 0xf6, 0x55, 0xbd, 0x89, 0xdf,
+	0x08, 0xfc, 0x45, 0xc8, 0xc6, 0x83, 0x29, 0xf8, 0x2c, 0x4c, 0xb4, 0x71, 0xbd, 0x15, 0x82, 0x07,
+	0x03, 0x63, 0x03, 0x66, 0x55, 0x29, 0x55, 0x5f, 0x69, 0x93, 0x2b, 0xf0, 0xbf, 0x88, 0x9f, 0x82,
+	0x40, 0x90, 0x14, 0xb5, 0x2f, 0xbd, 0xa6, 0x4b, 0xf2, 0xdf, 0xb8, 0x0f, 0x48, 0x1a, 0xee, 0x74,
+	0x6e, 0x53, 0x87, 0x85, 0x10, 0x08, 0x92, 0xf2, 0xc6, 0x04, 0xf1, 0xe5, 0x3f, 0xba, 0x06, 0xd0,
+	0x7b, 0x57, 0xe4, 0xde, 0x32, 0xeb, 0x45, 0x33, 0x28, 0x5a, 0x53, 0x3c, 0x42, 0x66, 0xf0, 0x5e,
+	0xa9, 0x47, 0xc8, 0xbc, 0xdb, 0x4b, 0x55, 0x29, 0xe2, 0x19, 0x21, 0xf9, 0xb5, 0x06, 0x73, 0x31,
+	0x70, 0xc5, 0xf3, 0x3c, 0x24, 0xeb, 0xd4, 0x11, 0xbb, 0x4b, 0xac, 0x66, 0xd6, 0x4f, 0x99, 0x87,
+	0x9f, 0x3e, 0xf3, 0x36, 0x75, 0x4a, 0xd2, 0x04, 0x5d, 0x1f, 0x40, 0x6a, 0x65, 0x24, 0xa9, 0x00,
+	0x27, 0xca, 0xca, 0xc8, 0xaa, 0x3c, 0xdc, 0xc5, 0x3e, 0x6e, 0x84, 0x79, 0x30, 0xee, 0xc0, 0x5c,
+	0x6c, 0x56, 0x11, 0xdc, 0x80, 0xc9, 0xa6, 0x9c, 0x91, 0x09, 0xca, 0xac, 0xe7, 0xfa, 0x29, 0x06,
+	0x1e, 0x5b, 0xc9, 0x67, 0x07, 0x85, 0xb1, 0x92, 0xb2, 0x36, 0x7e, 0xd2, 0xe0, 0xc4, 0x55, 0x5e,
+	0xdb, 0xc6, 0xf5, 0x7a, 0x24, 0xd3, 0xd8, 0x77, 0x58, 0x78, 0x26, 0xe2, 0x1f, 0x9d, 0x81, 0x94,
+	0x83, 0x59, 0xd9, 0xc6, 0x4d, 0x75, 0x3d, 0x26, 0x1d, 0xcc, 0xb6, 0x71, 0x13, 0x7d, 0x06, 0xb3,
+	0x4d, 0x9f, 0x36, 0x29, 0x23, 0x7e, 0xf7, 0x8a, 0x89, 0xeb, 0x31, 0xbd, 0xb5, 0xfe, 0xd7, 0x41,
+	0xc1, 0x74, 0x5c, 0x5e, 0x6b, 0x55, 0x4c, 0x9b, 0x36, 0x2c, 0xd5, 0x1b, 0x82, 0xcf, 0x25, 0x56,
+	0xdd, 0xb3, 0xf8, 0x7e, 0x93, 0x30, 0x73, 0xbb, 0x77, 0xb7, 0x4b, 0x27, 0xc3, 0x58, 0xe1, 0xbd,
+	0x9c, 0x87, 0xb4, 0x5d, 0xc3, 0xae, 0x57, 0x76, 0xab, 0xb9, 0xe4, 0x92, 0xb6, 0x9a, 0x28, 0xa5,
+	0xe4, 0xf8, 0x66, 0xd5, 0x58, 0x81, 0xb9, 0xab, 0x8c, 0xbb, 0x0d, 0xcc, 0xc9, 0x75, 0xdc, 0x4b,
+	0xc4, 0x2c, 0x24, 0x1c, 0x1c, 0x90, 0x4f, 0x96, 0xc4, 0xaf, 0xf1, 0x38, 0x19, 0x9e, 0xa9, 0x8f,
+	0x6d, 0xb2, 0xd3, 0x09, 0xf7, 0xb9, 0x06, 0x89, 0x06, 0x73, 0x54, 0xbe, 0x0a, 0xfd, 0xf9, 0xba,
+	0xc3, 0x9c, 0xab, 0x62, 0x8e, 0xb4, 0x1a, 0x3b, 0x9d, 0x92, 0xb0, 0x45, 0x57, 0x60, 0x9a, 0x8b,
+	0x20, 0x65, 0x9b, 0x7a, 0xbb, 0xae, 0x23, 0x77, 0x9a, 0x59, 0x5f, 0xec, 0xf7, 0x95, 0x50, 0xdb,
+	0xd2, 0xa8, 0x94, 0xe1, 0xbd, 0x01, 0xda, 0x86, 0xe9, 0xa6, 0x4f, 0xaa, 0xc4, 0x26, 0x8c, 0x51,
+	0x9f, 0xe5, 0x92, 0x4b, 0x89, 0xe3, 0xa0, 0xc7, 0x9c, 0xc4, 0x2b, 0x59, 0xa9, 0x53, 0x7b, 0x2f,
+	0x7c, 0x8f, 0x26, 0x64, 0x66, 0x32, 0x72, 0x2e, 0x78, 0x8d, 0xd0, 0x22, 0x40, 0x60, 0x22, 0x2f,
+	0xcd, 0xa4, 0xbc, 0x34, 0x53, 0x72, 0x46, 0xf6, 0x99, 0xed, 0x70, 0x59, 0xb4, 0xc2, 0x5c, 0x4a,
+	0x6e, 0x43, 0x37, 0x83, 0x3e, 0x69, 0x86, 0x7d, 0xd2, 0xdc, 0x09, 0xfb, 0xe4, 0x56, 0x5a, 0x14,
+	0xcd, 0x93, 0xdf, 0x0a, 0x9a, 0x0a, 0x22, 0x56, 0x06, 0x9e, 0x7d, 0xfa, 0xdf, 0x39, 0xfb, 0xa9,
+	0xd8, 0xd9, 0x23, 0x03, 0x66, 0x02, 0xfa, 0x0d, 0xdc, 0x29, 0x8b, 0xe3, 0x86, 0x48, 0x06, 0xee,
+	0xe0, 0xce, 0x75, 0xcc, 0xde, 0x4f, 0xa6, 0xc7, 0x67, 0x13, 0xa5, 0x34, 0xef, 0x94, 0x5d, 0xaf,
+	0x4a, 0x3a, 0xc6, 0x05, 0xf5, 0xca, 0x75, 0xab, 0xa0, 0xf7, 0x04, 0x55, 0x31, 0xc7, 0x61, 0xb9,
+	0x8b, 0x7f, 0xe3, 0xc7, 0x04, 0x9c, 0xee, 0x19, 0x6f, 0x89, 0xa8, 0x91, 0xaa, 0xe1, 0x9d, 0xf0,
+	0x21, 0x18, 0x5d, 0x35, 0xbc, 0xc3, 0x5e, 0x43, 0xd5, 0xfc, 0x77, 0xe0, 0xa3, 0x0f, 0xdc, 0xb8,
+	0x04, 0x67, 0xfa, 0xce, 0xec, 0x88, 0x33, 0x3e, 0xd5, 0xed, 0xd7, 0x8c, 0x5c, 0x23, 0x61, 0x5f,
+	0x30, 0x6e, 0x43, 0x36, 0x3e, 0xad, 0x42, 0xbc, 0x0d, 0x69, 0xf1, 0x78, 0x97, 0x77, 0x89, 0xea,
+	0x87, 0x5b, 0xf3, 0xbf, 0x1e, 0x14, 0x4e, 0x05, 0x3b, 0x64, 0xd5, 0x3d, 0xd3, 0xa5, 0x56, 0x03,
+	0xf3, 0x9a, 0x79, 0xd3, 0xe3, 0xa2, 0x4f, 0x4b, 0x6f, 0x63, 0x13, 0xf2, 0xc1, 0x6b, 0xed, 0x13,
+	0x9b, 0x36, 0x9a, 0x6e, 0x9d, 0x94, 0x88, 0xe3, 0x32, 0xee, 0xef, 0x87, 0xf5, 0x54, 0x80, 0x0c,
+	0xb6, 0xb9, 0xdb, 0x26, 0x65, 0xea, 0xd5, 0xf7, 0x65, 0xe8, 0x74, 0x09, 0x82, 0xa9, 0x0f, 0xbd,
+	0xfa, 0xbe, 0xb1, 0x07, 0x85, 0xa1, 0x21, 0x14, 0xb7, 0x1b, 0x90, 0x69, 0x76, 0x57, 0xc3, 0xda,
+	0x5c, 0x1a, 0xd0, 0x01, 0xba, 0x46, 0x37, 0xbd, 0x5d, 0xaa, 0x3a, 0x41, 0xd4, 0x75, 0xfd, 0x60,
+	0x06, 0x26, 0x24, 0x1a, 0xfa, 0x5c, 0x83, 0x94, 0x92, 0x53, 0x68, 0xb9, 0x3f, 0xd4, 0x00, 0xbd,
+	0xac, 0x17, 0x47, 0x99, 0x05, 0x74, 0x8d, 0x95, 0x47, 0x3f, 0xff, 0xf1, 0xed, 0xf8, 0x39, 0x54,
+	0x10, 0xea, 0x9e, 0xb2, 0x50, 0xe3, 0x2b, 0x39, 0x65, 0x3d, 0x50, 0xa5, 0xf5, 0x10, 0x7d, 0xa7,
+	0xc1, 0x4c, 0x4c, 0xb1, 0xa2, 0x37, 0x87, 0x40, 0x0c, 0x52, 0xc6, 0xfa, 0xc5, 0xe3, 0x19, 0x2b,
+	0x56, 0xa6, 0x64, 0xb5, 0x8a, 0x8a, 0x71, 0x56, 0xa1, 0x30, 0xee, 0x23, 0xf7, 0x83, 0x06, 0xb3,
+	0x87, 0x85, 0x27, 0x32, 0x87, 0x40, 0x0e, 0xd1, 0xbb, 0xba, 0x75, 0x6c, 0x7b, 0xc5, 0x72, 0x43,
+	0xb2, 0xbc, 0x8c, 0xcc, 0x38, 0xcb, 0x76, 0x68, 0xdf, 0x23, 0x1a, 0xd5, 0xd1, 0x0f, 0xd1, 0x23,
+	0x0d, 0x52, 0x4a, 0x5e, 0x0e, 0x3d, 0xce, 0xb8, 0x72, 0xd5, 0x8b, 0xa3, 0xcc, 0x14, 0xa5, 0x55,
+	0x49, 0xc9, 0x40, 0x4b, 0x71, 0x4a, 0x4a, 0xaa, 0xb2, 0x48, 0xca, 0xbe, 0xd4, 0x20, 0xa5, 0x44,
+	0xe6, 0x50, 0x12, 0x71, 0x45, 0xab, 0x17, 0x47, 0x99, 0x29, 0x12, 0x97, 0x24, 0x89, 0x15, 0xb4,
+	0x1c, 0x27, 0xc1, 0x02, 0xb3, 0x1e, 0x07, 0xeb, 0xc1, 0x1e, 0xd9, 0x7f, 0x88, 0xda, 0x90, 0x14,
+	0x3a, 0x14, 0x19, 0x43, 0x4b, 0xa4, 0x2b, 0x6e, 0xf5, 0xff, 0x1f, 0x69, 0xa3, 0xf0, 0x97, 0x25,
+	0x7e, 0x01, 0x2d, 0x1e, 0xae, 0x9e, 0x6a, 0x2c, 0x03, 0x0c, 0x26, 0x03, 0x19, 0x86, 0xde, 0x18,
+	0x12, 0x35, 0xa6, 0xf6, 0xf4, 0xe5, 0x11, 0x56, 0x0a, 0x7d, 0x41, 0xa2, 0x9f, 0x46, 0xd9, 0x38,
+	0x7a, 0xa0, 0xf1, 0x10, 0x87, 0x94, 0x92, 0x78, 0x68, 0xc0, 0xa3, 0x10, 0x57, 0x7f, 0xfa, 0xca,
+	0xa8, 0x96, 0x16, 0x62, 0xe6, 0x25, 0x66, 0x0e, 0x9d, 0x8e, 0x63, 0x12, 0x5e, 0x2b, 0xdb, 0x02,
+	0xea, 0x3e, 0x64, 0x22, 0xfa, 0xec, 0x18, 0xc8, 0x03, 0xf6, 0x3a, 0x40, 0xe0, 0x19, 0x86, 0xc4,
+	0x5d, 0x40, 0xfa, 0x21, 0x5c, 0x65, 0x2a, 0xba, 0x03, 0xea, 0x40, 0x4a, 0xb5, 0xf9, 0xa1, 0x75,
+	0x16, 0x17, 0x83, 0x7a, 0x71, 0x94, 0xd9, 0xd1, 0xbb, 0x0e, 0xfa, 0x3b, 0xef, 0xa0, 0xc7, 0x1a,
+	0x40, 0xaf, 0x01, 0xa1, 0xd5, 0xa3, 0xc2, 0x46, 0x75, 0x85, 0x7e, 0xfe, 0x18, 0x96, 0x8a, 0xc3,
+	0x39, 0xc9, 0xe1, 0x2c, 0x9a, 0x1f, 0xc4, 0x41, 0x76, 0x44, 0x91, 0x00, 0xd5, 0xc0, 0x8e, 0xb8,
+	0xed, 0xd1, 0xbe, 0xa7, 0x17, 0x47, 0x99, 0x1d, 0x9d, 0x80, 0xb0, 0x37, 0xa2, 0xef, 0x35, 0x40,
+	0xfd, 0xad, 0x0a, 0x5d, 0x1e, 0x56, 0xc8, 0xc3, 0x1a, 0xa3, 0xbe, 0xf6, 0x0a, 0x1e, 0x47, 0x27,
+	0x26, 0xd2, 0xe0, 0xb6, 0xae, 0x3c, 0x7b, 0x91, 0xd7, 0x9e, 0xbf, 0xc8, 0x6b, 0xbf, 0xbf, 0xc8,
+	0x6b, 0x4f, 0x5e, 0xe6, 0xc7, 0x9e, 0xbf, 0xcc, 0x8f, 0xfd, 0xf2, 0x32, 0x3f, 0xf6, 0x69, 0x31,
+	0x22, 0x5f, 0xba, 0xee, 0x94, 0x59, 0xed, 0xb5, 0x0d, 0xab, 0x23, 0x43, 0x49, 0x09, 0x53, 0x99,
+	0x94, 0x6a, 0xe9, 0xad, 0xbf, 0x07, 0x00, 0x3e, 0xc3, 0x30, 0x78, 0x1d, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1467,6 +1569,9 @@ type QueryClient interface {
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// PrecompileRegistry queries the registry of the precompiled contracts
+	// available in the EVM.
+	PrecompileRegistry(ctx context.Context, in *QueryPrecompileRegistryRequest, opts ...grpc.CallOption) (*QueryPrecompileRegistryResponse, error)
 }
 
 type queryClient struct {
@@ -1585,6 +1690,15 @@ func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) PrecompileRegistry(ctx context.Context, in *QueryPrecompileRegistryRequest, opts ...grpc.CallOption) (*QueryPrecompileRegistryResponse, error) {
+	out := new(QueryPrecompileRegistryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/PrecompileRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1614,6 +1728,9 @@ type QueryServer interface {
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// PrecompileRegistry queries the registry of the precompiled contracts
+	// available in the EVM.
+	PrecompileRegistry(context.Context, *QueryPrecompileRegistryRequest) (*QueryPrecompileRegistryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1656,6 +1773,9 @@ func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTrace
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
+func (*UnimplementedQueryServer) PrecompileRegistry(ctx context.Context, req *QueryPrecompileRegistryRequest) (*QueryPrecompileRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrecompileRegistry not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1877,6 +1997,24 @@ func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PrecompileRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrecompileRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrecompileRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/PrecompileRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrecompileRegistry(ctx, req.(*QueryPrecompileRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1929,6 +2067,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
 		},
+		{
+			MethodName: "PrecompileRegistry",
+			Handler:    _Query_PrecompileRegistry_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/query.proto",
@@ -2857,6 +2999,76 @@ func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPrecompileRegistryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrecompileRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrecompileRegistryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveOnly {
+		i--
+		if m.ActiveOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrecompileRegistryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrecompileRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrecompileRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Precompiles) > 0 {
+		for iNdEx := len(m.Precompiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Precompiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3266,6 +3478,33 @@ func (m *QueryBaseFeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPrecompileRegistryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActiveOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPrecompileRegistryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Precompiles) > 0 {
+		for _, e := range m.Precompiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5905,6 +6144,160 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPrecompileRegistryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrecompileRegistryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrecompileRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrecompileRegistryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrecompileRegistryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrecompileRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Precompiles = append(m.Precompiles, PrecompileInfo{})
+			if err := m.Precompiles[len(m.Precompiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@ package feemarket
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -24,13 +25,41 @@ func InitGenesis(
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
 
+	if data.LearningRate != nil {
+		k.SetLearningRate(ctx, *data.LearningRate)
+	}
+
+	// NOTE: the blocks outside of the window and the history are pruned
+	if window := data.Params.AIMDParams.Window; window > 0 {
+		for _, blockGas := range data.WindowBlockGas {
+			k.SetWindowBlockGas(ctx, blockGas.Height, blockGas.Gas, window)
+		}
+	}
+
+	if historySize := data.Params.BaseFeeHistorySize; historySize > 0 {
+		for _, entry := range data.BaseFeeHistory {
+			k.SetBaseFeeHistoryEntry(ctx, entry, historySize)
+		}
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	params := k.GetParams(ctx)
+
+	var learningRate *math.LegacyDec
+	if k.HasLearningRate(ctx) {
+		rate := k.GetLearningRate(ctx, params.AIMDParams)
+		learningRate = &rate
+	}
+
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:         params,
+		BlockGas:       k.GetBlockGasWanted(ctx),
+		LearningRate:   learningRate,
+		WindowBlockGas: k.GetAllWindowBlockGas(ctx),
+		BaseFeeHistory: k.GetAllBaseFeeHistory(ctx),
 	}
 }
@@ -0,0 +1,55 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/evmos/evmos/v16/utils"
+	"github.com/stretchr/testify/require"
+
+	simapp "github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/x/feemarket"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+func TestFeeMarketExportGenesis(t *testing.T) {
+	chainID := utils.TestnetChainID + "-1"
+	app := simapp.Setup(false, types.DefaultGenesisState(), chainID)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the learning rate is not exported until it's set
+	genesis := feemarket.ExportGenesis(ctx, app.FeeMarketKeeper)
+	require.Nil(t, genesis.LearningRate)
+	require.Empty(t, genesis.WindowBlockGas)
+	require.Empty(t, genesis.BaseFeeHistory)
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	learningRate := math.LegacyNewDecWithPrec(15, 2)
+	app.FeeMarketKeeper.SetLearningRate(ctx, learningRate)
+	app.FeeMarketKeeper.SetWindowBlockGas(ctx, 9, 300, params.AIMDParams.Window)
+	app.FeeMarketKeeper.SetWindowBlockGas(ctx, 10, 400, params.AIMDParams.Window)
+	app.FeeMarketKeeper.SetBaseFeeHistoryEntry(ctx, types.BaseFeeHistoryEntry{
+		Height: 10, BaseFee: math.NewInt(1000), GasWanted: 500, GasUsed: 400,
+	}, params.BaseFeeHistorySize)
+
+	genesis = feemarket.ExportGenesis(ctx, app.FeeMarketKeeper)
+	require.NoError(t, genesis.Validate())
+	require.NotNil(t, genesis.LearningRate)
+	require.Equal(t, learningRate, *genesis.LearningRate)
+	require.Equal(t, []types.WindowBlockGas{{Height: 9, Gas: 300}, {Height: 10, Gas: 400}}, genesis.WindowBlockGas)
+	require.Len(t, genesis.BaseFeeHistory, 1)
+	require.Equal(t, int64(10), genesis.BaseFeeHistory[0].Height)
+
+	// importing the exported genesis on a new chain restores the base fee dynamics
+	newApp := simapp.Setup(false, types.DefaultGenesisState(), chainID)
+	newCtx := newApp.BaseApp.NewContext(false, tmproto.Header{})
+	feemarket.InitGenesis(newCtx, newApp.FeeMarketKeeper, *genesis)
+
+	require.Equal(t, learningRate, newApp.FeeMarketKeeper.GetLearningRate(newCtx, params.AIMDParams))
+	require.Equal(t, uint64(400), newApp.FeeMarketKeeper.GetWindowBlockGas(newCtx, 10))
+	entry, found := newApp.FeeMarketKeeper.GetBaseFeeHistoryEntry(newCtx, 10)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(1000), entry.BaseFee)
+	require.Equal(t, genesis, feemarket.ExportGenesis(newCtx, newApp.FeeMarketKeeper))
+}
@@ -37,7 +37,8 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	})
 }
 
-// EndBlock update block gas wanted.
+// EndBlock updates the block gas wanted and records the block in the base fee
+// history.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
@@ -63,11 +64,35 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
 	// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
 	// this will be keep BaseFee protected from un-penalized manipulation
 	// more info here https://github.com/evmos/ethermint/pull/1105#discussion_r888798925
-	minGasMultiplier := k.GetParams(ctx).MinGasMultiplier
-	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(minGasMultiplier)
+	params := k.GetParams(ctx)
+	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(params.MinGasMultiplier)
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
+	if params.BaseFeeHistorySize > 0 {
+		baseFee := math.ZeroInt()
+		if fee := k.GetBaseFee(ctx); fee != nil {
+			baseFee = math.NewIntFromBigInt(fee)
+		}
+
+		k.SetBaseFeeHistoryEntry(ctx, types.BaseFeeHistoryEntry{
+			Height:    ctx.BlockHeight(),
+			BaseFee:   baseFee,
+			GasWanted: updatedGasWanted,
+			GasUsed:   gasUsed.Uint64(),
+		}, params.BaseFeeHistorySize)
+	}
+
+	// the AIMD strategy adjusts the learning rate used to calculate the base fee
+	// of the next block according to the block utilization over the window
+	if params.BaseFeeStrategy == types.BASE_FEE_STRATEGY_AIMD && params.IsBaseFeeEnabled(ctx.BlockHeight()) {
+		learningRate := k.UpdateLearningRate(ctx, params.AIMDParams, updatedGasWanted)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyLearningRate, learningRate.String()),
+		))
+	}
+
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
 	}()
@@ -0,0 +1,175 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// AIMD Learning Rate
+// Required by the AIMD base fee calculation.
+// ----------------------------------------------------------------------------
+
+// GetLearningRate returns the current learning rate of the AIMD base fee
+// strategy. The max learning rate is returned if it hasn't been set yet, so
+// that the base fee reacts quickly right after the strategy is selected.
+func (k Keeper) GetLearningRate(ctx sdk.Context, params types.AIMDParams) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixLearningRate)
+	if len(bz) == 0 {
+		return params.MaxLearningRate
+	}
+
+	var learningRate math.LegacyDec
+	if err := learningRate.Unmarshal(bz); err != nil {
+		return params.MaxLearningRate
+	}
+
+	return learningRate
+}
+
+// HasLearningRate returns true if the learning rate of the AIMD base fee
+// strategy has been set.
+func (k Keeper) HasLearningRate(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyPrefixLearningRate)
+}
+
+// SetLearningRate sets the learning rate of the AIMD base fee strategy to the
+// store.
+func (k Keeper) SetLearningRate(ctx sdk.Context, learningRate math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := learningRate.Marshal()
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal learning rate", "error", err)
+		return
+	}
+	store.Set(types.KeyPrefixLearningRate, bz)
+}
+
+// GetWindowBlockGas returns the gas of the block at the given height that was
+// recorded for the AIMD window, or zero if it wasn't recorded.
+func (k Keeper) GetWindowBlockGas(ctx sdk.Context, height int64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWindowBlockGas)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(height))) // #nosec G701 -- block heights are positive
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetWindowBlockGas records the gas of the block at the given height for the
+// AIMD window and prunes the blocks that are no longer part of the window.
+func (k Keeper) SetWindowBlockGas(ctx sdk.Context, height int64, gas uint64, window uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWindowBlockGas)
+	store.Set(sdk.Uint64ToBigEndian(uint64(height)), sdk.Uint64ToBigEndian(gas)) // #nosec G701 -- block heights are positive
+
+	if uint64(height) < window { // #nosec G701 -- block heights are positive
+		return
+	}
+
+	// NOTE: prune every block up to the start of the window, as the window size
+	// can be decreased by governance.
+	windowStart := uint64(height) - window + 1 // #nosec G701 -- block heights are positive
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(windowStart))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllWindowBlockGas returns the gas of the blocks recorded for the AIMD
+// window, sorted by height.
+func (k Keeper) GetAllWindowBlockGas(ctx sdk.Context) []types.WindowBlockGas {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWindowBlockGas)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var blocksGas []types.WindowBlockGas
+	for ; iterator.Valid(); iterator.Next() {
+		blocksGas = append(blocksGas, types.WindowBlockGas{
+			Height: int64(sdk.BigEndianToUint64(iterator.Key())), // #nosec G701 -- block heights are positive
+			Gas:    sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return blocksGas
+}
+
+// UpdateLearningRate records the gas of the current block and updates the
+// learning rate of the AIMD base fee strategy according to the block
+// utilization over the window. The learning rate is additively increased when
+// the utilization is outside of the [gamma, 1 - gamma] target range and
+// multiplicatively decreased otherwise.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) UpdateLearningRate(ctx sdk.Context, params types.AIMDParams, blockGas uint64) math.LegacyDec {
+	height := ctx.BlockHeight()
+	k.SetWindowBlockGas(ctx, height, blockGas, params.Window)
+
+	windowGas := new(big.Int)
+	for i := uint64(0); i < params.Window && int64(i) < height; i++ { // #nosec G701 -- i is lower than the block height
+		windowGas.Add(windowGas, new(big.Int).SetUint64(k.GetWindowBlockGas(ctx, height-int64(i)))) // #nosec G701
+	}
+
+	maxEVMBlockGas := k.GetParams(ctx).EVMBlockGasLimit(blockGasLimit(ctx))
+	windowGasLimit := new(big.Int).Mul(new(big.Int).SetUint64(maxEVMBlockGas), new(big.Int).SetUint64(params.Window))
+	utilization := math.LegacyNewDecFromBigInt(windowGas).Quo(math.LegacyNewDecFromBigInt(windowGasLimit))
+
+	learningRate := k.GetLearningRate(ctx, params)
+	if utilization.LTE(params.Gamma) || utilization.GTE(math.LegacyOneDec().Sub(params.Gamma)) {
+		learningRate = math.LegacyMinDec(params.MaxLearningRate, learningRate.Add(params.Alpha))
+	} else {
+		learningRate = math.LegacyMaxDec(params.MinLearningRate, learningRate.Mul(params.Beta))
+	}
+
+	k.SetLearningRate(ctx, learningRate)
+	return learningRate
+}
+
+// calculateAIMDBaseFee calculates the base fee using the AIMD learning rate,
+// where baseFee = parentBaseFee * (1 + learningRate * (parentGasUsed - parentGasTarget) / parentGasTarget).
+func (k Keeper) calculateAIMDBaseFee(
+	ctx sdk.Context,
+	params types.Params,
+	parentBaseFee *big.Int,
+	parentGasUsed uint64,
+	parentGasTarget *big.Int,
+) *big.Int {
+	if parentGasTarget.Sign() == 0 {
+		return new(big.Int).Set(parentBaseFee)
+	}
+
+	learningRate := k.GetLearningRate(ctx, params.AIMDParams)
+
+	gasUsedDelta := new(big.Int).Sub(new(big.Int).SetUint64(parentGasUsed), parentGasTarget)
+	adjustment := learningRate.
+		Mul(math.LegacyNewDecFromBigInt(gasUsedDelta)).
+		Quo(math.LegacyNewDecFromBigInt(parentGasTarget))
+
+	baseFee := math.LegacyNewDecFromBigInt(parentBaseFee).
+		Mul(math.LegacyOneDec().Add(adjustment)).
+		TruncateInt().
+		BigInt()
+
+	// Set global min gas price as lower bound of the base fee, transactions below
+	// the min gas price don't even reach the mempool.
+	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
+	if baseFee.Cmp(minGasPrice) < 0 {
+		return minGasPrice
+	}
+
+	return baseFee
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateLearningRate() {
+	testCases := []struct {
+		name            string
+		learningRate    math.LegacyDec
+		windowBlockGas  uint64
+		blockGas        uint64
+		expLearningRate math.LegacyDec
+	}{
+		{
+			"learning rate not set - low utilization, capped to the max learning rate",
+			math.LegacyDec{},
+			0,
+			50,
+			types.DefaultAIMDMaxLearningRate,
+		},
+		{
+			"low utilization - additive increase",
+			math.LegacyNewDecWithPrec(1, 1),
+			0,
+			50,
+			math.LegacyNewDecWithPrec(125, 3),
+		},
+		{
+			"high utilization - additive increase",
+			math.LegacyNewDecWithPrec(1, 1),
+			100,
+			100,
+			math.LegacyNewDecWithPrec(125, 3),
+		},
+		{
+			"target utilization - multiplicative decrease",
+			math.LegacyNewDecWithPrec(1, 1),
+			50,
+			50,
+			math.LegacyNewDecWithPrec(95, 3),
+		},
+		{
+			"target utilization - capped to the min learning rate",
+			types.DefaultAIMDMinLearningRate,
+			50,
+			50,
+			types.DefaultAIMDMinLearningRate,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := types.DefaultAIMDParams()
+			height := int64(10)
+			suite.ctx = suite.ctx.
+				WithBlockHeight(height).
+				WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10}})
+
+			if !tc.learningRate.IsNil() {
+				suite.app.FeeMarketKeeper.SetLearningRate(suite.ctx, tc.learningRate)
+			}
+
+			// fill the window with the previous blocks and add blocks outside of it
+			for h := height - int64(params.Window) - 1; h < height; h++ {
+				suite.app.FeeMarketKeeper.SetWindowBlockGas(suite.ctx, h, tc.windowBlockGas, params.Window+2)
+			}
+
+			learningRate := suite.app.FeeMarketKeeper.UpdateLearningRate(suite.ctx, params, tc.blockGas)
+			suite.Require().Equal(tc.expLearningRate, learningRate)
+			suite.Require().Equal(tc.expLearningRate, suite.app.FeeMarketKeeper.GetLearningRate(suite.ctx, params))
+
+			// the blocks outside of the window are pruned
+			windowStart := height - int64(params.Window) + 1
+			suite.Require().Zero(suite.app.FeeMarketKeeper.GetWindowBlockGas(suite.ctx, windowStart-1))
+			suite.Require().Equal(tc.blockGas, suite.app.FeeMarketKeeper.GetWindowBlockGas(suite.ctx, height))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCalculateBaseFeeAIMD() {
+	testCases := []struct {
+		name                 string
+		learningRate         math.LegacyDec
+		parentBlockGasWanted uint64
+		minGasPrice          math.LegacyDec
+		minBaseFee           math.Int
+		maxBaseFee           math.Int
+		expFee               *big.Int
+	}{
+		{
+			"parent block wanted the same gas as its target",
+			types.DefaultAIMDMaxLearningRate,
+			50,
+			math.LegacyZeroDec(),
+			math.ZeroInt(),
+			math.ZeroInt(),
+			big.NewInt(1000000000),
+		},
+		{
+			"parent block wanted more gas than its target",
+			types.DefaultAIMDMaxLearningRate,
+			100,
+			math.LegacyZeroDec(),
+			math.ZeroInt(),
+			math.ZeroInt(),
+			big.NewInt(1500000000),
+		},
+		{
+			"parent block wanted more gas than its target, with lower learning rate",
+			math.LegacyNewDecWithPrec(1, 1),
+			100,
+			math.LegacyZeroDec(),
+			math.ZeroInt(),
+			math.ZeroInt(),
+			big.NewInt(1100000000),
+		},
+		{
+			"parent block wanted more gas than its target, bounded by the max base fee",
+			types.DefaultAIMDMaxLearningRate,
+			100,
+			math.LegacyZeroDec(),
+			math.ZeroInt(),
+			math.NewInt(1200000000),
+			big.NewInt(1200000000),
+		},
+		{
+			"parent gas wanted smaller than parent gas target",
+			types.DefaultAIMDMaxLearningRate,
+			25,
+			math.LegacyZeroDec(),
+			math.ZeroInt(),
+			math.ZeroInt(),
+			big.NewInt(750000000),
+		},
+		{
+			"parent gas wanted smaller than parent gas target, with higher min gas price",
+			types.DefaultAIMDMaxLearningRate,
+			25,
+			math.LegacyNewDec(900000000),
+			math.ZeroInt(),
+			math.ZeroInt(),
+			big.NewInt(900000000),
+		},
+		{
+			"parent gas wanted smaller than parent gas target, bounded by the min base fee",
+			types.DefaultAIMDMaxLearningRate,
+			25,
+			math.LegacyZeroDec(),
+			math.NewInt(800000000),
+			math.ZeroInt(),
+			big.NewInt(800000000),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.BaseFeeStrategy = types.BASE_FEE_STRATEGY_AIMD
+			params.AIMDParams = types.DefaultAIMDParams()
+			params.BaseFee = math.NewInt(1000000000)
+			params.MinGasPrice = tc.minGasPrice
+			params.MinBaseFee = tc.minBaseFee
+			params.MaxBaseFee = tc.maxBaseFee
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			suite.ctx = suite.ctx.
+				WithBlockHeight(1).
+				WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10}})
+
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, tc.parentBlockGasWanted)
+			suite.app.FeeMarketKeeper.SetLearningRate(suite.ctx, tc.learningRate)
+
+			fee := suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+			suite.Require().Equal(tc.expFee, fee, tc.name)
+		})
+	}
+}
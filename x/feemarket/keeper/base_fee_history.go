@@ -0,0 +1,93 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// Base Fee History
+// Required by the eth_feeHistory JSON-RPC endpoint.
+// ----------------------------------------------------------------------------
+
+// GetBaseFeeHistoryEntry returns the base fee history entry of the given block
+// height and a boolean that indicates if it was found.
+func (k Keeper) GetBaseFeeHistoryEntry(ctx sdk.Context, height int64) (types.BaseFeeHistoryEntry, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(height))) // #nosec G701 -- block heights are positive
+	if len(bz) == 0 {
+		return types.BaseFeeHistoryEntry{}, false
+	}
+
+	var entry types.BaseFeeHistoryEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	return entry, true
+}
+
+// GetBaseFeeHistory returns the base fee history entries within the given
+// height range (inclusive), sorted by height.
+func (k Keeper) GetBaseFeeHistory(ctx sdk.Context, fromHeight, toHeight int64) []types.BaseFeeHistoryEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	iterator := store.Iterator(
+		sdk.Uint64ToBigEndian(uint64(fromHeight)), // #nosec G701 -- block heights are positive
+		sdk.Uint64ToBigEndian(uint64(toHeight)+1), // #nosec G701 -- block heights are positive
+	)
+	defer iterator.Close()
+
+	var entries []types.BaseFeeHistoryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.BaseFeeHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// GetAllBaseFeeHistory returns all the base fee history entries, sorted by
+// height.
+func (k Keeper) GetAllBaseFeeHistory(ctx sdk.Context) []types.BaseFeeHistoryEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var entries []types.BaseFeeHistoryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.BaseFeeHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// SetBaseFeeHistoryEntry adds the entry to the base fee history and prunes the
+// entries of the blocks that are older than the history size, so that the
+// history behaves as a ring buffer of the latest blocks.
+func (k Keeper) SetBaseFeeHistoryEntry(ctx sdk.Context, entry types.BaseFeeHistoryEntry, historySize uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBaseFeeHistory)
+	store.Set(sdk.Uint64ToBigEndian(uint64(entry.Height)), k.cdc.MustMarshal(&entry)) // #nosec G701 -- block heights are positive
+
+	if uint64(entry.Height) < historySize { // #nosec G701 -- block heights are positive
+		return
+	}
+
+	// NOTE: prune every entry up to the oldest block of the history, as the
+	// history size can be decreased by governance.
+	oldestHeight := uint64(entry.Height) - historySize + 1 // #nosec G701 -- block heights are positive
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(oldestHeight))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/abci/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestSetBaseFeeHistoryEntry() {
+	historySize := uint64(3)
+	for height := int64(1); height <= 5; height++ {
+		suite.app.FeeMarketKeeper.SetBaseFeeHistoryEntry(suite.ctx, feemarkettypes.BaseFeeHistoryEntry{
+			Height:    height,
+			BaseFee:   math.NewInt(height * 10),
+			GasWanted: uint64(height) * 100,
+			GasUsed:   uint64(height) * 50,
+		}, historySize)
+	}
+
+	// only the latest blocks within the history size are kept
+	for height := int64(1); height <= 2; height++ {
+		_, found := suite.app.FeeMarketKeeper.GetBaseFeeHistoryEntry(suite.ctx, height)
+		suite.Require().False(found, "height %d", height)
+	}
+
+	entry, found := suite.app.FeeMarketKeeper.GetBaseFeeHistoryEntry(suite.ctx, 4)
+	suite.Require().True(found)
+	suite.Require().Equal(math.NewInt(40), entry.BaseFee)
+	suite.Require().Equal(uint64(400), entry.GasWanted)
+	suite.Require().Equal(uint64(200), entry.GasUsed)
+
+	entries := suite.app.FeeMarketKeeper.GetBaseFeeHistory(suite.ctx, 1, 4)
+	suite.Require().Len(entries, 2)
+	suite.Require().Equal(int64(3), entries[0].Height)
+	suite.Require().Equal(int64(4), entries[1].Height)
+
+	// decreasing the history size prunes the older blocks
+	suite.app.FeeMarketKeeper.SetBaseFeeHistoryEntry(suite.ctx, feemarkettypes.BaseFeeHistoryEntry{
+		Height:  6,
+		BaseFee: math.NewInt(60),
+	}, 1)
+	entries = suite.app.FeeMarketKeeper.GetBaseFeeHistory(suite.ctx, 0, 10)
+	suite.Require().Len(entries, 1)
+	suite.Require().Equal(int64(6), entries[0].Height)
+}
+
+func (suite *KeeperTestSuite) TestEndBlockBaseFeeHistory() {
+	testCases := []struct {
+		name        string
+		historySize uint64
+		expFound    bool
+	}{
+		{
+			"base fee history disabled",
+			0,
+			false,
+		},
+		{
+			"base fee history enabled",
+			feemarkettypes.DefaultBaseFeeHistorySize,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.BaseFeeHistorySize = tc.historySize
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			meter := storetypes.NewGasMeter(uint64(1000000000))
+			meter.ConsumeGas(3000000, "test")
+			suite.ctx = suite.ctx.WithBlockGasMeter(meter).WithBlockHeight(10)
+			suite.app.FeeMarketKeeper.SetTransientBlockGasWanted(suite.ctx, 5000000)
+
+			suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{Height: 10})
+
+			entry, found := suite.app.FeeMarketKeeper.GetBaseFeeHistoryEntry(suite.ctx, 10)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(int64(10), entry.Height)
+				suite.Require().Equal(params.BaseFee, entry.BaseFee)
+				suite.Require().Equal(uint64(3000000), entry.GasWanted)
+				suite.Require().Equal(uint64(3000000), entry.GasUsed)
+			}
+		})
+	}
+}
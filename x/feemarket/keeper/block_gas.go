@@ -0,0 +1,17 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmostypes "github.com/evmos/evmos/v16/types"
+)
+
+// GetMaxEVMBlockGas returns the maximum gas that can be used by the EVM
+// transactions of the current block. It is the block gas limit capped by the
+// MaxEVMBlockGas parameter, so that the remaining block gas is reserved to the
+// Cosmos transactions.
+func (k Keeper) GetMaxEVMBlockGas(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).EVMBlockGasLimit(evmostypes.BlockGasLimit(ctx))
+}
@@ -9,10 +9,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
+// The update rule is selected by the BaseFeeStrategy parameter and the result is bounded by the MinBaseFee and
+// MaxBaseFee parameters.
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
@@ -23,8 +27,6 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 		return nil
 	}
 
-	consParams := ctx.ConsensusParams()
-
 	// If the current block is the first EIP-1559 block, return the base fee
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
@@ -43,20 +45,33 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 
 	parentGasUsed := k.GetBlockGasWanted(ctx)
 
-	gasLimit := new(big.Int).SetUint64(math.MaxUint64)
-
-	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
-	if consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > -1 {
-		gasLimit = big.NewInt(consParams.Block.MaxGas)
-	}
-
+	// the gas target is either set by governance or derived from the EVM block
+	// gas limit.
 	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
 	// validation
-	parentGasTargetBig := new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
-	if !parentGasTargetBig.IsUint64() {
-		return nil
+	parentGasTarget := params.BlockGasTarget(params.EVMBlockGasLimit(blockGasLimit(ctx)))
+	parentGasTargetBig := new(big.Int).SetUint64(parentGasTarget)
+
+	var baseFee *big.Int
+	switch params.BaseFeeStrategy {
+	case types.BASE_FEE_STRATEGY_AIMD:
+		baseFee = k.calculateAIMDBaseFee(ctx, params, parentBaseFee, parentGasUsed, parentGasTargetBig)
+	default:
+		baseFee = calculateEIP1559BaseFee(params, parentBaseFee, parentGasUsed, parentGasTargetBig)
 	}
 
+	return clampBaseFee(params, baseFee)
+}
+
+// calculateEIP1559BaseFee calculates the base fee using the classic EIP-1559
+// update rule, where the base fee changes by at most 1/BaseFeeChangeDenominator
+// proportionally to the difference between the parent block gas and the target.
+func calculateEIP1559BaseFee(
+	params types.Params,
+	parentBaseFee *big.Int,
+	parentGasUsed uint64,
+	parentGasTargetBig *big.Int,
+) *big.Int {
 	parentGasTarget := parentGasTargetBig.Uint64()
 	baseFeeChangeDenominator := new(big.Int).SetUint64(uint64(params.BaseFeeChangeDenominator))
 
@@ -92,3 +107,30 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
 	return math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
 }
+
+// clampBaseFee bounds the base fee to the [MinBaseFee, MaxBaseFee] range of the
+// params. A zero MaxBaseFee means that the base fee has no upper bound.
+func clampBaseFee(params types.Params, baseFee *big.Int) *big.Int {
+	if !params.MaxBaseFee.IsNil() && params.MaxBaseFee.IsPositive() {
+		baseFee = math.BigMin(baseFee, params.MaxBaseFee.BigInt())
+	}
+
+	if !params.MinBaseFee.IsNil() && params.MinBaseFee.IsPositive() {
+		baseFee = math.BigMax(baseFee, params.MinBaseFee.BigInt())
+	}
+
+	return baseFee
+}
+
+// blockGasLimit returns the block gas limit from the consensus params, or the
+// max uint64 value if the block gas is unlimited.
+func blockGasLimit(ctx sdk.Context) uint64 {
+	consParams := ctx.ConsensusParams()
+
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	if consParams != nil && consParams.Block != nil && consParams.Block.MaxGas > -1 {
+		return uint64(consParams.Block.MaxGas) // #nosec G701 -- MaxGas is not negative
+	}
+
+	return math.MaxUint64
+}
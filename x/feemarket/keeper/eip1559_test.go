@@ -115,3 +115,74 @@ func (suite *KeeperTestSuite) TestCalculateBaseFee() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCalculateBaseFeeWithBlockGasParams() {
+	testCases := []struct {
+		name                 string
+		targetBlockGas       uint64
+		maxEVMBlockGas       uint64
+		parentBlockGasWanted uint64
+		expFee               *big.Int
+	}{
+		{
+			"parent block wanted the same gas as the explicit target",
+			25,
+			0,
+			25,
+			suite.app.FeeMarketKeeper.GetParams(suite.ctx).BaseFee.BigInt(),
+		},
+		{
+			"parent block wanted more gas than the explicit target",
+			25,
+			0,
+			50,
+			big.NewInt(1125000000),
+		},
+		{
+			"parent block wanted the same gas as the target derived from the max EVM block gas",
+			0,
+			50,
+			25,
+			suite.app.FeeMarketKeeper.GetParams(suite.ctx).BaseFee.BigInt(),
+		},
+		{
+			"parent block wanted more gas than the target derived from the max EVM block gas",
+			0,
+			50,
+			50,
+			big.NewInt(1125000000),
+		},
+		{
+			"max EVM block gas greater than the consensus max gas",
+			0,
+			200,
+			50,
+			suite.app.FeeMarketKeeper.GetParams(suite.ctx).BaseFee.BigInt(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.MinGasPrice = math.LegacyZeroDec()
+			params.TargetBlockGas = tc.targetBlockGas
+			params.MaxEVMBlockGas = tc.maxEVMBlockGas
+			err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			suite.ctx = suite.ctx.WithBlockHeight(1)
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, tc.parentBlockGasWanted)
+
+			blockParams := tmproto.BlockParams{
+				MaxGas:   100,
+				MaxBytes: 10,
+			}
+			consParams := tmproto.ConsensusParams{Block: &blockParams}
+			suite.ctx = suite.ctx.WithConsensusParams(&consParams)
+
+			fee := suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx)
+			suite.Require().Equal(tc.expFee, fee, tc.name)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// GetFeeDenoms returns the denominations, other than the EVM denom, that are
+// accepted to pay the transaction fees.
+func (k Keeper) GetFeeDenoms(ctx sdk.Context) []types.FeeDenom {
+	return k.GetParams(ctx).FeeDenoms
+}
+
+// IsAcceptedFeeDenom returns true if the given denom is accepted to pay the
+// transaction fees in place of the EVM denom.
+func (k Keeper) IsAcceptedFeeDenom(ctx sdk.Context, denom string) bool {
+	_, found := k.GetParams(ctx).GetFeeDenom(denom)
+	return found
+}
+
+// GetConversionRate returns the amount of the given accepted fee denom that is
+// equivalent to one unit of the EVM denom, as set by governance.
+func (k Keeper) GetConversionRate(ctx sdk.Context, denom string) (math.LegacyDec, error) {
+	feeDenom, found := k.GetParams(ctx).GetFeeDenom(denom)
+	if !found {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "denom %s", denom)
+	}
+
+	rate := feeDenom.ConversionRate
+	if rate.IsNil() || !rate.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidConversionRate, "conversion rate of denom %s must be positive: %s", denom, rate)
+	}
+
+	return rate, nil
+}
+
+// ConvertToFeeDenom converts the given amount of the EVM denom to the given
+// accepted fee denom. The result is rounded up so that the converted fees are
+// never lower than the original ones.
+func (k Keeper) ConvertToFeeDenom(ctx sdk.Context, amount math.Int, denom string) (sdk.Coin, error) {
+	rate, err := k.GetConversionRate(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	converted := math.LegacyNewDecFromInt(amount).Mul(rate).Ceil().TruncateInt()
+	return sdk.Coin{Denom: denom, Amount: converted}, nil
+}
+
+// ConvertFromFeeDenom converts the given coin of an accepted fee denom to the
+// equivalent amount of the EVM denom. The result is rounded down.
+func (k Keeper) ConvertFromFeeDenom(ctx sdk.Context, coin sdk.Coin) (math.Int, error) {
+	rate, err := k.GetConversionRate(ctx, coin.Denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return math.LegacyNewDecFromInt(coin.Amount).Quo(rate).TruncateInt(), nil
+}
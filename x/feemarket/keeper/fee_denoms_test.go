@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestGetConversionRate() {
+	testCases := []struct {
+		name    string
+		denom   string
+		expRate math.LegacyDec
+		expErr  error
+	}{
+		{
+			"fail - denom not accepted",
+			"uatom",
+			math.LegacyDec{},
+			types.ErrInvalidFeeDenom,
+		},
+		{
+			"success - conversion rate",
+			"uusdc",
+			math.LegacyNewDecWithPrec(5, 2),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.FeeDenoms = []types.FeeDenom{types.NewFeeDenom("uusdc", math.LegacyNewDecWithPrec(5, 2))}
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+			keeper := suite.app.FeeMarketKeeper
+			rate, err := keeper.GetConversionRate(suite.ctx, tc.denom)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRate, rate)
+			suite.Require().True(keeper.IsAcceptedFeeDenom(suite.ctx, tc.denom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertFeeDenom() {
+	suite.SetupTest()
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.FeeDenoms = []types.FeeDenom{types.NewFeeDenom("uusdc", math.LegacyNewDecWithPrec(5, 2))}
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	// conversions to the fee denom are rounded up
+	coin, err := suite.app.FeeMarketKeeper.ConvertToFeeDenom(suite.ctx, math.NewInt(1010), "uusdc")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin("uusdc", 51), coin)
+
+	// conversions from the fee denom are rounded down
+	amount, err := suite.app.FeeMarketKeeper.ConvertFromFeeDenom(suite.ctx, sdk.NewInt64Coin("uusdc", 51))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1020), amount)
+
+	_, err = suite.app.FeeMarketKeeper.ConvertToFeeDenom(suite.ctx, math.NewInt(1010), "uatom")
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeDenom)
+}
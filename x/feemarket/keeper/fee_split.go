@@ -0,0 +1,94 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// DistributeTxFees distributes the fees paid by a transaction of the given class
+// according to its fee split. The fees are expected to be held by the fee
+// collector module account:
+//   - the burn portion is burned from the fee collector
+//   - the community pool portion is sent to the community pool
+//   - the validators portion is kept by the fee collector
+//   - the developers portion is paid by x/revenue to the withdrawer of the called
+//     contract when it is registered and kept by the fee collector otherwise
+//
+// An event is emitted for each non-empty portion.
+func (k Keeper) DistributeTxFees(ctx sdk.Context, txClass string, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	feeSplit, err := k.GetParams(ctx).GetFeeSplit(txClass)
+	if err != nil {
+		return err
+	}
+
+	portions := feeSplit.Split(fees)
+
+	if !portions.Burn.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, k.feeCollectorName, portions.Burn); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn %s %s tx fees", portions.Burn, txClass)
+		}
+	}
+
+	if !portions.CommunityPool.IsZero() {
+		feeCollector := authtypes.NewModuleAddress(k.feeCollectorName)
+		if err := k.distributionKeeper.FundCommunityPool(ctx, portions.CommunityPool, feeCollector); err != nil {
+			return errorsmod.Wrapf(err, "failed to fund the community pool with %s %s tx fees", portions.CommunityPool, txClass)
+		}
+	}
+
+	events := make(sdk.Events, 0, 4)
+	for _, portion := range []struct {
+		name  string
+		coins sdk.Coins
+	}{
+		{types.PortionBurn, portions.Burn},
+		{types.PortionCommunityPool, portions.CommunityPool},
+		{types.PortionValidators, portions.Validators},
+		{types.PortionDevelopers, portions.Developers},
+	} {
+		if portion.coins.IsZero() {
+			continue
+		}
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeDistributeTxFees,
+			sdk.NewAttribute(types.AttributeKeyTxClass, txClass),
+			sdk.NewAttribute(types.AttributeKeyPortion, portion.name),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, portion.coins.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	defer func() {
+		if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+			return
+		}
+		for _, c := range portions.Burn {
+			// if fee amount is higher than uint64, skip the counter
+			if !c.Amount.IsUint64() {
+				continue
+			}
+			telemetry.IncrCounterWithLabels(
+				[]string{"burned", "tx", "fee", "amount"},
+				float32(c.Amount.Uint64()),
+				[]metrics.Label{
+					telemetry.NewLabel("denom", c.Denom),
+					telemetry.NewLabel("tx_class", txClass),
+				},
+			)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+	inflationtypes "github.com/evmos/evmos/v16/x/inflation/v1/types"
+)
+
+func (suite *KeeperTestSuite) TestDistributeTxFees() {
+	denom := "dhives"
+
+	testCases := []struct {
+		name             string
+		txClass          string
+		feeSplit         types.FeeSplit
+		fees             sdk.Coins
+		expErr           bool
+		expFeeCollector  math.Int
+		expCommunityPool math.LegacyDec
+		expBurned        math.Int
+		expEvents        int
+	}{
+		{
+			"fail - unknown tx class",
+			"ibc",
+			types.DefaultCosmosFeeSplit(),
+			sdk.NewCoins(sdk.NewInt64Coin(denom, 100)),
+			true,
+			math.NewInt(100),
+			math.LegacyZeroDec(),
+			math.ZeroInt(),
+			0,
+		},
+		{
+			"success - no fees",
+			types.TxClassCosmos,
+			types.DefaultCosmosFeeSplit(),
+			sdk.Coins{},
+			false,
+			math.NewInt(100),
+			math.LegacyZeroDec(),
+			math.ZeroInt(),
+			0,
+		},
+		{
+			"success - default cosmos fee split burns all the fees",
+			types.TxClassCosmos,
+			types.DefaultCosmosFeeSplit(),
+			sdk.NewCoins(sdk.NewInt64Coin(denom, 100)),
+			false,
+			math.ZeroInt(),
+			math.LegacyZeroDec(),
+			math.NewInt(100),
+			1,
+		},
+		{
+			"success - default evm fee split keeps all the fees in the fee collector",
+			types.TxClassEVM,
+			types.DefaultEVMFeeSplit(),
+			sdk.NewCoins(sdk.NewInt64Coin(denom, 100)),
+			false,
+			math.NewInt(100),
+			math.LegacyZeroDec(),
+			math.ZeroInt(),
+			1,
+		},
+		{
+			"success - evm fee split with every portion, rounding down to the validators",
+			types.TxClassEVM,
+			types.NewFeeSplit(
+				math.LegacyNewDecWithPrec(255, 3),
+				math.LegacyNewDecWithPrec(255, 3),
+				math.LegacyNewDecWithPrec(245, 3),
+				math.LegacyNewDecWithPrec(245, 3),
+			),
+			sdk.NewCoins(sdk.NewInt64Coin(denom, 10)),
+			false,
+			math.NewInt(6),
+			math.LegacyNewDec(2),
+			math.NewInt(2),
+			4,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.CosmosFeeSplit = types.DefaultCosmosFeeSplit()
+			params.EVMFeeSplit = types.DefaultEVMFeeSplit()
+			if tc.txClass == types.TxClassCosmos {
+				params.CosmosFeeSplit = tc.feeSplit
+			} else {
+				params.EVMFeeSplit = tc.feeSplit
+			}
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+			collected := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+			if !tc.fees.IsZero() {
+				collected = tc.fees
+			}
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, collected))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, inflationtypes.ModuleName, authtypes.FeeCollectorName, collected))
+
+			feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			communityPoolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom)
+			supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount
+
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			err := suite.app.FeeMarketKeeper.DistributeTxFees(ctx, tc.txClass, tc.fees)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom)
+			suite.Require().Equal(tc.expFeeCollector.String(), balance.Amount.String())
+
+			communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom)
+			suite.Require().Equal(tc.expCommunityPool.String(), communityPool.Sub(communityPoolBefore).String())
+
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount
+			suite.Require().Equal(tc.expBurned.String(), supplyBefore.Sub(supply).String())
+
+			var events int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeDistributeTxFees {
+					events++
+				}
+			}
+			suite.Require().Equal(tc.expEvents, events)
+		})
+	}
+}
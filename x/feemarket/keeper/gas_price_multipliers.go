@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GetGasPriceMultiplier returns the gas price multiplier set by governance for
+// the calls to the given contract, or 1 if the contract has none or if the
+// address is nil (i.e. a contract creation).
+func (k Keeper) GetGasPriceMultiplier(ctx sdk.Context, contract *common.Address) math.LegacyDec {
+	if contract == nil {
+		return math.LegacyOneDec()
+	}
+	return k.GetParams(ctx).GetGasPriceMultiplier(contract)
+}
@@ -7,7 +7,10 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/evmos/v16/x/feemarket/types"
 )
@@ -52,3 +55,51 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// BaseFeeHistory implements the Query/BaseFeeHistory gRPC method
+func (k Keeper) BaseFeeHistory(c context.Context, req *types.QueryBaseFeeHistoryRequest) (*types.QueryBaseFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.FromHeight < 0 || req.ToHeight < req.FromHeight {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid height range: from %d to %d", req.FromHeight, req.ToHeight,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBaseFeeHistoryResponse{
+		Entries: k.GetBaseFeeHistory(ctx, req.FromHeight, req.ToHeight),
+	}, nil
+}
+
+// NextBaseFee implements the Query/NextBaseFee gRPC method. It runs the same
+// base fee calculation as the BeginBlock of the block that follows the current
+// one, using the block max gas of the request as consensus params.
+func (k Keeper) NextBaseFee(c context.Context, req *types.QueryNextBaseFeeRequest) (*types.QueryNextBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// NOTE: a block max gas of -1 means that the block gas is unlimited
+	if req.BlockMaxGas == 0 || req.BlockMaxGas < -1 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block max gas: %d", req.BlockMaxGas)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+	})
+
+	res := &types.QueryNextBaseFeeResponse{}
+	if baseFee := k.CalculateBaseFee(ctx); baseFee != nil {
+		aux := sdkmath.NewIntFromBigInt(baseFee)
+		res.BaseFee = &aux
+	}
+
+	return res, nil
+}
@@ -98,3 +98,122 @@ func (suite *KeeperTestSuite) TestQueryBlockGas() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryBaseFeeHistory() {
+	for height := int64(1); height <= 3; height++ {
+		suite.app.FeeMarketKeeper.SetBaseFeeHistoryEntry(suite.ctx, types.BaseFeeHistoryEntry{
+			Height:  height,
+			BaseFee: sdkmath.NewInt(height),
+		}, types.DefaultBaseFeeHistorySize)
+	}
+
+	testCases := []struct {
+		name       string
+		req        *types.QueryBaseFeeHistoryRequest
+		expHeights []int64
+		expPass    bool
+	}{
+		{
+			"fail - negative from height",
+			&types.QueryBaseFeeHistoryRequest{FromHeight: -1, ToHeight: 2},
+			nil,
+			false,
+		},
+		{
+			"fail - to height lower than from height",
+			&types.QueryBaseFeeHistoryRequest{FromHeight: 2, ToHeight: 1},
+			nil,
+			false,
+		},
+		{
+			"pass - range within the history",
+			&types.QueryBaseFeeHistoryRequest{FromHeight: 2, ToHeight: 3},
+			[]int64{2, 3},
+			true,
+		},
+		{
+			"pass - range partially outside of the history",
+			&types.QueryBaseFeeHistoryRequest{FromHeight: 3, ToHeight: 10},
+			[]int64{3},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		res, err := suite.queryClient.BaseFeeHistory(suite.ctx.Context(), tc.req)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Len(res.Entries, len(tc.expHeights), tc.name)
+			for i, entry := range res.Entries {
+				suite.Require().Equal(tc.expHeights[i], entry.Height, tc.name)
+				suite.Require().Equal(sdkmath.NewInt(entry.Height), entry.BaseFee, tc.name)
+			}
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryNextBaseFee() {
+	testCases := []struct {
+		name       string
+		malleate   func(params *types.Params)
+		req        *types.QueryNextBaseFeeRequest
+		expBaseFee *sdkmath.Int
+		expPass    bool
+	}{
+		{
+			"fail - zero block max gas",
+			func(_ *types.Params) {},
+			&types.QueryNextBaseFeeRequest{},
+			nil,
+			false,
+		},
+		{
+			"pass - base fee disabled",
+			func(params *types.Params) {
+				params.NoBaseFee = true
+			},
+			&types.QueryNextBaseFeeRequest{BlockMaxGas: 100},
+			nil,
+			true,
+		},
+		{
+			"pass - block wanted more gas than its target",
+			func(_ *types.Params) {},
+			&types.QueryNextBaseFeeRequest{BlockMaxGas: 100},
+			func() *sdkmath.Int { fee := sdkmath.NewInt(1125000000); return &fee }(),
+			true,
+		},
+		{
+			"pass - next base fee bounded by the max base fee",
+			func(params *types.Params) {
+				params.MaxBaseFee = sdkmath.NewInt(1100000000)
+			},
+			&types.QueryNextBaseFeeRequest{BlockMaxGas: 100},
+			func() *sdkmath.Int { fee := sdkmath.NewInt(1100000000); return &fee }(),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.EnableHeight = 0
+			params.BaseFee = sdkmath.NewInt(ethparams.InitialBaseFee)
+			tc.malleate(&params)
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, 100)
+
+			res, err := suite.queryClient.NextBaseFee(suite.ctx.Context(), tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBaseFee, res.BaseFee)
+		})
+	}
+}
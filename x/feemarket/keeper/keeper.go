@@ -28,11 +28,18 @@ type Keeper struct {
 	authority sdk.AccAddress
 	// Legacy subspace
 	ss paramstypes.Subspace
+	// bankKeeper burns the burned portion of the transaction fees
+	bankKeeper types.BankKeeper
+	// distributionKeeper funds the community pool with its portion of the transaction fees
+	distributionKeeper types.DistributionKeeper
+	// feeCollectorName is the name of the module account that collects the transaction fees
+	feeCollectorName string
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
 	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey, ss paramstypes.Subspace,
+	bk types.BankKeeper, dk types.DistributionKeeper, feeCollectorName string,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -40,11 +47,14 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		authority:    authority,
-		transientKey: transientKey,
-		ss:           ss,
+		cdc:                cdc,
+		storeKey:           storeKey,
+		authority:          authority,
+		transientKey:       transientKey,
+		ss:                 ss,
+		bankKeeper:         bk,
+		distributionKeeper: dk,
+		feeCollectorName:   feeCollectorName,
 	}
 }
 
@@ -5,6 +5,8 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v4 "github.com/evmos/evmos/v16/x/feemarket/migrations/v4"
+	v5 "github.com/evmos/evmos/v16/x/feemarket/migrations/v5"
+	v6 "github.com/evmos/evmos/v16/x/feemarket/migrations/v6"
 	"github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
@@ -12,13 +14,15 @@ import (
 type Migrator struct {
 	keeper         Keeper
 	legacySubspace types.Subspace
+	revenueKeeper  types.RevenueKeeper
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
+func NewMigrator(keeper Keeper, legacySubspace types.Subspace, revenueKeeper types.RevenueKeeper) Migrator {
 	return Migrator{
 		keeper:         keeper,
 		legacySubspace: legacySubspace,
+		revenueKeeper:  revenueKeeper,
 	}
 }
 
@@ -26,3 +30,13 @@ func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate4to5 migrates the store from consensus version 4 to 5
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates the store from consensus version 5 to 6
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.revenueKeeper.GetDeveloperShares(ctx))
+}
@@ -20,7 +20,7 @@ func (ms mockSubspace) GetParamSetIfExists(_ sdk.Context, ps types.LegacyParams)
 
 func (suite *KeeperTestSuite) TestMigrations() {
 	legacySubspace := newMockSubspace(types.DefaultParams())
-	migrator := feemarketkeeper.NewMigrator(suite.app.FeeMarketKeeper, legacySubspace)
+	migrator := feemarketkeeper.NewMigrator(suite.app.FeeMarketKeeper, legacySubspace, suite.app.RevenueKeeper)
 
 	testCases := []struct {
 		name        string
@@ -30,6 +30,14 @@ func (suite *KeeperTestSuite) TestMigrations() {
 			"Run Migrate3to4",
 			migrator.Migrate3to4,
 		},
+		{
+			"Run Migrate4to5",
+			migrator.Migrate4to5,
+		},
+		{
+			"Run Migrate5to6",
+			migrator.Migrate5to6,
+		},
 	}
 
 	for _, tc := range testCases {
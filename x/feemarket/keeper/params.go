@@ -30,6 +30,22 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		params.MinGasMultiplier = math.LegacyZeroDec()
 	}
 
+	if params.MinBaseFee.IsNil() {
+		params.MinBaseFee = math.ZeroInt()
+	}
+
+	if params.MaxBaseFee.IsNil() {
+		params.MaxBaseFee = math.ZeroInt()
+	}
+
+	if params.CosmosFeeSplit.IsEmpty() {
+		params.CosmosFeeSplit = types.DefaultCosmosFeeSplit()
+	}
+
+	if params.EVMFeeSplit.IsEmpty() {
+		params.EVMFeeSplit = types.DefaultEVMFeeSplit()
+	}
+
 	return
 }
 
@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v5
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version 4 to
+// version 5. Specifically, it adds the base fee strategy params, keeping the classic
+// EIP-1559 update rule and an unbounded base fee so that the current behavior is preserved,
+// and the size of the base fee history.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+
+	paramsBz := store.Get(types.ParamsKey)
+	if err := cdc.Unmarshal(paramsBz, &params); err != nil {
+		return err
+	}
+
+	params.BaseFeeStrategy = types.BASE_FEE_STRATEGY_EIP1559
+	params.AIMDParams = types.DefaultAIMDParams()
+	params.MinBaseFee = math.ZeroInt()
+	params.MaxBaseFee = math.ZeroInt()
+	params.BaseFeeHistorySize = types.DefaultBaseFeeHistorySize
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
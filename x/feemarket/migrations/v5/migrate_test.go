@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v5_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/encoding"
+	v5 "github.com/evmos/evmos/v16/x/feemarket/migrations/v5"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	// params stored before the base fee strategy was introduced
+	v4Params := types.NewParams(false, 8, 2, 1000000000, 10, math.LegacyNewDec(5), math.LegacyNewDecWithPrec(50, 2))
+	kvStore.Set(types.ParamsKey, cdc.MustMarshal(&v4Params))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(kvStore.Get(types.ParamsKey), &params)
+
+	require.Equal(t, v4Params.BaseFeeChangeDenominator, params.BaseFeeChangeDenominator)
+	require.Equal(t, v4Params.ElasticityMultiplier, params.ElasticityMultiplier)
+	require.Equal(t, v4Params.BaseFee, params.BaseFee)
+	require.Equal(t, v4Params.EnableHeight, params.EnableHeight)
+	require.Equal(t, v4Params.MinGasPrice, params.MinGasPrice)
+	require.Equal(t, v4Params.MinGasMultiplier, params.MinGasMultiplier)
+	require.Equal(t, types.BASE_FEE_STRATEGY_EIP1559, params.BaseFeeStrategy)
+	require.Equal(t, types.DefaultAIMDParams(), params.AIMDParams)
+	require.True(t, params.MinBaseFee.IsZero())
+	require.True(t, params.MaxBaseFee.IsZero())
+	require.Equal(t, types.DefaultBaseFeeHistorySize, params.BaseFeeHistorySize)
+}
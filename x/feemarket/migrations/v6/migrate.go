@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v6
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version 5 to
+// version 6. Specifically, it adds the fee splits of the Cosmos and EVM transactions,
+// burning all the Cosmos transaction fees and sharing the EVM transaction fees between
+// the validators and the developers according to the given x/revenue developer shares,
+// so that the current behavior is preserved.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	developerShares math.LegacyDec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+
+	paramsBz := store.Get(types.ParamsKey)
+	if err := cdc.Unmarshal(paramsBz, &params); err != nil {
+		return err
+	}
+
+	params.CosmosFeeSplit = types.DefaultCosmosFeeSplit()
+	params.EVMFeeSplit = types.NewFeeSplit(
+		math.LegacyZeroDec(),
+		math.LegacyZeroDec(),
+		math.LegacyOneDec().Sub(developerShares),
+		developerShares,
+	)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v6_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/encoding"
+	v6 "github.com/evmos/evmos/v16/x/feemarket/migrations/v6"
+	"github.com/evmos/evmos/v16/x/feemarket/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	testCases := []struct {
+		name            string
+		developerShares math.LegacyDec
+		expEVMFeeSplit  types.FeeSplit
+	}{
+		{
+			"default developer shares",
+			math.LegacyNewDecWithPrec(50, 2),
+			types.NewFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(50, 2), math.LegacyNewDecWithPrec(50, 2)),
+		},
+		{
+			"custom developer shares",
+			math.LegacyNewDecWithPrec(20, 2),
+			types.NewFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(80, 2), math.LegacyNewDecWithPrec(20, 2)),
+		},
+		{
+			"no developer shares",
+			math.LegacyZeroDec(),
+			types.NewFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyZeroDec()),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeKey := sdk.NewKVStoreKey(types.ModuleName)
+			tKey := sdk.NewTransientStoreKey("transient_test")
+			ctx := testutil.DefaultContext(storeKey, tKey)
+			kvStore := ctx.KVStore(storeKey)
+
+			// params stored before the fee splits were introduced
+			v5Params := types.DefaultParams()
+			v5Params.MinGasPrice = math.LegacyNewDec(5)
+			v5Params.CosmosFeeSplit = types.FeeSplit{}
+			v5Params.EVMFeeSplit = types.FeeSplit{}
+			kvStore.Set(types.ParamsKey, cdc.MustMarshal(&v5Params))
+
+			require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc, tc.developerShares))
+
+			var params types.Params
+			cdc.MustUnmarshal(kvStore.Get(types.ParamsKey), &params)
+
+			require.Equal(t, v5Params.MinGasPrice, params.MinGasPrice)
+			require.Equal(t, v5Params.BaseFeeHistorySize, params.BaseFeeHistorySize)
+			require.Equal(t, types.DefaultCosmosFeeSplit(), params.CosmosFeeSplit)
+			require.Equal(t, tc.expEVMFeeSplit, params.EVMFeeSplit)
+		})
+	}
+}
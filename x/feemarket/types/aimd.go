@@ -0,0 +1,88 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// DefaultAIMDAlpha is 0.025
+	DefaultAIMDAlpha = math.LegacyNewDecWithPrec(25, 3)
+	// DefaultAIMDBeta is 0.95
+	DefaultAIMDBeta = math.LegacyNewDecWithPrec(95, 2)
+	// DefaultAIMDGamma is 0.25
+	DefaultAIMDGamma = math.LegacyNewDecWithPrec(25, 2)
+	// DefaultAIMDMinLearningRate is 0.01
+	DefaultAIMDMinLearningRate = math.LegacyNewDecWithPrec(1, 2)
+	// DefaultAIMDMaxLearningRate is 0.5
+	DefaultAIMDMaxLearningRate = math.LegacyNewDecWithPrec(5, 1)
+	// DefaultAIMDWindow is 8 blocks
+	DefaultAIMDWindow = uint64(8)
+)
+
+// NewAIMDParams creates a new AIMDParams instance
+func NewAIMDParams(
+	alpha, beta, gamma, minLearningRate, maxLearningRate math.LegacyDec,
+	window uint64,
+) AIMDParams {
+	return AIMDParams{
+		Alpha:           alpha,
+		Beta:            beta,
+		Gamma:           gamma,
+		MinLearningRate: minLearningRate,
+		MaxLearningRate: maxLearningRate,
+		Window:          window,
+	}
+}
+
+// DefaultAIMDParams returns the default parameters of the AIMD base fee strategy.
+func DefaultAIMDParams() AIMDParams {
+	return NewAIMDParams(
+		DefaultAIMDAlpha,
+		DefaultAIMDBeta,
+		DefaultAIMDGamma,
+		DefaultAIMDMinLearningRate,
+		DefaultAIMDMaxLearningRate,
+		DefaultAIMDWindow,
+	)
+}
+
+// Validate performs a stateless validation of the AIMD base fee strategy
+// parameters.
+func (p AIMDParams) Validate() error {
+	if p.Alpha.IsNil() || p.Alpha.IsNegative() {
+		return fmt.Errorf("AIMD alpha cannot be nil or negative: %s", p.Alpha)
+	}
+
+	if p.Beta.IsNil() || p.Beta.IsNegative() || p.Beta.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("AIMD beta must be between 0 and 1: %s", p.Beta)
+	}
+
+	if p.Gamma.IsNil() || p.Gamma.IsNegative() || p.Gamma.GT(math.LegacyNewDecWithPrec(5, 1)) {
+		return fmt.Errorf("AIMD gamma must be between 0 and 0.5: %s", p.Gamma)
+	}
+
+	if p.MinLearningRate.IsNil() || !p.MinLearningRate.IsPositive() {
+		return fmt.Errorf("AIMD min learning rate must be positive: %s", p.MinLearningRate)
+	}
+
+	if p.MaxLearningRate.IsNil() || p.MaxLearningRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("AIMD max learning rate cannot be nil or greater than 1: %s", p.MaxLearningRate)
+	}
+
+	if p.MinLearningRate.GT(p.MaxLearningRate) {
+		return fmt.Errorf(
+			"AIMD min learning rate cannot be greater than the max learning rate: %s > %s",
+			p.MinLearningRate, p.MaxLearningRate,
+		)
+	}
+
+	if p.Window == 0 {
+		return fmt.Errorf("AIMD window cannot be 0")
+	}
+
+	return nil
+}
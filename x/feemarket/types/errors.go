@@ -0,0 +1,20 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrInvalidFeeDenom = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrInvalidConversionRate
+)
+
+var (
+	// ErrInvalidFeeDenom returns an error if the denom is not accepted to pay the transaction fees.
+	ErrInvalidFeeDenom = errorsmod.Register(ModuleName, codeErrInvalidFeeDenom, "fee denom is not accepted")
+
+	// ErrInvalidConversionRate returns an error if the conversion rate of a fee denom cannot be obtained.
+	ErrInvalidConversionRate = errorsmod.Register(ModuleName, codeErrInvalidConversionRate, "invalid fee denom conversion rate")
+)
@@ -4,7 +4,19 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket        = "fee_market"
+	EventTypeDistributeTxFees = "distribute_tx_fees"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee      = "base_fee"
+	AttributeKeyLearningRate = "learning_rate"
+	AttributeKeyTxClass      = "tx_class"
+	AttributeKeyPortion      = "portion"
+)
+
+// fee split portions
+const (
+	PortionBurn          = "burn"
+	PortionCommunityPool = "community_pool"
+	PortionValidators    = "validators"
+	PortionDevelopers    = "developers"
 )
@@ -0,0 +1,31 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeDenom creates a new accepted fee denom with the given conversion rate.
+func NewFeeDenom(denom string, conversionRate math.LegacyDec) FeeDenom {
+	return FeeDenom{
+		Denom:          denom,
+		ConversionRate: conversionRate,
+	}
+}
+
+// Validate performs a stateless validation of the accepted fee denom.
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	if fd.ConversionRate.IsNil() || !fd.ConversionRate.IsPositive() {
+		return fmt.Errorf("conversion rate of fee denom %s must be positive: %s", fd.Denom, fd.ConversionRate)
+	}
+
+	return nil
+}
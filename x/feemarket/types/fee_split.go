@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TxClassCosmos defines the class of the transactions that don't contain
+	// Ethereum messages.
+	TxClassCosmos = "cosmos"
+	// TxClassEVM defines the class of the Ethereum transactions.
+	TxClassEVM = "evm"
+)
+
+// NewFeeSplit creates a new FeeSplit instance
+func NewFeeSplit(burn, communityPool, validators, developers math.LegacyDec) FeeSplit {
+	return FeeSplit{
+		Burn:          burn,
+		CommunityPool: communityPool,
+		Validators:    validators,
+		Developers:    developers,
+	}
+}
+
+// DefaultCosmosFeeSplit returns the default fee split of the Cosmos
+// transactions, where all the fees are burned.
+func DefaultCosmosFeeSplit() FeeSplit {
+	return NewFeeSplit(math.LegacyOneDec(), math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+}
+
+// DefaultEVMFeeSplit returns the default fee split of the EVM transactions,
+// where all the fees are kept by the validators.
+func DefaultEVMFeeSplit() FeeSplit {
+	return NewFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyZeroDec())
+}
+
+// IsEmpty returns true if none of the shares of the fee split is set, which is
+// the case for the params stored before the fee split was introduced. An empty
+// fee split is never valid, as its shares don't add up to 1.
+func (fs FeeSplit) IsEmpty() bool {
+	for _, share := range []math.LegacyDec{fs.Burn, fs.CommunityPool, fs.Validators, fs.Developers} {
+		if !share.IsNil() && !share.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate performs a stateless validation of the fee split. Each share must be
+// between 0 and 1 and the shares must add up to 1.
+func (fs FeeSplit) Validate() error {
+	shares := []struct {
+		name  string
+		value math.LegacyDec
+	}{
+		{"burn", fs.Burn},
+		{"community pool", fs.CommunityPool},
+		{"validators", fs.Validators},
+		{"developers", fs.Developers},
+	}
+
+	total := math.LegacyZeroDec()
+	for _, share := range shares {
+		if share.value.IsNil() || share.value.IsNegative() || share.value.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("%s share must be between 0 and 1: %s", share.name, share.value)
+		}
+		total = total.Add(share.value)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("fee split shares must add up to 1: %s", total)
+	}
+
+	return nil
+}
+
+// FeeSplitCoins defines the portions of the transaction fees computed from a
+// FeeSplit.
+type FeeSplitCoins struct {
+	Burn          sdk.Coins
+	CommunityPool sdk.Coins
+	Validators    sdk.Coins
+	Developers    sdk.Coins
+}
+
+// Split splits the given fees according to the fee split shares. The burned,
+// community pool and developers portions are rounded down, so that the
+// validators portion receives the remainder.
+func (fs FeeSplit) Split(fees sdk.Coins) FeeSplitCoins {
+	var portions FeeSplitCoins
+	for _, fee := range fees {
+		burn := fs.Burn.MulInt(fee.Amount).TruncateInt()
+		communityPool := fs.CommunityPool.MulInt(fee.Amount).TruncateInt()
+		developers := fs.Developers.MulInt(fee.Amount).TruncateInt()
+		validators := fee.Amount.Sub(burn).Sub(communityPool).Sub(developers)
+
+		portions.Burn = portions.Burn.Add(sdk.NewCoin(fee.Denom, burn))
+		portions.CommunityPool = portions.CommunityPool.Add(sdk.NewCoin(fee.Denom, communityPool))
+		portions.Validators = portions.Validators.Add(sdk.NewCoin(fee.Denom, validators))
+		portions.Developers = portions.Developers.Add(sdk.NewCoin(fee.Denom, developers))
+	}
+
+	return portions
+}
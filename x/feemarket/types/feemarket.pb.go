@@ -24,6 +24,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeStrategy enumerates the algorithms used to update the base fee.
+type BaseFeeStrategy int32
+
+const (
+	// BASE_FEE_STRATEGY_EIP1559 defines the classic EIP-1559 update rule, where
+	// the base fee changes proportionally to the difference between the parent
+	// block gas and the gas target.
+	BASE_FEE_STRATEGY_EIP1559 BaseFeeStrategy = 0
+	// BASE_FEE_STRATEGY_AIMD defines the additive increase / multiplicative
+	// decrease update rule, where the learning rate of the EIP-1559 update is
+	// adjusted according to the block utilization over a window of blocks.
+	BASE_FEE_STRATEGY_AIMD BaseFeeStrategy = 1
+)
+
+var BaseFeeStrategy_name = map[int32]string{
+	0: "BASE_FEE_STRATEGY_EIP1559",
+	1: "BASE_FEE_STRATEGY_AIMD",
+}
+
+var BaseFeeStrategy_value = map[string]int32{
+	"BASE_FEE_STRATEGY_EIP1559": 0,
+	"BASE_FEE_STRATEGY_AIMD":    1,
+}
+
+func (x BaseFeeStrategy) String() string {
+	return proto.EnumName(BaseFeeStrategy_name, int32(x))
+}
+
+func (BaseFeeStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -43,6 +75,41 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// fee_denoms defines the denominations, other than the EVM denom, that are
+	// accepted to pay the transaction fees.
+	FeeDenoms []FeeDenom `protobuf:"bytes,9,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+	// base_fee_strategy defines the algorithm used to update the base fee
+	// between blocks.
+	BaseFeeStrategy BaseFeeStrategy `protobuf:"varint,10,opt,name=base_fee_strategy,json=baseFeeStrategy,proto3,enum=ethermint.feemarket.v1.BaseFeeStrategy" json:"base_fee_strategy,omitempty"`
+	// aimd_params defines the parameters of the AIMD base fee strategy.
+	AIMDParams AIMDParams `protobuf:"bytes,11,opt,name=aimd_params,json=aimdParams,proto3" json:"aimd_params"`
+	// min_base_fee defines the lower bound of the base fee. A zero value means
+	// that the base fee is only bounded by the min_gas_price.
+	MinBaseFee cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_base_fee"`
+	// max_base_fee defines the upper bound of the base fee. A zero value means
+	// that the base fee is unbounded.
+	MaxBaseFee cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_base_fee"`
+	// base_fee_history_size defines the number of blocks kept in the base fee
+	// history. A zero value disables the base fee history.
+	BaseFeeHistorySize uint64 `protobuf:"varint,14,opt,name=base_fee_history_size,json=baseFeeHistorySize,proto3" json:"base_fee_history_size,omitempty"`
+	// cosmos_fee_split defines how the fees paid by Cosmos transactions are
+	// distributed.
+	CosmosFeeSplit FeeSplit `protobuf:"bytes,15,opt,name=cosmos_fee_split,json=cosmosFeeSplit,proto3" json:"cosmos_fee_split"`
+	// evm_fee_split defines how the fees paid by EVM transactions are
+	// distributed.
+	EVMFeeSplit FeeSplit `protobuf:"bytes,16,opt,name=evm_fee_split,json=evmFeeSplit,proto3" json:"evm_fee_split"`
+	// contract_gas_price_multipliers defines the gas price multipliers of the
+	// contracts whose calls are discounted or surcharged.
+	ContractGasPriceMultipliers []ContractGasPriceMultiplier `protobuf:"bytes,17,rep,name=contract_gas_price_multipliers,json=contractGasPriceMultipliers,proto3" json:"contract_gas_price_multipliers"`
+	// target_block_gas defines the block gas targeted by the base fee update
+	// rules. A zero value means that the target is the EVM block gas limit
+	// divided by the elasticity_multiplier.
+	TargetBlockGas uint64 `protobuf:"varint,18,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// max_evm_block_gas defines the maximum gas that can be used by the EVM
+	// transactions of a block, so that the rest of the consensus max block gas
+	// is reserved to the Cosmos transactions. A zero value means that the EVM
+	// transactions are only bounded by the consensus max block gas.
+	MaxEVMBlockGas uint64 `protobuf:"varint,19,opt,name=max_evm_block_gas,json=maxEvmBlockGas,proto3" json:"max_evm_block_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +173,356 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetBaseFeeStrategy() BaseFeeStrategy {
+	if m != nil {
+		return m.BaseFeeStrategy
+	}
+	return BASE_FEE_STRATEGY_EIP1559
+}
+
+func (m *Params) GetAIMDParams() AIMDParams {
+	if m != nil {
+		return m.AIMDParams
+	}
+	return AIMDParams{}
+}
+
+func (m *Params) GetBaseFeeHistorySize() uint64 {
+	if m != nil {
+		return m.BaseFeeHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetCosmosFeeSplit() FeeSplit {
+	if m != nil {
+		return m.CosmosFeeSplit
+	}
+	return FeeSplit{}
+}
+
+func (m *Params) GetEVMFeeSplit() FeeSplit {
+	if m != nil {
+		return m.EVMFeeSplit
+	}
+	return FeeSplit{}
+}
+
+func (m *Params) GetContractGasPriceMultipliers() []ContractGasPriceMultiplier {
+	if m != nil {
+		return m.ContractGasPriceMultipliers
+	}
+	return nil
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxEVMBlockGas() uint64 {
+	if m != nil {
+		return m.MaxEVMBlockGas
+	}
+	return 0
+}
+
+// FeeSplit defines the shares of the transaction fees that are burned, sent to
+// the community pool, kept by the validators and paid to the developers of the
+// called contracts. The shares must add up to 1.
+type FeeSplit struct {
+	// burn is the share of the fees that is burned.
+	Burn cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=burn,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn"`
+	// community_pool is the share of the fees that is sent to the community pool.
+	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"`
+	// validators is the share of the fees that is kept by the fee collector and
+	// distributed to the validators.
+	Validators cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=validators,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators"`
+	// developers is the share of the fees that is paid to the withdrawer of the
+	// called contract when it is registered in x/revenue. It is kept by the
+	// validators otherwise.
+	Developers cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=developers,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"developers"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
+// ContractGasPriceMultiplier defines the multiplier applied to the gas price of
+// the EVM transactions that call a contract. The fees charged to the sender and
+// checked against the minimum gas prices are the ones of the multiplied gas
+// price, so that a multiplier lower than 1 discounts the calls to the contract
+// and a multiplier greater than 1 surcharges them.
+type ContractGasPriceMultiplier struct {
+	// contract is the hex address of the called contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// multiplier is the positive factor applied to the gas price
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *ContractGasPriceMultiplier) Reset()         { *m = ContractGasPriceMultiplier{} }
+func (m *ContractGasPriceMultiplier) String() string { return proto.CompactTextString(m) }
+func (*ContractGasPriceMultiplier) ProtoMessage()    {}
+func (*ContractGasPriceMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *ContractGasPriceMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractGasPriceMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractGasPriceMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractGasPriceMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractGasPriceMultiplier.Merge(m, src)
+}
+func (m *ContractGasPriceMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractGasPriceMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractGasPriceMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractGasPriceMultiplier proto.InternalMessageInfo
+
+func (m *ContractGasPriceMultiplier) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// AIMDParams defines the parameters of the additive increase / multiplicative
+// decrease (AIMD) base fee strategy.
+type AIMDParams struct {
+	// alpha is the amount that is added to the learning rate when the block
+	// utilization over the window is outside of the target range.
+	Alpha cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=alpha,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"alpha"`
+	// beta is the factor the learning rate is multiplied by when the block
+	// utilization over the window is within the target range.
+	Beta cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=beta,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"beta"`
+	// gamma defines the target range of the block utilization, which is
+	// [gamma, 1 - gamma].
+	Gamma cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=gamma,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gamma"`
+	// min_learning_rate is the lower bound of the learning rate.
+	MinLearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_learning_rate,json=minLearningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_learning_rate"`
+	// max_learning_rate is the upper bound of the learning rate.
+	MaxLearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_learning_rate,json=maxLearningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_learning_rate"`
+	// window is the number of blocks used to compute the block utilization.
+	Window uint64 `protobuf:"varint,6,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *AIMDParams) Reset()         { *m = AIMDParams{} }
+func (m *AIMDParams) String() string { return proto.CompactTextString(m) }
+func (*AIMDParams) ProtoMessage()    {}
+func (*AIMDParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{3}
+}
+func (m *AIMDParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AIMDParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AIMDParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AIMDParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AIMDParams.Merge(m, src)
+}
+func (m *AIMDParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *AIMDParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AIMDParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AIMDParams proto.InternalMessageInfo
+
+func (m *AIMDParams) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// FeeDenom defines a denomination that is accepted to pay the transaction fees
+// in place of the EVM denom.
+type FeeDenom struct {
+	// denom is the coin denomination accepted to pay the fees
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion_rate is the amount of the fee denom that is equivalent to one
+	// unit of the EVM denom, set by governance.
+	ConversionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"conversion_rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{4}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// BaseFeeHistoryEntry defines the base fee and the gas of a block that are kept
+// in the base fee history.
+type BaseFeeHistoryEntry struct {
+	// height is the block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the base fee of the block
+	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee"`
+	// gas_wanted is the block gas wanted used by the base fee calculation
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the gas consumed by the block
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *BaseFeeHistoryEntry) Reset()         { *m = BaseFeeHistoryEntry{} }
+func (m *BaseFeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeHistoryEntry) ProtoMessage()    {}
+func (*BaseFeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{5}
+}
+func (m *BaseFeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeHistoryEntry.Merge(m, src)
+}
+func (m *BaseFeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeHistoryEntry proto.InternalMessageInfo
+
+func (m *BaseFeeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BaseFeeHistoryEntry) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *BaseFeeHistoryEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.BaseFeeStrategy", BaseFeeStrategy_name, BaseFeeStrategy_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*FeeSplit)(nil), "ethermint.feemarket.v1.FeeSplit")
+	proto.RegisterType((*ContractGasPriceMultiplier)(nil), "ethermint.feemarket.v1.ContractGasPriceMultiplier")
+	proto.RegisterType((*AIMDParams)(nil), "ethermint.feemarket.v1.AIMDParams")
+	proto.RegisterType((*FeeDenom)(nil), "ethermint.feemarket.v1.FeeDenom")
+	proto.RegisterType((*BaseFeeHistoryEntry)(nil), "ethermint.feemarket.v1.BaseFeeHistoryEntry")
 }
 
 func init() {
@@ -115,33 +530,73 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xdb, 0x30,
-	0x14, 0xc7, 0xad, 0xa5, 0x4d, 0x5d, 0x75, 0x81, 0x20, 0xda, 0x61, 0x56, 0xe6, 0x9a, 0x15, 0x86,
-	0x0f, 0xc3, 0x26, 0x14, 0xc6, 0x2e, 0xbb, 0x64, 0xa5, 0xdd, 0xc6, 0x06, 0x9d, 0x8f, 0xbb, 0x08,
-	0xd9, 0x7d, 0xb1, 0x45, 0x2c, 0xc9, 0x58, 0x8a, 0x59, 0xbe, 0xc5, 0xbe, 0xc9, 0xbe, 0x46, 0x8e,
-	0x39, 0x8e, 0x1d, 0xc2, 0x48, 0xbe, 0xc8, 0x88, 0x9d, 0xd8, 0x81, 0x5d, 0x76, 0x11, 0xd2, 0xfb,
-	0xff, 0x7f, 0x7f, 0xde, 0x43, 0x0f, 0xbf, 0x02, 0x93, 0x41, 0x29, 0xb8, 0x34, 0xe1, 0x04, 0x40,
-	0xb0, 0x72, 0x0a, 0x26, 0xac, 0x46, 0xdd, 0x23, 0x28, 0x4a, 0x65, 0x14, 0x79, 0xd6, 0xfa, 0x82,
-	0x4e, 0xaa, 0x46, 0xcf, 0xcf, 0x53, 0x95, 0xaa, 0xda, 0x12, 0x6e, 0x6f, 0x8d, 0xfb, 0xe5, 0xcf,
-	0x1e, 0xee, 0x3f, 0xb0, 0x92, 0x09, 0x4d, 0x5c, 0x7c, 0x26, 0x15, 0x8d, 0x99, 0x06, 0x3a, 0x01,
-	0x70, 0x90, 0x87, 0x7c, 0x3b, 0x3a, 0x95, 0x6a, 0xcc, 0x34, 0xdc, 0x01, 0x90, 0x77, 0xf8, 0x72,
-	0x2f, 0xd2, 0x24, 0x63, 0x32, 0x05, 0xfa, 0x08, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xa5, 0xf3, 0xc4,
-	0x43, 0xfe, 0x20, 0x72, 0xe2, 0xc6, 0xfd, 0xbe, 0x36, 0xdc, 0x76, 0x3a, 0xb9, 0xc1, 0x17, 0x90,
-	0x33, 0x6d, 0x78, 0xc2, 0xcd, 0x9c, 0x8a, 0x59, 0x6e, 0x78, 0x91, 0x73, 0x28, 0x9d, 0x5e, 0x0d,
-	0x9e, 0x77, 0xe2, 0x97, 0x56, 0x23, 0xd7, 0x78, 0x00, 0x92, 0xc5, 0x39, 0xd0, 0x0c, 0x78, 0x9a,
-	0x19, 0xe7, 0xd8, 0x43, 0x7e, 0x2f, 0x7a, 0xda, 0x14, 0x3f, 0xd4, 0x35, 0xf2, 0x16, 0xdb, 0x6d,
-	0xd7, 0x7d, 0x0f, 0xf9, 0xa7, 0xe3, 0x17, 0x8b, 0xd5, 0x95, 0xf5, 0x7b, 0x75, 0x75, 0x91, 0x28,
-	0x2d, 0x94, 0xd6, 0x8f, 0xd3, 0x80, 0xab, 0x50, 0x30, 0x93, 0x05, 0x1f, 0xa5, 0x89, 0x4e, 0x76,
-	0x4d, 0x92, 0x7b, 0x3c, 0x10, 0x5c, 0xd2, 0x94, 0x69, 0x5a, 0x94, 0x3c, 0x01, 0xe7, 0xa4, 0xc6,
-	0xaf, 0x77, 0xf8, 0xe5, 0xbf, 0xf8, 0x67, 0x48, 0x59, 0x32, 0xbf, 0x85, 0x24, 0x3a, 0x13, 0x5c,
-	0xde, 0x33, 0xfd, 0xb0, 0xe5, 0xc8, 0x57, 0x4c, 0xf6, 0x41, 0x07, 0x93, 0xd9, 0xff, 0x9f, 0x36,
-	0x6c, 0xd2, 0xba, 0xd1, 0x3f, 0x1d, 0xd9, 0x47, 0xc3, 0xe3, 0x68, 0xc8, 0x25, 0x37, 0x9c, 0xe5,
-	0xed, 0xbf, 0x8c, 0xef, 0x16, 0x6b, 0x17, 0x2d, 0xd7, 0x2e, 0xfa, 0xb3, 0x76, 0xd1, 0x8f, 0x8d,
-	0x6b, 0x2d, 0x37, 0xae, 0xf5, 0x6b, 0xe3, 0x5a, 0xdf, 0x5e, 0xa7, 0xdc, 0x64, 0xb3, 0x38, 0x48,
-	0x94, 0x08, 0xa1, 0x12, 0x4a, 0xef, 0xce, 0x6a, 0xf4, 0x26, 0xfc, 0x7e, 0xb0, 0x34, 0x66, 0x5e,
-	0x80, 0x8e, 0xfb, 0xf5, 0x02, 0xdc, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xb6, 0x17, 0xf2,
-	0x58, 0x02, 0x00, 0x00,
+	// 1050 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0x82, 0x01, 0xfb, 0x19, 0x1b, 0x33, 0xfc, 0xd0, 0x06, 0x84, 0xb1, 0x1c, 0xa9, 0xb5,
+	0xaa, 0xca, 0x16, 0x44, 0x69, 0x9b, 0x43, 0x54, 0x61, 0x30, 0x84, 0x08, 0x54, 0xba, 0x4e, 0x13,
+	0xa5, 0x97, 0xd5, 0x78, 0x3d, 0xac, 0x47, 0xec, 0xcc, 0x58, 0x3b, 0x83, 0xb1, 0x23, 0xe5, 0xde,
+	0x63, 0xaf, 0x3d, 0x56, 0xfd, 0x67, 0x72, 0xcc, 0xb1, 0xea, 0x01, 0x55, 0x70, 0xef, 0xad, 0xf7,
+	0x6a, 0x76, 0xd7, 0xbb, 0x26, 0x14, 0xc5, 0xbe, 0x58, 0x9e, 0xf7, 0xde, 0xf7, 0xcd, 0xdb, 0xf7,
+	0xbd, 0x7d, 0x6f, 0xe1, 0x0b, 0xa2, 0xba, 0xc4, 0x67, 0x94, 0xab, 0xfa, 0x39, 0x21, 0x0c, 0xfb,
+	0x17, 0x44, 0xd5, 0xfb, 0x3b, 0xc9, 0xa1, 0xd6, 0xf3, 0x85, 0x12, 0x68, 0x3d, 0x8e, 0xab, 0x25,
+	0xae, 0xfe, 0xce, 0xc6, 0xaa, 0x2b, 0x5c, 0x11, 0x84, 0xd4, 0xf5, 0xbf, 0x30, 0xba, 0xf2, 0x4f,
+	0x16, 0xe6, 0xcf, 0xb0, 0x8f, 0x99, 0x44, 0x25, 0xc8, 0x71, 0x61, 0xb7, 0xb1, 0x24, 0xf6, 0x39,
+	0x21, 0xa6, 0x51, 0x36, 0xaa, 0x19, 0x2b, 0xcb, 0x45, 0x03, 0x4b, 0x72, 0x48, 0x08, 0x7a, 0x0e,
+	0x9b, 0x23, 0xa7, 0xed, 0x74, 0x31, 0x77, 0x89, 0xdd, 0x21, 0x5c, 0x30, 0xca, 0xb1, 0x12, 0xbe,
+	0x39, 0x53, 0x36, 0xaa, 0x79, 0xcb, 0x6c, 0x87, 0xd1, 0xfb, 0x41, 0xc0, 0x41, 0xe2, 0x47, 0x4f,
+	0x60, 0x8d, 0x78, 0x58, 0x2a, 0xea, 0x50, 0x35, 0xb4, 0xd9, 0xa5, 0xa7, 0x68, 0xcf, 0xa3, 0xc4,
+	0x37, 0x67, 0x03, 0xe0, 0x6a, 0xe2, 0x3c, 0x8d, 0x7d, 0xe8, 0x31, 0xe4, 0x09, 0xc7, 0x6d, 0x8f,
+	0xd8, 0x5d, 0x42, 0xdd, 0xae, 0x32, 0xe7, 0xca, 0x46, 0x75, 0xd6, 0x5a, 0x0c, 0x8d, 0x2f, 0x02,
+	0x1b, 0xfa, 0x0e, 0x32, 0x71, 0xd6, 0xf3, 0x65, 0xa3, 0x9a, 0x6d, 0x6c, 0x7d, 0xb8, 0xde, 0x4e,
+	0xfd, 0x75, 0xbd, 0xbd, 0xe6, 0x08, 0xc9, 0x84, 0x94, 0x9d, 0x8b, 0x1a, 0x15, 0x75, 0x86, 0x55,
+	0xb7, 0x76, 0xcc, 0x95, 0xb5, 0x10, 0x25, 0x89, 0x8e, 0x20, 0xcf, 0x28, 0xb7, 0x5d, 0x2c, 0xed,
+	0x9e, 0x4f, 0x1d, 0x62, 0x2e, 0x04, 0xf0, 0xc7, 0x11, 0x7c, 0xf3, 0x3e, 0xfc, 0x84, 0xb8, 0xd8,
+	0x19, 0x1e, 0x10, 0xc7, 0xca, 0x31, 0xca, 0x8f, 0xb0, 0x3c, 0xd3, 0x38, 0xf4, 0x23, 0xa0, 0x11,
+	0xd1, 0xd8, 0x93, 0x65, 0x26, 0x67, 0x2b, 0x86, 0x6c, 0x63, 0x8f, 0xde, 0x04, 0x38, 0x27, 0x51,
+	0x89, 0xa5, 0x99, 0x2d, 0xcf, 0x56, 0x73, 0xbb, 0xe5, 0xda, 0xff, 0x8b, 0x5b, 0x3b, 0x24, 0x61,
+	0xad, 0x1b, 0x69, 0x7d, 0x99, 0x95, 0x3d, 0x8f, 0xce, 0x12, 0xb5, 0x60, 0x39, 0x56, 0x4d, 0x2a,
+	0x1f, 0x2b, 0xe2, 0x0e, 0x4d, 0x28, 0x1b, 0xd5, 0xc2, 0xee, 0x97, 0x0f, 0xb1, 0x45, 0x8a, 0xb7,
+	0xa2, 0x70, 0x6b, 0xa9, 0x7d, 0xd7, 0x80, 0xde, 0x40, 0x0e, 0x53, 0xd6, 0xb1, 0x7b, 0x41, 0xe7,
+	0x98, 0xb9, 0xb2, 0x51, 0xcd, 0xed, 0x56, 0x1e, 0xa2, 0xdb, 0x3b, 0x3e, 0x3d, 0x08, 0x7b, 0xac,
+	0x81, 0x74, 0x7a, 0x37, 0xd7, 0xdb, 0x90, 0xd8, 0x2c, 0xd0, 0x54, 0xe1, 0x7f, 0xf4, 0x3d, 0x2c,
+	0xea, 0x3a, 0xc6, 0x72, 0x2e, 0x4e, 0x22, 0x27, 0x30, 0xca, 0x47, 0x4d, 0xaa, 0x09, 0xf0, 0x20,
+	0x21, 0xc8, 0x4f, 0x46, 0x80, 0x07, 0x23, 0x82, 0x1d, 0x58, 0x8b, 0xeb, 0xd5, 0xa5, 0x52, 0x09,
+	0x7f, 0x68, 0x4b, 0xfa, 0x8e, 0x98, 0x85, 0xb2, 0x51, 0x4d, 0x5b, 0x28, 0x2a, 0xc5, 0x8b, 0xd0,
+	0xd5, 0xa2, 0xef, 0x08, 0x3a, 0x83, 0x62, 0xc8, 0x1b, 0x16, 0xb9, 0xe7, 0x51, 0x65, 0x2e, 0x95,
+	0x8d, 0xcf, 0xe8, 0xd5, 0xd2, 0x71, 0x91, 0x5e, 0x85, 0x10, 0x3f, 0xb2, 0xa2, 0xb7, 0x90, 0x27,
+	0x7d, 0x36, 0x46, 0x57, 0x9c, 0x90, 0x6e, 0x25, 0xaa, 0x6f, 0xae, 0xf9, 0xfa, 0x74, 0x64, 0xb4,
+	0x72, 0xa4, 0xcf, 0x62, 0xea, 0xf7, 0x50, 0x72, 0x04, 0x57, 0x3e, 0x76, 0x54, 0xd2, 0xf7, 0x63,
+	0x4d, 0x2b, 0xcd, 0xe5, 0xa0, 0xd5, 0x76, 0x1f, 0xba, 0x6b, 0x3f, 0x42, 0x8f, 0x7a, 0x3f, 0x69,
+	0xd9, 0xe8, 0x61, 0x36, 0x9d, 0x07, 0x23, 0x24, 0xaa, 0x42, 0x51, 0x61, 0xdf, 0x25, 0xca, 0x6e,
+	0x7b, 0xc2, 0xb9, 0xd0, 0x29, 0x98, 0x28, 0xa8, 0x6c, 0x21, 0xb4, 0x37, 0xb4, 0xf9, 0x08, 0x4b,
+	0xf4, 0x1c, 0x96, 0xb5, 0x92, 0xba, 0x0e, 0x49, 0xe8, 0x8a, 0x0e, 0x6d, 0xa0, 0x9b, 0xeb, 0xed,
+	0xc2, 0x29, 0x1e, 0x34, 0x5f, 0x9f, 0x8e, 0xc2, 0xad, 0x02, 0xc3, 0x83, 0x66, 0x9f, 0x8d, 0xce,
+	0x2f, 0xd3, 0x99, 0x74, 0x71, 0xce, 0x2a, 0x52, 0x4e, 0x15, 0xc5, 0x5e, 0xdc, 0x10, 0x95, 0xdf,
+	0x66, 0x20, 0x13, 0x17, 0xe3, 0x5b, 0x48, 0xb7, 0x2f, 0x7d, 0x6e, 0x1a, 0x93, 0xbf, 0xa8, 0x01,
+	0x00, 0xbd, 0x84, 0x82, 0x23, 0x18, 0xbb, 0xe4, 0x7a, 0x96, 0xf5, 0x84, 0xf0, 0xcc, 0x99, 0xc9,
+	0x29, 0xf2, 0x31, 0xf4, 0x4c, 0x08, 0x0f, 0xed, 0x03, 0xf4, 0xb1, 0x47, 0x3b, 0x7a, 0x4a, 0x4a,
+	0x73, 0x76, 0x72, 0x9e, 0x31, 0x98, 0x26, 0xe9, 0x90, 0x3e, 0xf1, 0x44, 0x4f, 0x4b, 0x98, 0x9e,
+	0x82, 0x24, 0x81, 0x55, 0xde, 0xc3, 0xc6, 0xc3, 0xea, 0xa2, 0x0d, 0xc8, 0x8c, 0x94, 0x0d, 0x0b,
+	0x66, 0xc5, 0x67, 0x7d, 0xfd, 0xd8, 0xdc, 0x9b, 0xa2, 0x16, 0x63, 0xb0, 0xca, 0xbf, 0x33, 0x30,
+	0x36, 0x17, 0xd0, 0x33, 0x98, 0xc3, 0x5e, 0xaf, 0x8b, 0xa7, 0x51, 0x27, 0x44, 0x04, 0xba, 0x12,
+	0x85, 0xa7, 0x49, 0x24, 0x00, 0xe8, 0x3b, 0x5d, 0xcc, 0x18, 0x9e, 0x46, 0x86, 0x10, 0x81, 0x7e,
+	0x80, 0x65, 0x3d, 0xba, 0x3c, 0x82, 0x7d, 0x4e, 0xb9, 0x6b, 0xeb, 0x51, 0x39, 0x8d, 0x10, 0x4b,
+	0x8c, 0xf2, 0x93, 0x08, 0x6c, 0x61, 0x45, 0x02, 0x42, 0x3c, 0xf8, 0x84, 0x70, 0x6e, 0x1a, 0x42,
+	0x3c, 0xb8, 0x43, 0xb8, 0x0e, 0xf3, 0x57, 0x94, 0x77, 0xc4, 0x55, 0xb0, 0x25, 0xd3, 0x56, 0x74,
+	0xaa, 0xf0, 0xe0, 0x8d, 0x08, 0xf6, 0x05, 0x5a, 0x85, 0xb9, 0x60, 0xe3, 0x44, 0x0a, 0x87, 0x07,
+	0x74, 0x02, 0x4b, 0x8e, 0xe0, 0x7d, 0xe2, 0x4b, 0x2a, 0x78, 0x98, 0xc8, 0x14, 0xa5, 0x2d, 0x24,
+	0x58, 0x9d, 0x47, 0xe5, 0x77, 0x03, 0x56, 0x1a, 0x77, 0xc6, 0x68, 0x93, 0x2b, 0x7f, 0xa8, 0xf3,
+	0x8b, 0xb6, 0xbc, 0x11, 0x6c, 0xf9, 0xf9, 0xee, 0xfd, 0xfd, 0x3e, 0x33, 0xd5, 0x7e, 0xdf, 0x02,
+	0xd0, 0x33, 0xee, 0x0a, 0x73, 0x45, 0x3a, 0x81, 0xa6, 0x69, 0x2b, 0xeb, 0x62, 0xf9, 0x26, 0x30,
+	0xa0, 0x47, 0x90, 0xd1, 0xee, 0x4b, 0x49, 0x3a, 0x81, 0x52, 0x69, 0x6b, 0xc1, 0xc5, 0xf2, 0x27,
+	0x49, 0x3a, 0x5f, 0x59, 0xb0, 0xf4, 0xc9, 0x16, 0x44, 0x5b, 0xf0, 0xa8, 0xb1, 0xd7, 0x6a, 0xda,
+	0x87, 0xcd, 0xa6, 0xdd, 0x7a, 0x65, 0xed, 0xbd, 0x6a, 0x1e, 0xbd, 0xb5, 0x9b, 0xc7, 0x67, 0x3b,
+	0x4f, 0x9f, 0x3e, 0x2b, 0xa6, 0xd0, 0x06, 0xac, 0xdf, 0x77, 0xeb, 0x76, 0x2e, 0x1a, 0x1b, 0xe9,
+	0x5f, 0xfe, 0x28, 0xa5, 0x1a, 0x87, 0x1f, 0x6e, 0x4a, 0xc6, 0xc7, 0x9b, 0x92, 0xf1, 0xf7, 0x4d,
+	0xc9, 0xf8, 0xf5, 0xb6, 0x94, 0xfa, 0x78, 0x5b, 0x4a, 0xfd, 0x79, 0x5b, 0x4a, 0xfd, 0xfc, 0xb5,
+	0x4b, 0x55, 0xf7, 0xb2, 0x5d, 0x73, 0x04, 0xab, 0x93, 0x3e, 0x13, 0x32, 0xfa, 0xed, 0xef, 0x7c,
+	0x53, 0x1f, 0x8c, 0x7d, 0xee, 0xa9, 0x61, 0x8f, 0xc8, 0xf6, 0x7c, 0xf0, 0xe9, 0xf6, 0xe4, 0xbf,
+	0x01, 0x00, 0xfe, 0x36, 0xc1, 0x9c, 0x12, 0x0a, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -164,6 +619,112 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxEVMBlockGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MaxEVMBlockGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ContractGasPriceMultipliers) > 0 {
+		for iNdEx := len(m.ContractGasPriceMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractGasPriceMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	{
+		size, err := m.EVMFeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size, err := m.CosmosFeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.BaseFeeHistorySize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeHistorySize))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.AIMDParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.BaseFeeStrategy != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeStrategy))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -222,13 +783,282 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeemarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Developers.Size()
+		i -= size
+		if _, err := m.Developers.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Validators.Size()
+		i -= size
+		if _, err := m.Validators.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractGasPriceMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractGasPriceMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractGasPriceMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AIMDParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AIMDParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AIMDParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxLearningRate.Size()
+		i -= size
+		if _, err := m.MaxLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinLearningRate.Size()
+		i -= size
+		if _, err := m.MinLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Gamma.Size()
+		i -= size
+		if _, err := m.Gamma.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Beta.Size()
+		i -= size
+		if _, err := m.Beta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Alpha.Size()
+		i -= size
+		if _, err := m.Alpha.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -257,49 +1087,1082 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.BaseFeeStrategy != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeStrategy))
+	}
+	l = m.AIMDParams.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeHistorySize != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeHistorySize))
+	}
+	l = m.CosmosFeeSplit.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.EVMFeeSplit.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	if len(m.ContractGasPriceMultipliers) > 0 {
+		for _, e := range m.ContractGasPriceMultipliers {
+			l = e.Size()
+			n += 2 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.TargetBlockGas != 0 {
+		n += 2 + sovFeemarket(uint64(m.TargetBlockGas))
+	}
+	if m.MaxEVMBlockGas != 0 {
+		n += 2 + sovFeemarket(uint64(m.MaxEVMBlockGas))
+	}
 	return n
 }
 
-func sovFeemarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burn.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Validators.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Developers.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
 }
-func sozFeemarket(x uint64) (n int) {
-	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ContractGasPriceMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFeemarket
+
+func (m *AIMDParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Alpha.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Beta.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Gamma.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinLearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxLearningRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovFeemarket(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BaseFeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoBaseFee = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+			}
+			m.EnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeStrategy", wireType)
+			}
+			m.BaseFeeStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeStrategy |= BaseFeeStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AIMDParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AIMDParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeHistorySize", wireType)
+			}
+			m.BaseFeeHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosFeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CosmosFeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EVMFeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EVMFeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractGasPriceMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractGasPriceMultipliers = append(m.ContractGasPriceMultipliers, ContractGasPriceMultiplier{})
+			if err := m.ContractGasPriceMultipliers[len(m.ContractGasPriceMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEVMBlockGas", wireType)
+			}
+			m.MaxEVMBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEVMBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Developers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Developers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractGasPriceMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractGasPriceMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractGasPriceMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AIMDParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AIMDParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AIMDParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alpha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Alpha.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gamma", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -309,17 +2172,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoBaseFee = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
 			}
-			m.BaseFeeChangeDenominator = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gamma.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLearningRate", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -329,16 +2206,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
 			}
-			m.ElasticityMultiplier = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLearningRate", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -348,16 +2240,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			m.EnableHeight = 0
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -367,14 +2274,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EnableHeight |= int64(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -402,13 +2359,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -436,13 +2391,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -470,10 +2494,48 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewContractGasPriceMultiplier creates a new gas price multiplier for the calls
+// to the given contract.
+func NewContractGasPriceMultiplier(contract common.Address, multiplier math.LegacyDec) ContractGasPriceMultiplier {
+	return ContractGasPriceMultiplier{
+		Contract:   contract.Hex(),
+		Multiplier: multiplier,
+	}
+}
+
+// Validate performs a stateless validation of the contract gas price multiplier.
+func (cm ContractGasPriceMultiplier) Validate() error {
+	if !common.IsHexAddress(cm.Contract) {
+		return fmt.Errorf("invalid contract address: %s", cm.Contract)
+	}
+
+	if cm.Multiplier.IsNil() || !cm.Multiplier.IsPositive() {
+		return fmt.Errorf("gas price multiplier of contract %s must be positive: %s", cm.Contract, cm.Multiplier)
+	}
+
+	return nil
+}
+
+// GetGasPriceMultiplier returns the gas price multiplier of the calls to the
+// given contract. It returns 1 for the contracts without a multiplier and for
+// the contract creations (i.e. a nil address).
+func (p Params) GetGasPriceMultiplier(contract *common.Address) math.LegacyDec {
+	if contract == nil {
+		return math.LegacyOneDec()
+	}
+
+	for _, cm := range p.ContractGasPriceMultipliers {
+		if common.HexToAddress(cm.Contract) == *contract {
+			return cm.Multiplier
+		}
+	}
+	return math.LegacyOneDec()
+}
+
+// ApplyGasPriceMultiplier returns the given fees multiplied by the gas price
+// multiplier. The result is rounded up so that the surcharged fees are never
+// lower than the multiplied ones.
+func ApplyGasPriceMultiplier(fees sdk.Coins, multiplier math.LegacyDec) sdk.Coins {
+	if multiplier.Equal(math.LegacyOneDec()) {
+		return fees
+	}
+
+	multiplied := make(sdk.Coins, 0, len(fees))
+	for _, fee := range fees {
+		amount := math.LegacyNewDecFromInt(fee.Amount).Mul(multiplier).Ceil().TruncateInt()
+		multiplied = append(multiplied, sdk.Coin{Denom: fee.Denom, Amount: amount})
+	}
+	return multiplied
+}
+
+func validateContractGasPriceMultipliers(i interface{}) error {
+	multipliers, ok := i.([]ContractGasPriceMultiplier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenContracts := make(map[common.Address]bool, len(multipliers))
+	for _, cm := range multipliers {
+		if err := cm.Validate(); err != nil {
+			return err
+		}
+
+		contract := common.HexToAddress(cm.Contract)
+		if seenContracts[contract] {
+			return fmt.Errorf("duplicate gas price multiplier for contract %s", cm.Contract)
+		}
+		seenContracts[contract] = true
+	}
+
+	return nil
+}
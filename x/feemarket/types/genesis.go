@@ -2,6 +2,8 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
 package types
 
+import "fmt"
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -21,5 +23,34 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if gs.LearningRate != nil && (gs.LearningRate.IsNil() || gs.LearningRate.IsNegative()) {
+		return fmt.Errorf("learning rate cannot be nil or negative: %v", gs.LearningRate)
+	}
+
+	windowHeights := make(map[int64]bool, len(gs.WindowBlockGas))
+	for _, blockGas := range gs.WindowBlockGas {
+		if blockGas.Height <= 0 {
+			return fmt.Errorf("window block gas height must be positive: %d", blockGas.Height)
+		}
+		if windowHeights[blockGas.Height] {
+			return fmt.Errorf("duplicate window block gas height: %d", blockGas.Height)
+		}
+		windowHeights[blockGas.Height] = true
+	}
+
+	historyHeights := make(map[int64]bool, len(gs.BaseFeeHistory))
+	for _, entry := range gs.BaseFeeHistory {
+		if entry.Height <= 0 {
+			return fmt.Errorf("base fee history height must be positive: %d", entry.Height)
+		}
+		if historyHeights[entry.Height] {
+			return fmt.Errorf("duplicate base fee history height: %d", entry.Height)
+		}
+		if entry.BaseFee.IsNil() || entry.BaseFee.IsNegative() {
+			return fmt.Errorf("base fee history base fee cannot be nil or negative at height %d", entry.Height)
+		}
+		historyHeights[entry.Height] = true
+	}
+
 	return gs.Params.Validate()
 }
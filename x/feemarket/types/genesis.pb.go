@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +31,13 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// learning_rate is the current learning rate of the AIMD base fee strategy.
+	// Empty if it hasn't been set yet.
+	LearningRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate,omitempty"`
+	// window_block_gas is the gas of the blocks recorded for the AIMD window.
+	WindowBlockGas []WindowBlockGas `protobuf:"bytes,5,rep,name=window_block_gas,json=windowBlockGas,proto3" json:"window_block_gas"`
+	// base_fee_history is the base fee and the block gas of the latest blocks.
+	BaseFeeHistory []BaseFeeHistoryEntry `protobuf:"bytes,6,rep,name=base_fee_history,json=baseFeeHistory,proto3" json:"base_fee_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,8 +87,78 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetWindowBlockGas() []WindowBlockGas {
+	if m != nil {
+		return m.WindowBlockGas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBaseFeeHistory() []BaseFeeHistoryEntry {
+	if m != nil {
+		return m.BaseFeeHistory
+	}
+	return nil
+}
+
+// WindowBlockGas defines the gas of a block recorded for the AIMD window.
+type WindowBlockGas struct {
+	// height is the block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas is the gas of the block used by the AIMD learning rate update
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *WindowBlockGas) Reset()         { *m = WindowBlockGas{} }
+func (m *WindowBlockGas) String() string { return proto.CompactTextString(m) }
+func (*WindowBlockGas) ProtoMessage()    {}
+func (*WindowBlockGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6241c21661288629, []int{1}
+}
+func (m *WindowBlockGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowBlockGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowBlockGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindowBlockGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowBlockGas.Merge(m, src)
+}
+func (m *WindowBlockGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindowBlockGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowBlockGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowBlockGas proto.InternalMessageInfo
+
+func (m *WindowBlockGas) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *WindowBlockGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.feemarket.v1.GenesisState")
+	proto.RegisterType((*WindowBlockGas)(nil), "ethermint.feemarket.v1.WindowBlockGas")
 }
 
 func init() {
@@ -88,23 +166,33 @@ func init() {
 }
 
 var fileDescriptor_6241c21661288629 = []byte{
-	// 246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x83, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x52, 0xc3, 0xa1, 0x1b, 0xa1,
-	0x08, 0xac, 0x5f, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a,
-	0x95, 0x5c, 0x3c, 0xee, 0x10, 0x6b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x6c, 0xb8, 0xd8, 0x0a,
-	0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0xb0, 0x5b,
-	0xab, 0x17, 0x00, 0x56, 0xe5, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8f, 0x90, 0x34,
-	0x17, 0x67, 0x52, 0x4e, 0x7e, 0x72, 0x76, 0x7c, 0x7a, 0x62, 0xb1, 0x04, 0xb3, 0x02, 0xa3, 0x06,
-	0x4b, 0x10, 0x07, 0x58, 0xc0, 0x3d, 0xb1, 0xd8, 0x8b, 0x85, 0x83, 0x49, 0x80, 0x39, 0x88, 0x23,
-	0x29, 0xb1, 0x38, 0x35, 0x3e, 0x2d, 0x35, 0xd5, 0xc9, 0xed, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x74, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x53, 0xcb, 0x72, 0xf3, 0x8b, 0xa1, 0x64, 0x99, 0xa1, 0x99, 0x7e, 0x05, 0x92, 0x2f, 0x4b, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x3e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xfb,
-	0x01, 0x5f, 0x47, 0x01, 0x00, 0x00,
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4f, 0x6b, 0xd4, 0x40,
+	0x14, 0x4f, 0x36, 0x31, 0x6c, 0xa7, 0xb5, 0x84, 0x20, 0x25, 0xb4, 0x90, 0x0d, 0x45, 0x4a, 0x40,
+	0x99, 0xb0, 0x15, 0x3c, 0x88, 0xa7, 0x50, 0x5b, 0x11, 0x0f, 0x12, 0x41, 0x41, 0x0f, 0x61, 0x92,
+	0xbe, 0x4e, 0x86, 0x6d, 0x32, 0xcb, 0xcc, 0x98, 0x35, 0xdf, 0xc2, 0x8f, 0xd5, 0x63, 0x8f, 0xe2,
+	0xa1, 0xc8, 0xee, 0x57, 0xf0, 0x03, 0x48, 0x66, 0xb3, 0xbb, 0x2e, 0xb8, 0x97, 0xe1, 0xcd, 0x9b,
+	0xdf, 0xbf, 0x79, 0x3c, 0xf4, 0x14, 0x54, 0x09, 0xa2, 0x62, 0xb5, 0x8a, 0x6f, 0x00, 0x2a, 0x22,
+	0x26, 0xa0, 0xe2, 0x66, 0x1c, 0x53, 0xa8, 0x41, 0x32, 0x89, 0xa7, 0x82, 0x2b, 0xee, 0x1d, 0xad,
+	0x51, 0x78, 0x8d, 0xc2, 0xcd, 0xf8, 0xf8, 0x6c, 0x07, 0x7b, 0x03, 0xd2, 0xfc, 0xe3, 0x27, 0x94,
+	0x53, 0xae, 0xcb, 0xb8, 0xab, 0x96, 0xdd, 0xd3, 0x3f, 0x03, 0x74, 0x70, 0xb5, 0xf4, 0xf9, 0xa8,
+	0x88, 0x02, 0xef, 0x35, 0x72, 0xa6, 0x44, 0x90, 0x4a, 0xfa, 0x66, 0x68, 0x46, 0xfb, 0xe7, 0x01,
+	0xfe, 0xbf, 0x2f, 0xfe, 0xa0, 0x51, 0x89, 0x7d, 0xf7, 0x30, 0x32, 0xd2, 0x9e, 0xe3, 0x9d, 0xa0,
+	0xbd, 0xfc, 0x96, 0x17, 0x93, 0x8c, 0x12, 0xe9, 0x5b, 0xa1, 0x19, 0xd9, 0xe9, 0x50, 0x37, 0xae,
+	0x88, 0xf4, 0x2e, 0xd0, 0xe3, 0x5b, 0x20, 0xa2, 0x66, 0x35, 0xcd, 0x04, 0x51, 0xe0, 0xdb, 0xa1,
+	0x19, 0xed, 0x25, 0xa3, 0x5f, 0x0f, 0xa3, 0x93, 0x82, 0xcb, 0x8a, 0x4b, 0x79, 0x3d, 0xc1, 0x8c,
+	0xc7, 0x15, 0x51, 0x25, 0x7e, 0x0f, 0x94, 0x14, 0xed, 0x05, 0x14, 0xe9, 0xc1, 0x8a, 0x95, 0x76,
+	0x01, 0x3f, 0x21, 0x77, 0xc6, 0xea, 0x6b, 0x3e, 0xcb, 0x36, 0x4e, 0x8f, 0x42, 0x2b, 0xda, 0x3f,
+	0x3f, 0xdb, 0x15, 0xf5, 0xb3, 0xc6, 0x27, 0x7d, 0x8e, 0x3e, 0xf2, 0xe1, 0x6c, 0xab, 0xeb, 0x7d,
+	0x45, 0x6e, 0x4e, 0x24, 0x64, 0x37, 0x00, 0x59, 0xc9, 0xa4, 0xe2, 0xa2, 0xf5, 0x1d, 0xad, 0xfb,
+	0x6c, 0x97, 0x6e, 0x42, 0x24, 0x5c, 0x02, 0xbc, 0x5d, 0xa2, 0xdf, 0xd4, 0x4a, 0xb4, 0x2b, 0xf1,
+	0x7c, 0xeb, 0xe9, 0x9d, 0x3d, 0x1c, 0xb8, 0x56, 0x3a, 0x5c, 0x19, 0x9c, 0xbe, 0x42, 0x87, 0xdb,
+	0xa1, 0xbc, 0x23, 0xe4, 0x94, 0xc0, 0x68, 0xa9, 0xf4, 0xdc, 0xad, 0xb4, 0xbf, 0x79, 0x2e, 0xb2,
+	0xba, 0x1f, 0x0e, 0xf4, 0x2c, 0xbb, 0x32, 0xb9, 0xbc, 0x9b, 0x07, 0xe6, 0xfd, 0x3c, 0x30, 0x7f,
+	0xcf, 0x03, 0xf3, 0xc7, 0x22, 0x30, 0xee, 0x17, 0x81, 0xf1, 0x73, 0x11, 0x18, 0x5f, 0x9e, 0x53,
+	0xa6, 0xca, 0x6f, 0x39, 0x2e, 0x78, 0x15, 0x43, 0x53, 0x71, 0xd9, 0x9f, 0xcd, 0xf8, 0x65, 0xfc,
+	0xfd, 0x9f, 0xed, 0x50, 0xed, 0x14, 0x64, 0xee, 0xe8, 0x0d, 0x78, 0xf1, 0x77, 0x00, 0xdd, 0xb9,
+	0x44, 0xba, 0x7f, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +215,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BaseFeeHistory) > 0 {
+		for iNdEx := len(m.BaseFeeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFeeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.WindowBlockGas) > 0 {
+		for iNdEx := len(m.WindowBlockGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WindowBlockGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LearningRate != nil {
+		{
+			size := m.LearningRate.Size()
+			i -= size
+			if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -145,6 +273,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WindowBlockGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowBlockGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowBlockGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -167,6 +328,37 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if m.LearningRate != nil {
+		l = m.LearningRate.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.WindowBlockGas) > 0 {
+		for _, e := range m.WindowBlockGas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BaseFeeHistory) > 0 {
+		for _, e := range m.BaseFeeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WindowBlockGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovGenesis(uint64(m.Gas))
+	}
 	return n
 }
 
@@ -257,6 +449,198 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.LearningRate = &v
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlockGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowBlockGas = append(m.WindowBlockGas, WindowBlockGas{})
+			if err := m.WindowBlockGas[len(m.WindowBlockGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeHistory = append(m.BaseFeeHistory, BaseFeeHistoryEntry{})
+			if err := m.BaseFeeHistory[len(m.BaseFeeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowBlockGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowBlockGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowBlockGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -28,8 +29,8 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			"valid genesis",
 			&GenesisState{
-				DefaultParams(),
-				uint64(1),
+				Params:   DefaultParams(),
+				BlockGas: uint64(1),
 			},
 			true,
 		},
@@ -41,6 +42,48 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			),
 			true,
 		},
+		{
+			"valid genesis with the AIMD state and the base fee history",
+			&GenesisState{
+				Params:         DefaultParams(),
+				LearningRate:   &DefaultAIMDMaxLearningRate,
+				WindowBlockGas: []WindowBlockGas{{Height: 1, Gas: 100}, {Height: 2, Gas: 200}},
+				BaseFeeHistory: []BaseFeeHistoryEntry{{Height: 2, BaseFee: math.NewInt(1000), GasWanted: 200, GasUsed: 100}},
+			},
+			true,
+		},
+		{
+			"invalid genesis - negative learning rate",
+			&GenesisState{
+				Params:       DefaultParams(),
+				LearningRate: func() *math.LegacyDec { rate := math.LegacyNewDec(-1); return &rate }(),
+			},
+			false,
+		},
+		{
+			"invalid genesis - duplicate window block gas height",
+			&GenesisState{
+				Params:         DefaultParams(),
+				WindowBlockGas: []WindowBlockGas{{Height: 1, Gas: 100}, {Height: 1, Gas: 200}},
+			},
+			false,
+		},
+		{
+			"invalid genesis - base fee history entry with zero height",
+			&GenesisState{
+				Params:         DefaultParams(),
+				BaseFeeHistory: []BaseFeeHistoryEntry{{Height: 0, BaseFee: math.NewInt(1000)}},
+			},
+			false,
+		},
+		{
+			"invalid genesis - base fee history entry with nil base fee",
+			&GenesisState{
+				Params:         DefaultParams(),
+				BaseFeeHistory: []BaseFeeHistoryEntry{{Height: 1}},
+			},
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{
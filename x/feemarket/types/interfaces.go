@@ -3,6 +3,7 @@
 package types
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -15,3 +16,20 @@ type (
 		GetParamSetIfExists(ctx sdk.Context, ps LegacyParams)
 	}
 )
+
+// BankKeeper defines the expected interface needed to burn the transaction fees.
+type BankKeeper interface {
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the expected interface needed to send the
+// transaction fees to the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// RevenueKeeper defines the expected interface of the x/revenue keeper needed to
+// migrate its deprecated developer shares to the EVM fee split.
+type RevenueKeeper interface {
+	GetDeveloperShares(ctx sdk.Context) math.LegacyDec
+}
@@ -22,6 +22,9 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixLearningRate
+	prefixWindowBlockGas
+	prefixBaseFeeHistory
 )
 
 const (
@@ -31,6 +34,9 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixLearningRate   = []byte{prefixLearningRate}
+	KeyPrefixWindowBlockGas = []byte{prefixWindowBlockGas}
+	KeyPrefixBaseFeeHistory = []byte{prefixBaseFeeHistory}
 )
 
 // Transient Store key prefixes
@@ -19,6 +19,10 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeStrategy is the classic EIP-1559 update rule
+	DefaultBaseFeeStrategy = BASE_FEE_STRATEGY_EIP1559
+	// DefaultBaseFeeHistorySize is 1024 blocks
+	DefaultBaseFeeHistorySize = uint64(1024)
 )
 
 // Parameter keys
@@ -82,6 +86,13 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeStrategy:          DefaultBaseFeeStrategy,
+		AIMDParams:               DefaultAIMDParams(),
+		MinBaseFee:               math.ZeroInt(),
+		MaxBaseFee:               math.ZeroInt(),
+		BaseFeeHistorySize:       DefaultBaseFeeHistorySize,
+		CosmosFeeSplit:           DefaultCosmosFeeSplit(),
+		EVMFeeSplit:              DefaultEVMFeeSplit(),
 	}
 }
 
@@ -103,6 +114,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return err
+	}
+
+	if err := p.validateBaseFeeStrategy(); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeBounds(p.MinBaseFee, p.MaxBaseFee); err != nil {
+		return err
+	}
+
+	if err := p.validateFeeSplits(); err != nil {
+		return err
+	}
+
+	if err := validateContractGasPriceMultipliers(p.ContractGasPriceMultipliers); err != nil {
+		return err
+	}
+
+	if p.MaxEVMBlockGas != 0 && p.TargetBlockGas > p.MaxEVMBlockGas {
+		return fmt.Errorf("target block gas cannot be greater than the max EVM block gas: %d > %d", p.TargetBlockGas, p.MaxEVMBlockGas)
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -118,6 +153,89 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// GetFeeDenom returns the accepted fee denom that matches the given denomination
+// and a boolean that indicates if it was found.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+	return FeeDenom{}, false
+}
+
+// EVMBlockGasLimit returns the maximum gas that can be used by the EVM
+// transactions of a block with the given gas limit, which is capped by the
+// MaxEVMBlockGas parameter when it is set.
+func (p Params) EVMBlockGasLimit(blockGasLimit uint64) uint64 {
+	if p.MaxEVMBlockGas == 0 || p.MaxEVMBlockGas > blockGasLimit {
+		return blockGasLimit
+	}
+	return p.MaxEVMBlockGas
+}
+
+// BlockGasTarget returns the block gas targeted by the base fee update rules
+// for the given EVM block gas limit. It defaults to the EVM block gas limit
+// divided by the elasticity multiplier when the TargetBlockGas parameter isn't
+// set.
+// CONTRACT: ElasticityMultiplier cannot be 0
+func (p Params) BlockGasTarget(evmBlockGasLimit uint64) uint64 {
+	if p.TargetBlockGas != 0 {
+		return p.TargetBlockGas
+	}
+	return evmBlockGasLimit / uint64(p.ElasticityMultiplier)
+}
+
+// validateBaseFeeStrategy checks that the base fee strategy is known and that
+// the parameters it requires are valid. The AIMD parameters are only validated
+// when the AIMD strategy is selected.
+func (p Params) validateBaseFeeStrategy() error {
+	switch p.BaseFeeStrategy {
+	case BASE_FEE_STRATEGY_EIP1559:
+		return nil
+	case BASE_FEE_STRATEGY_AIMD:
+		return p.AIMDParams.Validate()
+	default:
+		return fmt.Errorf("invalid base fee strategy: %s", p.BaseFeeStrategy)
+	}
+}
+
+// validateFeeSplits checks the fee split of each transaction class. Unset fee
+// splits are accepted for the params that were stored before the fee splits were
+// introduced and are equivalent to the default ones. Cosmos transactions don't
+// call contracts, so their fees can't be shared with developers.
+func (p Params) validateFeeSplits() error {
+	if !p.CosmosFeeSplit.IsEmpty() {
+		if err := p.CosmosFeeSplit.Validate(); err != nil {
+			return fmt.Errorf("invalid cosmos fee split: %w", err)
+		}
+
+		if !p.CosmosFeeSplit.Developers.IsZero() {
+			return fmt.Errorf("cosmos fee split developers share must be 0: %s", p.CosmosFeeSplit.Developers)
+		}
+	}
+
+	if !p.EVMFeeSplit.IsEmpty() {
+		if err := p.EVMFeeSplit.Validate(); err != nil {
+			return fmt.Errorf("invalid evm fee split: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetFeeSplit returns the fee split of the given transaction class.
+func (p Params) GetFeeSplit(txClass string) (FeeSplit, error) {
+	switch txClass {
+	case TxClassCosmos:
+		return p.CosmosFeeSplit, nil
+	case TxClassEVM:
+		return p.EVMFeeSplit, nil
+	default:
+		return FeeSplit{}, fmt.Errorf("invalid transaction class: %s", txClass)
+	}
+}
+
 func validateMinGasPrice(i interface{}) error {
 	v, ok := i.(math.LegacyDec)
 
@@ -203,3 +321,47 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateFeeDenoms(i interface{}) error {
+	feeDenoms, ok := i.([]FeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool, len(feeDenoms))
+	for _, feeDenom := range feeDenoms {
+		if seenDenoms[feeDenom.Denom] {
+			return fmt.Errorf("duplicate fee denom %s", feeDenom.Denom)
+		}
+		seenDenoms[feeDenom.Denom] = true
+
+		if err := feeDenom.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateBaseFeeBounds checks the min and max base fee clamp. Nil values are
+// accepted for the params that were stored before the clamp was introduced and
+// are equivalent to zero (i.e. disabled).
+func validateBaseFeeBounds(minBaseFee, maxBaseFee math.Int) error {
+	if !minBaseFee.IsNil() && minBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", minBaseFee)
+	}
+
+	if maxBaseFee.IsNil() || maxBaseFee.IsZero() {
+		return nil
+	}
+
+	if maxBaseFee.IsNegative() {
+		return fmt.Errorf("max base fee cannot be negative: %s", maxBaseFee)
+	}
+
+	if !minBaseFee.IsNil() && minBaseFee.GT(maxBaseFee) {
+		return fmt.Errorf("min base fee cannot be greater than the max base fee: %s > %s", minBaseFee, maxBaseFee)
+	}
+
+	return nil
+}
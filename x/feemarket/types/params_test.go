@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -57,6 +59,277 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), math.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"valid: accepted fee denoms",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				FeeDenoms:                []FeeDenom{NewFeeDenom("uusdc", math.LegacyNewDecWithPrec(5, 2)), NewFeeDenom("uosmo", math.LegacyNewDec(2))},
+			},
+			false,
+		},
+		{
+			"invalid: duplicate fee denoms",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				FeeDenoms:                []FeeDenom{NewFeeDenom("uusdc", math.LegacyOneDec()), NewFeeDenom("uusdc", math.LegacyNewDec(2))},
+			},
+			true,
+		},
+		{
+			"invalid: fee denom with invalid denom",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				FeeDenoms:                []FeeDenom{NewFeeDenom("1", math.LegacyOneDec())},
+			},
+			true,
+		},
+		{
+			"invalid: fee denom with zero conversion rate",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				FeeDenoms:                []FeeDenom{NewFeeDenom("uusdc", math.LegacyZeroDec())},
+			},
+			true,
+		},
+		{
+			"valid: AIMD base fee strategy",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeStrategy:          BASE_FEE_STRATEGY_AIMD,
+				AIMDParams:               DefaultAIMDParams(),
+			},
+			false,
+		},
+		{
+			"invalid: AIMD base fee strategy without params",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeStrategy:          BASE_FEE_STRATEGY_AIMD,
+			},
+			true,
+		},
+		{
+			"invalid: AIMD min learning rate greater than max learning rate",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeStrategy:          BASE_FEE_STRATEGY_AIMD,
+				AIMDParams:               NewAIMDParams(DefaultAIMDAlpha, DefaultAIMDBeta, DefaultAIMDGamma, math.LegacyNewDecWithPrec(6, 1), math.LegacyNewDecWithPrec(5, 1), 8),
+			},
+			true,
+		},
+		{
+			"invalid: AIMD zero window",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeStrategy:          BASE_FEE_STRATEGY_AIMD,
+				AIMDParams:               NewAIMDParams(DefaultAIMDAlpha, DefaultAIMDBeta, DefaultAIMDGamma, DefaultAIMDMinLearningRate, DefaultAIMDMaxLearningRate, 0),
+			},
+			true,
+		},
+		{
+			"invalid: unknown base fee strategy",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				BaseFeeStrategy:          5,
+			},
+			true,
+		},
+		{
+			"valid: base fee bounds",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinBaseFee:               math.NewInt(10),
+				MaxBaseFee:               math.NewInt(100),
+			},
+			false,
+		},
+		{
+			"invalid: negative min base fee",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinBaseFee:               math.NewInt(-1),
+			},
+			true,
+		},
+		{
+			"invalid: min base fee greater than max base fee",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinBaseFee:               math.NewInt(100),
+				MaxBaseFee:               math.NewInt(10),
+			},
+			true,
+		},
+		{
+			"valid: fee splits",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				CosmosFeeSplit:           NewFeeSplit(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyZeroDec(), math.LegacyZeroDec()),
+				EVMFeeSplit:              NewFeeSplit(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyNewDecWithPrec(3, 1), math.LegacyNewDecWithPrec(4, 1)),
+			},
+			false,
+		},
+		{
+			"invalid: fee split shares not adding up to 1",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				EVMFeeSplit:              NewFeeSplit(math.LegacyNewDecWithPrec(5, 1), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(6, 1), math.LegacyZeroDec()),
+			},
+			true,
+		},
+		{
+			"invalid: negative fee split share",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				EVMFeeSplit:              NewFeeSplit(math.LegacyNewDec(-1), math.LegacyZeroDec(), math.LegacyNewDec(2), math.LegacyZeroDec()),
+			},
+			true,
+		},
+		{
+			"invalid: cosmos fee split with developers share",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				CosmosFeeSplit:           NewFeeSplit(math.LegacyNewDecWithPrec(5, 1), math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(5, 1)),
+			},
+			true,
+		},
+		{
+			"valid: contract gas price multipliers",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				ContractGasPriceMultipliers: []ContractGasPriceMultiplier{
+					NewContractGasPriceMultiplier(common.HexToAddress("0x1"), math.LegacyNewDecWithPrec(5, 1)),
+					NewContractGasPriceMultiplier(common.HexToAddress("0x2"), math.LegacyNewDec(3)),
+				},
+			},
+			false,
+		},
+		{
+			"invalid: contract gas price multiplier with invalid address",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				ContractGasPriceMultipliers: []ContractGasPriceMultiplier{
+					{Contract: "dhives1invalid", Multiplier: math.LegacyOneDec()},
+				},
+			},
+			true,
+		},
+		{
+			"invalid: zero contract gas price multiplier",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				ContractGasPriceMultipliers: []ContractGasPriceMultiplier{
+					NewContractGasPriceMultiplier(common.HexToAddress("0x1"), math.LegacyZeroDec()),
+				},
+			},
+			true,
+		},
+		{
+			"invalid: duplicate contract gas price multiplier",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				ContractGasPriceMultipliers: []ContractGasPriceMultiplier{
+					NewContractGasPriceMultiplier(common.HexToAddress("0x1"), math.LegacyNewDecWithPrec(5, 1)),
+					{Contract: "0x0000000000000000000000000000000000000001", Multiplier: math.LegacyNewDec(2)},
+				},
+			},
+			true,
+		},
+		{
+			"valid: target block gas lower than the max EVM block gas",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				TargetBlockGas:           15_000_000,
+				MaxEVMBlockGas:           30_000_000,
+			},
+			false,
+		},
+		{
+			"valid: target block gas without max EVM block gas",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				TargetBlockGas:           15_000_000,
+			},
+			false,
+		},
+		{
+			"invalid: target block gas greater than the max EVM block gas",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  math.OneInt(),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				TargetBlockGas:           30_000_001,
+				MaxEVMBlockGas:           30_000_000,
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -117,3 +390,49 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestGetGasPriceMultiplier() {
+	contract := common.HexToAddress("0x1")
+	other := common.HexToAddress("0x2")
+	params := DefaultParams()
+	params.ContractGasPriceMultipliers = []ContractGasPriceMultiplier{
+		NewContractGasPriceMultiplier(contract, math.LegacyNewDecWithPrec(5, 1)),
+	}
+
+	suite.Require().Equal(math.LegacyNewDecWithPrec(5, 1), params.GetGasPriceMultiplier(&contract))
+	suite.Require().Equal(math.LegacyOneDec(), params.GetGasPriceMultiplier(&other))
+	suite.Require().Equal(math.LegacyOneDec(), params.GetGasPriceMultiplier(nil))
+}
+
+func (suite *ParamsTestSuite) TestApplyGasPriceMultiplier() {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("adhives", 101), sdk.NewInt64Coin("uusdc", 10))
+
+	suite.Require().Equal(fees, ApplyGasPriceMultiplier(fees, math.LegacyOneDec()))
+	// the multiplied fees are rounded up
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("adhives", 51), sdk.NewInt64Coin("uusdc", 5)).String(),
+		ApplyGasPriceMultiplier(fees, math.LegacyNewDecWithPrec(5, 1)).String(),
+	)
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("adhives", 303), sdk.NewInt64Coin("uusdc", 30)).String(),
+		ApplyGasPriceMultiplier(fees, math.LegacyNewDec(3)).String(),
+	)
+}
+
+func (suite *ParamsTestSuite) TestEVMBlockGasLimit() {
+	params := DefaultParams()
+	suite.Require().Equal(uint64(40_000_000), params.EVMBlockGasLimit(40_000_000))
+
+	params.MaxEVMBlockGas = 30_000_000
+	suite.Require().Equal(uint64(30_000_000), params.EVMBlockGasLimit(40_000_000))
+	suite.Require().Equal(uint64(20_000_000), params.EVMBlockGasLimit(20_000_000))
+}
+
+func (suite *ParamsTestSuite) TestBlockGasTarget() {
+	params := DefaultParams()
+	params.ElasticityMultiplier = 2
+	suite.Require().Equal(uint64(20_000_000), params.BlockGasTarget(40_000_000))
+
+	params.TargetBlockGas = 10_000_000
+	suite.Require().Equal(uint64(10_000_000), params.BlockGasTarget(40_000_000))
+}
@@ -274,6 +274,196 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBaseFeeHistoryRequest defines the request type for querying the base fee
+// history.
+type QueryBaseFeeHistoryRequest struct {
+	// from_height is the first block height of the range (inclusive)
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last block height of the range (inclusive)
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *QueryBaseFeeHistoryRequest) Reset()         { *m = QueryBaseFeeHistoryRequest{} }
+func (m *QueryBaseFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryBaseFeeHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// QueryBaseFeeHistoryResponse returns the base fee history entries within the
+// requested height range, sorted by height.
+type QueryBaseFeeHistoryResponse struct {
+	// entries are the base fee history entries
+	Entries []BaseFeeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryBaseFeeHistoryResponse) Reset()         { *m = QueryBaseFeeHistoryResponse{} }
+func (m *QueryBaseFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryResponse) GetEntries() []BaseFeeHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// QueryNextBaseFeeRequest defines the request type for querying the base fee of
+// the next block.
+type QueryNextBaseFeeRequest struct {
+	// block_max_gas is the max gas of the next block from the consensus params
+	BlockMaxGas int64 `protobuf:"varint,1,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+}
+
+func (m *QueryNextBaseFeeRequest) Reset()         { *m = QueryNextBaseFeeRequest{} }
+func (m *QueryNextBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextBaseFeeRequest) ProtoMessage()    {}
+func (*QueryNextBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{8}
+}
+func (m *QueryNextBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryNextBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextBaseFeeRequest proto.InternalMessageInfo
+
+func (m *QueryNextBaseFeeRequest) GetBlockMaxGas() int64 {
+	if m != nil {
+		return m.BlockMaxGas
+	}
+	return 0
+}
+
+// QueryNextBaseFeeResponse returns the base fee of the next block.
+type QueryNextBaseFeeResponse struct {
+	// base_fee is the base fee of the next block, empty if the base fee is not
+	// enabled
+	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+}
+
+func (m *QueryNextBaseFeeResponse) Reset()         { *m = QueryNextBaseFeeResponse{} }
+func (m *QueryNextBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextBaseFeeResponse) ProtoMessage()    {}
+func (*QueryNextBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{9}
+}
+func (m *QueryNextBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryNextBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextBaseFeeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +471,10 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBaseFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryBaseFeeHistoryRequest")
+	proto.RegisterType((*QueryBaseFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeHistoryResponse")
+	proto.RegisterType((*QueryNextBaseFeeRequest)(nil), "ethermint.feemarket.v1.QueryNextBaseFeeRequest")
+	proto.RegisterType((*QueryNextBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryNextBaseFeeResponse")
 }
 
 func init() {
@@ -288,35 +482,47 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x12, 0x48, 0xcb, 0x72, 0x41, 0x4b, 0x52, 0x81, 0x15, 0x36, 0xc8, 0x02, 0xc4, 0x47,
-	0xd9, 0x55, 0x0a, 0xe2, 0xc4, 0xc9, 0x87, 0x22, 0x24, 0x0e, 0x60, 0x6e, 0x5c, 0xaa, 0x75, 0x98,
-	0x3a, 0x56, 0x6a, 0xaf, 0xeb, 0xdd, 0x58, 0xf4, 0x8a, 0xc4, 0x85, 0x03, 0x42, 0xe2, 0x77, 0xf0,
-	0x3f, 0x7a, 0xac, 0xc4, 0x05, 0x71, 0xa8, 0x50, 0xc2, 0x0f, 0x41, 0xde, 0x5d, 0x07, 0x0c, 0x09,
-	0xcd, 0xc5, 0x1a, 0x8d, 0xdf, 0xbc, 0xf7, 0xe6, 0x8d, 0x16, 0xfb, 0xa0, 0xc7, 0x50, 0xa4, 0x49,
-	0xa6, 0xf9, 0x3e, 0x40, 0x2a, 0x8a, 0x09, 0x68, 0x5e, 0x0e, 0xf9, 0xe1, 0x14, 0x8a, 0x23, 0x96,
-	0x17, 0x52, 0x4b, 0xb2, 0xb5, 0xc0, 0xb0, 0x05, 0x86, 0x95, 0x43, 0xef, 0xf6, 0x8a, 0xd9, 0xdf,
-	0x20, 0x33, 0xef, 0x75, 0x63, 0x19, 0x4b, 0x53, 0xf2, 0xaa, 0x72, 0xdd, 0x7e, 0x2c, 0x65, 0x7c,
-	0x00, 0x5c, 0xe4, 0x09, 0x17, 0x59, 0x26, 0xb5, 0xd0, 0x89, 0xcc, 0x94, 0xfd, 0xeb, 0x77, 0x31,
-	0x79, 0x59, 0x59, 0x78, 0x21, 0x0a, 0x91, 0xaa, 0x10, 0x0e, 0xa7, 0xa0, 0xb4, 0xff, 0x0a, 0x5f,
-	0x69, 0x74, 0x55, 0x2e, 0x33, 0x05, 0xe4, 0x09, 0xee, 0xe4, 0xa6, 0x73, 0x15, 0xdd, 0x40, 0x77,
-	0x2e, 0xed, 0x50, 0xb6, 0xdc, 0x31, 0xb3, 0x73, 0xc1, 0xf9, 0xe3, 0xd3, 0x41, 0x2b, 0x74, 0x33,
-	0x7e, 0xcf, 0x91, 0x06, 0x42, 0xc1, 0x2e, 0x40, 0xad, 0xf5, 0x1c, 0x77, 0x9b, 0x6d, 0x27, 0xf6,
-	0x08, 0x6f, 0x46, 0x42, 0xc1, 0xde, 0x3e, 0x80, 0x91, 0xbb, 0x18, 0x5c, 0xfb, 0x7e, 0x3a, 0xe8,
-	0x8d, 0xa4, 0x4a, 0xa5, 0x52, 0x6f, 0x26, 0x2c, 0x91, 0x3c, 0x15, 0x7a, 0xcc, 0x9e, 0x65, 0x3a,
-	0xdc, 0x88, 0xec, 0xb4, 0xbf, 0x55, 0xb3, 0x1d, 0xc8, 0xd1, 0xe4, 0xa9, 0x58, 0x6c, 0x74, 0x17,
-	0xf7, 0xfe, 0xea, 0x3b, 0x99, 0xcb, 0xb8, 0x1d, 0x0b, 0xbb, 0x50, 0x3b, 0xac, 0xca, 0x9d, 0x2f,
-	0x6d, 0x7c, 0xc1, 0x60, 0xc9, 0x7b, 0x84, 0x3b, 0x76, 0x15, 0x72, 0x6f, 0xd5, 0xaa, 0xff, 0xa6,
-	0xe7, 0xdd, 0x5f, 0x0b, 0x6b, 0xf5, 0x7d, 0xff, 0xdd, 0xd7, 0x9f, 0x9f, 0xcf, 0xf5, 0x89, 0xc7,
-	0xa1, 0x4c, 0xa5, 0x6a, 0x5e, 0xd8, 0x26, 0x47, 0x3e, 0x20, 0xbc, 0xe1, 0xe2, 0x21, 0xff, 0x27,
-	0x6f, 0x66, 0xeb, 0x6d, 0xaf, 0x07, 0x76, 0x56, 0x6e, 0x1a, 0x2b, 0x94, 0xf4, 0x97, 0x59, 0xa9,
-	0x6f, 0x41, 0x3e, 0x22, 0xbc, 0x59, 0xa7, 0x48, 0xce, 0x10, 0x68, 0x1e, 0xc1, 0x7b, 0xb0, 0x26,
-	0xda, 0xf9, 0xb9, 0x65, 0xfc, 0x0c, 0xc8, 0xf5, 0xa5, 0x7e, 0x2a, 0xf4, 0x5e, 0x2c, 0x54, 0xb0,
-	0x7b, 0x3c, 0xa3, 0xe8, 0x64, 0x46, 0xd1, 0x8f, 0x19, 0x45, 0x9f, 0xe6, 0xb4, 0x75, 0x32, 0xa7,
-	0xad, 0x6f, 0x73, 0xda, 0x7a, 0xbd, 0x1d, 0x27, 0x7a, 0x3c, 0x8d, 0xd8, 0x48, 0xa6, 0x8e, 0xc2,
-	0x7e, 0xcb, 0xe1, 0x63, 0xfe, 0xf6, 0x0f, 0x3a, 0x7d, 0x94, 0x83, 0x8a, 0x3a, 0xe6, 0x45, 0x3c,
-	0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xd3, 0xfa, 0x92, 0xab, 0x03, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0xd9, 0xd6, 0x02, 0x1d, 0xa2, 0x31, 0x23, 0xd4, 0xba, 0xc5, 0x45, 0xc7, 0xda, 0xb4,
+	0x16, 0x77, 0x85, 0x1a, 0x4f, 0x7a, 0x21, 0xb1, 0xad, 0xf1, 0x47, 0x2a, 0xde, 0x7a, 0xd9, 0x0c,
+	0x38, 0xec, 0xae, 0x74, 0x77, 0xe8, 0xce, 0x40, 0xe0, 0x6a, 0xe2, 0xc5, 0x83, 0x31, 0xf1, 0xe8,
+	0x1f, 0xe0, 0xbf, 0xd2, 0x63, 0x13, 0x2f, 0xc6, 0x43, 0x63, 0xc0, 0x7f, 0xc1, 0xbb, 0xd9, 0xd9,
+	0x59, 0xca, 0xca, 0x0f, 0x31, 0x5e, 0xc8, 0xe4, 0xcd, 0xfb, 0x7e, 0xdf, 0xe7, 0x3d, 0xde, 0x2c,
+	0x40, 0x84, 0xdb, 0xc4, 0x77, 0x1d, 0x8f, 0x1b, 0x0d, 0x42, 0x5c, 0xec, 0x37, 0x09, 0x37, 0x3a,
+	0x25, 0xe3, 0xb8, 0x4d, 0xfc, 0x9e, 0xde, 0xf2, 0x29, 0xa7, 0x70, 0x65, 0x98, 0xa3, 0x0f, 0x73,
+	0xf4, 0x4e, 0x49, 0xdd, 0x98, 0xa2, 0x3d, 0x4f, 0x12, 0x7a, 0x35, 0x6b, 0x51, 0x8b, 0x8a, 0xa3,
+	0x11, 0x9c, 0x64, 0x34, 0x6f, 0x51, 0x6a, 0x1d, 0x11, 0x03, 0xb7, 0x1c, 0x03, 0x7b, 0x1e, 0xe5,
+	0x98, 0x3b, 0xd4, 0x63, 0xe1, 0x2d, 0xca, 0x02, 0xf8, 0x32, 0x40, 0x38, 0xc0, 0x3e, 0x76, 0x59,
+	0x95, 0x1c, 0xb7, 0x09, 0xe3, 0xe8, 0x15, 0xb8, 0x12, 0x8b, 0xb2, 0x16, 0xf5, 0x18, 0x81, 0x0f,
+	0x41, 0xb2, 0x25, 0x22, 0xab, 0xca, 0x0d, 0x65, 0x33, 0x53, 0xd6, 0xf4, 0xc9, 0xc4, 0x7a, 0xa8,
+	0xab, 0x5c, 0x38, 0x39, 0x2b, 0x24, 0xaa, 0x52, 0x83, 0x72, 0xd2, 0xb4, 0x82, 0x19, 0xd9, 0x25,
+	0x24, 0xaa, 0xf5, 0x0c, 0x64, 0xe3, 0x61, 0x59, 0xec, 0x3e, 0x48, 0xd7, 0x30, 0x23, 0x66, 0x83,
+	0x10, 0x51, 0x6e, 0xb9, 0x72, 0xed, 0xfb, 0x59, 0x21, 0x57, 0xa7, 0xcc, 0xa5, 0x8c, 0xbd, 0x6e,
+	0xea, 0x0e, 0x35, 0x5c, 0xcc, 0x6d, 0xfd, 0x89, 0xc7, 0xab, 0xa9, 0x5a, 0xa8, 0x46, 0x2b, 0x91,
+	0xdb, 0x11, 0xad, 0x37, 0xf7, 0xf0, 0xb0, 0xa3, 0x2d, 0x90, 0xfb, 0x23, 0x2e, 0xcb, 0x5c, 0x06,
+	0x8b, 0x16, 0x0e, 0x1b, 0x5a, 0xac, 0x06, 0x47, 0x74, 0x08, 0xd4, 0x51, 0xa0, 0x7d, 0x87, 0x71,
+	0xea, 0xf7, 0xa4, 0x11, 0x2c, 0x80, 0x4c, 0xc3, 0xa7, 0xae, 0x69, 0x13, 0xc7, 0xb2, 0xb9, 0xd4,
+	0x81, 0x20, 0xb4, 0x2f, 0x22, 0x70, 0x0d, 0x2c, 0x73, 0x1a, 0x5d, 0x2f, 0x88, 0xeb, 0x34, 0xa7,
+	0xe1, 0x25, 0x7a, 0x03, 0xd6, 0x26, 0x7a, 0x4b, 0x98, 0xa7, 0x20, 0x45, 0x3c, 0xee, 0x3b, 0x24,
+	0x00, 0x5a, 0xdc, 0xcc, 0x94, 0xb7, 0xa7, 0x4d, 0x38, 0x6e, 0xf0, 0xd8, 0xe3, 0x7e, 0x4f, 0x8e,
+	0x3b, 0x72, 0x40, 0x8f, 0xc0, 0x55, 0x51, 0xeb, 0x05, 0xe9, 0xf2, 0xf8, 0xcc, 0x21, 0x02, 0x17,
+	0x6b, 0xc1, 0x20, 0x4c, 0x17, 0x77, 0xcd, 0xf3, 0xf6, 0x33, 0x22, 0xf8, 0x1c, 0x77, 0xf7, 0x30,
+	0x43, 0x07, 0x60, 0x75, 0x5c, 0xfe, 0x3f, 0xff, 0x4d, 0xf9, 0xd7, 0x12, 0x58, 0x12, 0x96, 0xf0,
+	0x9d, 0x02, 0x92, 0xe1, 0x8e, 0xc0, 0x3b, 0xd3, 0x3a, 0x1c, 0x5f, 0x4b, 0x75, 0x7b, 0xae, 0xdc,
+	0x90, 0x11, 0xa1, 0xb7, 0x5f, 0x7f, 0x7e, 0x5a, 0xc8, 0x43, 0xd5, 0x20, 0x1d, 0x97, 0xb2, 0xf8,
+	0xd3, 0x09, 0x57, 0x12, 0xbe, 0x57, 0x40, 0x4a, 0xf6, 0x06, 0x67, 0x9b, 0xc7, 0x07, 0xa8, 0x16,
+	0xe7, 0x4b, 0x96, 0x28, 0xeb, 0x02, 0x45, 0x83, 0xf9, 0x49, 0x28, 0xd1, 0x20, 0xe1, 0x07, 0x05,
+	0xa4, 0xa3, 0xf5, 0x84, 0x7f, 0x29, 0x10, 0xdf, 0x6e, 0xf5, 0xee, 0x9c, 0xd9, 0x92, 0xe7, 0xb6,
+	0xe0, 0x29, 0xc0, 0xeb, 0x13, 0x79, 0xc4, 0x62, 0x58, 0x98, 0xc1, 0x2f, 0x0a, 0xb8, 0x14, 0xdf,
+	0x33, 0x58, 0x9e, 0xa7, 0xef, 0xf8, 0x8b, 0x51, 0x77, 0xfe, 0x49, 0x23, 0x11, 0x8b, 0x02, 0x71,
+	0x03, 0xae, 0xcf, 0x1a, 0x99, 0x69, 0x4b, 0xac, 0xcf, 0x0a, 0xc8, 0x8c, 0xec, 0x29, 0x34, 0x66,
+	0x96, 0x1c, 0x7f, 0x10, 0xea, 0xbd, 0xf9, 0x05, 0x12, 0x70, 0x4b, 0x00, 0xde, 0x82, 0x37, 0x27,
+	0x01, 0x7a, 0xa4, 0xcb, 0xcd, 0x88, 0xb2, 0xb2, 0x7b, 0xd2, 0xd7, 0x94, 0xd3, 0xbe, 0xa6, 0xfc,
+	0xe8, 0x6b, 0xca, 0xc7, 0x81, 0x96, 0x38, 0x1d, 0x68, 0x89, 0x6f, 0x03, 0x2d, 0x71, 0x58, 0xb4,
+	0x1c, 0x6e, 0xb7, 0x6b, 0x7a, 0x9d, 0xba, 0xd2, 0x26, 0xfc, 0xed, 0x94, 0x1e, 0x18, 0xdd, 0x11,
+	0x4b, 0xde, 0x6b, 0x11, 0x56, 0x4b, 0x8a, 0x4f, 0xf6, 0xce, 0xef, 0x01, 0x00, 0x50, 0x57, 0xc0,
+	0xee, 0x4c, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +543,12 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BaseFeeHistory queries the base fee and the block gas of the blocks within
+	// the given height range that are kept in the base fee history.
+	BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error)
+	// NextBaseFee queries the base fee that the fee market module calculates for
+	// the block that follows the current one.
+	NextBaseFee(ctx context.Context, in *QueryNextBaseFeeRequest, opts ...grpc.CallOption) (*QueryNextBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -374,6 +586,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error) {
+	out := new(QueryBaseFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BaseFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextBaseFee(ctx context.Context, in *QueryNextBaseFeeRequest, opts ...grpc.CallOption) (*QueryNextBaseFeeResponse, error) {
+	out := new(QueryNextBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/NextBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -382,6 +612,12 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BaseFeeHistory queries the base fee and the block gas of the blocks within
+	// the given height range that are kept in the base fee history.
+	BaseFeeHistory(context.Context, *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error)
+	// NextBaseFee queries the base fee that the fee market module calculates for
+	// the block that follows the current one.
+	NextBaseFee(context.Context, *QueryNextBaseFeeRequest) (*QueryNextBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -397,6 +633,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BaseFeeHistory(ctx context.Context, req *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) NextBaseFee(ctx context.Context, req *QueryNextBaseFeeRequest) (*QueryNextBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -456,6 +698,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BaseFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeHistory(ctx, req.(*QueryBaseFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/NextBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextBaseFee(ctx, req.(*QueryNextBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -472,6 +750,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BaseFeeHistory",
+			Handler:    _Query_BaseFeeHistory_Handler,
+		},
+		{
+			MethodName: "NextBaseFee",
+			Handler:    _Query_NextBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -642,6 +928,139 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBaseFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -716,12 +1135,67 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *QueryBaseFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryNextBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1110,6 +1584,333 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, BaseFeeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,50 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/outposts/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the outposts module params
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Route returns the route registered with the given name
+func (k Keeper) Route(
+	c context.Context,
+	req *types.QueryRouteRequest,
+) (*types.QueryRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "route name is empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	route, found := k.GetParams(ctx).GetRoute(req.Name)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "route '%s' not found", req.Name)
+	}
+
+	return &types.QueryRouteResponse{Route: route}, nil
+}
@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"github.com/evmos/evmos/v16/x/outposts/types"
+)
+
+func (s *KeeperTestSuite) TestRouteQuery() {
+	route := types.Route{
+		Name:             "hub-forward",
+		ChainId:          "cosmoshub-4",
+		SourceChannel:    "channel-3",
+		ReceiverTemplate: "pfm",
+		MemoTemplate:     types.PacketForwardMemoTemplate,
+		Enabled:          true,
+	}
+	ctx := s.network.GetContext()
+	s.Require().NoError(s.network.App.OutpostsKeeper.SetParams(ctx, types.NewParams(true, route)))
+
+	_, err := s.network.App.OutpostsKeeper.Route(ctx, nil)
+	s.Require().Error(err)
+
+	_, err = s.network.App.OutpostsKeeper.Route(ctx, &types.QueryRouteRequest{Name: "osmosis-swap"})
+	s.Require().ErrorContains(err, "not found")
+
+	res, err := s.network.App.OutpostsKeeper.Route(ctx, &types.QueryRouteRequest{Name: route.Name})
+	s.Require().NoError(err)
+	s.Require().Equal(route, res.Route)
+
+	params, err := s.network.App.OutpostsKeeper.Params(ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewParams(true, route), params.Params)
+}
@@ -0,0 +1,60 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/outposts/types"
+)
+
+// GetParams returns the total set of outposts parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the outposts params in a single key
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetEnabledRoute returns the route registered with the given name. It fails if
+// the outposts are disabled, or if the route is not found or disabled.
+func (k Keeper) GetEnabledRoute(ctx sdk.Context, name string) (types.Route, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableOutposts {
+		return types.Route{}, types.ErrOutpostsDisabled
+	}
+
+	route, found := params.GetRoute(name)
+	if !found {
+		return types.Route{}, errorsmod.Wrapf(types.ErrRouteNotFound, "route '%s'", name)
+	}
+
+	if !route.Enabled {
+		return types.Route{}, errorsmod.Wrapf(types.ErrRouteDisabled, "route '%s'", name)
+	}
+
+	return route, nil
+}
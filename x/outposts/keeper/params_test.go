@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"github.com/evmos/evmos/v16/x/outposts/types"
+)
+
+func (s *KeeperTestSuite) TestParams() {
+	ctx := s.network.GetContext()
+	defaultParams := s.network.App.OutpostsKeeper.GetParams(ctx)
+	s.Require().True(defaultParams.EnableOutposts)
+	s.Require().Empty(defaultParams.Routes)
+
+	params := types.NewParams(true, types.Route{
+		Name:             "hub-forward",
+		ChainId:          "cosmoshub-4",
+		SourceChannel:    "channel-3",
+		ReceiverTemplate: "pfm",
+		MemoTemplate:     types.PacketForwardMemoTemplate,
+		Enabled:          true,
+	})
+	s.Require().NoError(s.network.App.OutpostsKeeper.SetParams(ctx, params))
+	s.Require().Equal(params, s.network.App.OutpostsKeeper.GetParams(ctx))
+
+	// invalid params are not stored
+	err := s.network.App.OutpostsKeeper.SetParams(ctx, types.NewParams(true, types.Route{}))
+	s.Require().Error(err)
+	s.Require().Equal(params, s.network.App.OutpostsKeeper.GetParams(ctx))
+}
+
+func (s *KeeperTestSuite) TestGetEnabledRoute() {
+	route := types.Route{
+		Name:             "hub-forward",
+		ChainId:          "cosmoshub-4",
+		SourceChannel:    "channel-3",
+		ReceiverTemplate: "pfm",
+		MemoTemplate:     types.PacketForwardMemoTemplate,
+		Enabled:          true,
+	}
+	disabledRoute := route
+	disabledRoute.Name = "hub-disabled"
+	disabledRoute.Enabled = false
+
+	testCases := []struct {
+		name     string
+		params   types.Params
+		route    string
+		expError error
+	}{
+		{"fail - outposts disabled", types.NewParams(false, route), route.Name, types.ErrOutpostsDisabled},
+		{"fail - route not found", types.NewParams(true, route), "osmosis-swap", types.ErrRouteNotFound},
+		{"fail - route disabled", types.NewParams(true, route, disabledRoute), disabledRoute.Name, types.ErrRouteDisabled},
+		{"pass", types.NewParams(true, route, disabledRoute), route.Name, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			s.Require().NoError(s.network.App.OutpostsKeeper.SetParams(ctx, tc.params))
+
+			found, err := s.network.App.OutpostsKeeper.GetEnabledRoute(ctx, tc.route)
+			if tc.expError != nil {
+				s.Require().ErrorIs(err, tc.expError)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(route, found)
+		})
+	}
+}
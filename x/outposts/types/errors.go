@@ -0,0 +1,18 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrOutpostsDisabled = errorsmod.Register(ModuleName, 2, "outposts are disabled by governance")
+	ErrRouteNotFound    = errorsmod.Register(ModuleName, 3, "route not found")
+	ErrRouteDisabled    = errorsmod.Register(ModuleName, 4, "route is disabled")
+	ErrInvalidRoute     = errorsmod.Register(ModuleName, 5, "invalid route")
+	ErrInvalidTemplate  = errorsmod.Register(ModuleName, 6, "invalid template")
+	ErrInvalidArgument  = errorsmod.Register(ModuleName, 7, "invalid template argument")
+)
@@ -0,0 +1,579 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/outposts/v1/outposts.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Route defines a destination chain that can be reached through the outpost
+// precompile. The ICS20 transfer of a route is sent through its source channel,
+// while the transfer receiver and memo are rendered from the route templates.
+type Route struct {
+	// name is the unique identifier of the route
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// chain_id is the chain identifier of the destination chain
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// source_channel is the channel identifier on this chain used to send the
+	// ICS20 transfer to the destination chain
+	SourceChannel string `protobuf:"bytes,3,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// receiver_template is the template of the ICS20 transfer receiver on the
+	// counterparty chain (e.g. the swap contract targeted by a wasm hook)
+	ReceiverTemplate string `protobuf:"bytes,4,opt,name=receiver_template,json=receiverTemplate,proto3" json:"receiver_template,omitempty"`
+	// memo_template is the JSON template of the ICS20 transfer memo
+	// (e.g. a wasm hook, packet forward or autopilot memo). An empty template
+	// sends the transfer without memo
+	MemoTemplate string `protobuf:"bytes,5,opt,name=memo_template,json=memoTemplate,proto3" json:"memo_template,omitempty"`
+	// enabled defines whether the route can be used by the outpost
+	Enabled bool `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6f6144b4fb2ed4, []int{0}
+}
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(m, src)
+}
+func (m *Route) XXX_Size() int {
+	return m.Size()
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Route) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Route) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *Route) GetReceiverTemplate() string {
+	if m != nil {
+		return m.ReceiverTemplate
+	}
+	return ""
+}
+
+func (m *Route) GetMemoTemplate() string {
+	if m != nil {
+		return m.MemoTemplate
+	}
+	return ""
+}
+
+func (m *Route) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Route)(nil), "evmos.outposts.v1.Route")
+}
+
+func init() { proto.RegisterFile("evmos/outposts/v1/outposts.proto", fileDescriptor_2d6f6144b4fb2ed4) }
+
+var fileDescriptor_2d6f6144b4fb2ed4 = []byte{
+	// 258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xcf, 0x4a, 0xf4, 0x30,
+	0x14, 0xc5, 0x9b, 0xef, 0x9b, 0x7f, 0x06, 0x47, 0x9c, 0xac, 0xe2, 0x26, 0x14, 0x45, 0x18, 0x18,
+	0x68, 0x29, 0x82, 0x0f, 0xa0, 0xb8, 0x70, 0x5b, 0x5c, 0xb9, 0x29, 0x69, 0x7a, 0xb1, 0x85, 0x26,
+	0x29, 0x4d, 0x1a, 0xf4, 0x2d, 0x7c, 0x2c, 0xdd, 0xcd, 0xd2, 0xa5, 0xb4, 0x2f, 0x22, 0xa6, 0x4e,
+	0x67, 0x73, 0x39, 0xe7, 0x77, 0x7f, 0xab, 0x83, 0x43, 0x70, 0x52, 0x9b, 0x58, 0x77, 0xb6, 0xd1,
+	0xc6, 0x9a, 0xd8, 0x25, 0x53, 0x8e, 0x9a, 0x56, 0x5b, 0x4d, 0x36, 0xde, 0x88, 0x26, 0xea, 0x92,
+	0xcb, 0x4f, 0x84, 0xe7, 0xa9, 0xee, 0x2c, 0x10, 0x82, 0x67, 0x8a, 0x4b, 0xa0, 0x28, 0x44, 0xdb,
+	0x93, 0xd4, 0x67, 0x72, 0x81, 0x57, 0xa2, 0xe4, 0x95, 0xca, 0xaa, 0x82, 0xfe, 0xf3, 0x7c, 0xe9,
+	0xfb, 0x63, 0x41, 0xae, 0xf1, 0x99, 0xd1, 0x5d, 0x2b, 0x20, 0x13, 0x25, 0x57, 0x0a, 0x6a, 0xfa,
+	0xdf, 0x0b, 0xeb, 0x91, 0xde, 0x8f, 0x90, 0xec, 0xf0, 0xa6, 0x05, 0x01, 0x95, 0x83, 0x36, 0xb3,
+	0x20, 0x9b, 0x9a, 0x5b, 0xa0, 0x33, 0x6f, 0x9e, 0x1f, 0x1e, 0x4f, 0x7f, 0x9c, 0x5c, 0xe1, 0xb5,
+	0x04, 0xa9, 0x8f, 0xe2, 0xdc, 0x8b, 0xa7, 0xbf, 0x70, 0x92, 0x28, 0x5e, 0x82, 0xe2, 0x79, 0x0d,
+	0x05, 0x5d, 0x84, 0x68, 0xbb, 0x4a, 0x0f, 0xf5, 0xee, 0xe1, 0xa3, 0x67, 0x68, 0xdf, 0x33, 0xf4,
+	0xdd, 0x33, 0xf4, 0x3e, 0xb0, 0x60, 0x3f, 0xb0, 0xe0, 0x6b, 0x60, 0xc1, 0xf3, 0xee, 0xa5, 0xb2,
+	0x65, 0x97, 0x47, 0x42, 0xcb, 0x78, 0x5c, 0x69, 0xbc, 0x2e, 0xb9, 0x8d, 0x5f, 0x8f, 0x8b, 0xd9,
+	0xb7, 0x06, 0x4c, 0xbe, 0xf0, 0x63, 0xdd, 0xfc, 0x0c, 0x00, 0xd4, 0x05, 0x06, 0xc2, 0x50, 0x01,
+	0x00, 0x00,
+}
+
+func (m *Route) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Route) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Route) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MemoTemplate) > 0 {
+		i -= len(m.MemoTemplate)
+		copy(dAtA[i:], m.MemoTemplate)
+		i = encodeVarintOutposts(dAtA, i, uint64(len(m.MemoTemplate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReceiverTemplate) > 0 {
+		i -= len(m.ReceiverTemplate)
+		copy(dAtA[i:], m.ReceiverTemplate)
+		i = encodeVarintOutposts(dAtA, i, uint64(len(m.ReceiverTemplate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintOutposts(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintOutposts(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOutposts(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOutposts(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOutposts(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Route) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOutposts(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovOutposts(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovOutposts(uint64(l))
+	}
+	l = len(m.ReceiverTemplate)
+	if l > 0 {
+		n += 1 + l + sovOutposts(uint64(l))
+	}
+	l = len(m.MemoTemplate)
+	if l > 0 {
+		n += 1 + l + sovOutposts(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func sovOutposts(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOutposts(x uint64) (n int) {
+	return sovOutposts(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Route) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutposts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Route: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutposts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutposts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutposts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutposts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutposts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemoTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutposts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutposts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOutposts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOutposts(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOutposts
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutposts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutposts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOutposts
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOutposts
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOutposts
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOutposts        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOutposts          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOutposts = fmt.Errorf("proto: unexpected end of group")
+)
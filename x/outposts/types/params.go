@@ -0,0 +1,58 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultEnableOutposts defines the default value of the enable_outposts parameter
+var DefaultEnableOutposts = true
+
+// NewParams creates a new Params object
+func NewParams(enableOutposts bool, routes ...Route) Params {
+	return Params{
+		EnableOutposts: enableOutposts,
+		Routes:         routes,
+	}
+}
+
+// DefaultParams returns the default outposts params. No route is registered by
+// default, since the channels to the destination chains differ on each network.
+func DefaultParams() Params {
+	return Params{
+		EnableOutposts: DefaultEnableOutposts,
+		Routes:         []Route{},
+	}
+}
+
+// Validate performs a stateless validation of the outposts params.
+func (p Params) Validate() error {
+	seenRoutes := make(map[string]bool, len(p.Routes))
+	for _, route := range p.Routes {
+		if seenRoutes[route.Name] {
+			return fmt.Errorf("duplicate route name %s", route.Name)
+		}
+
+		if err := route.Validate(); err != nil {
+			return err
+		}
+
+		seenRoutes[route.Name] = true
+	}
+
+	return nil
+}
+
+// GetRoute returns the route with the given name and a boolean indicating
+// whether it was found.
+func (p Params) GetRoute(name string) (Route, bool) {
+	for _, route := range p.Routes {
+		if route.Name == name {
+			return route, true
+		}
+	}
+
+	return Route{}, false
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	route := Route{
+		Name:             "osmosis-swap",
+		ChainId:          "osmosis-1",
+		SourceChannel:    "channel-0",
+		ReceiverTemplate: "osmo1a34wxsxjwvtz3ua4hnkh4lv3d4qrgry0fhkasppplphwu5k538tqcyms9x",
+		MemoTemplate:     WasmHooksSwapMemoTemplate,
+		Enabled:          true,
+	}
+
+	strideRoute := Route{
+		Name:             "stride-stake",
+		ChainId:          "stride-1",
+		SourceChannel:    "channel-1",
+		ReceiverTemplate: "{{stride_receiver}}",
+		MemoTemplate:     AutopilotLiquidStakeMemoTemplate,
+		Enabled:          true,
+	}
+
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{"default", DefaultParams(), false},
+		{"valid: empty", Params{}, false},
+		{"valid: routes", NewParams(true, route, strideRoute), false},
+		{"valid: disabled", NewParams(false, route), false},
+		{"invalid: duplicate route", NewParams(true, route, route), true},
+		{"invalid: route", NewParams(true, route, Route{Name: "invalid"}), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestParamsGetRoute(t *testing.T) {
+	route := Route{Name: "osmosis-swap"}
+	params := NewParams(true, route)
+
+	found, ok := params.GetRoute("osmosis-swap")
+	require.True(t, ok)
+	require.Equal(t, route, found)
+
+	_, ok = params.GetRoute("stride-stake")
+	require.False(t, ok)
+}
@@ -0,0 +1,235 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+const (
+	// ArgSender is the template argument holding the bech32 address of the
+	// sender on this chain.
+	ArgSender = "sender"
+	// ArgReceiver is the template argument holding the final receiver provided
+	// by the caller of the outpost.
+	ArgReceiver = "receiver"
+	// ArgDenom is the template argument holding the denomination of the
+	// transferred coin on this chain.
+	ArgDenom = "denom"
+	// ArgAmount is the template argument holding the transferred amount.
+	ArgAmount = "amount"
+	// ArgIBCReceiver is the template argument holding the rendered receiver of
+	// the ICS20 transfer. It is only available to the memo template.
+	ArgIBCReceiver = "ibc_receiver"
+)
+
+const (
+	// WasmHooksSwapMemoTemplate is the memo template of a swap executed by the
+	// Osmosis Cross-Chain Swap contract (XCS) through wasm hooks. The receiver
+	// template of the route has to be the XCS contract address.
+	WasmHooksSwapMemoTemplate = `{"wasm":{"contract":"{{ibc_receiver}}","msg":{"osmosis_swap":{"output_denom":"{{output_denom}}","slippage":{"twap":{"slippage_percentage":"{{slippage_percentage}}","window_seconds":{{window_seconds}}}},"receiver":"{{receiver}}","on_failed_delivery":{"local_recovery_addr":"{{recovery_address}}"}}}}}`
+	// PacketForwardMemoTemplate is the memo template of a transfer forwarded by
+	// the packet-forward-middleware of the destination chain to the chain
+	// connected through the forward_channel argument.
+	PacketForwardMemoTemplate = `{"forward":{"receiver":"{{receiver}}","port":"transfer","channel":"{{forward_channel}}"}}`
+	// AutopilotLiquidStakeMemoTemplate is the memo template of a liquid stake
+	// executed by the Stride autopilot module. The liquid staked tokens are sent
+	// back to the receiver provided by the caller.
+	AutopilotLiquidStakeMemoTemplate = `{"autopilot":{"receiver":"{{ibc_receiver}}","stakeibc":{"action":"LiquidStake","ibc_receiver":"{{receiver}}"}}}`
+)
+
+const (
+	placeholderOpen  = "{{"
+	placeholderClose = "}}"
+)
+
+var (
+	routeNameRegex   = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]*$`)
+	placeholderRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	jsonNumberRegex  = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+)
+
+// reservedArgs are the template arguments set by the outpost that cannot be
+// provided by the caller.
+var reservedArgs = map[string]bool{
+	ArgSender:      true,
+	ArgReceiver:    true,
+	ArgDenom:       true,
+	ArgAmount:      true,
+	ArgIBCReceiver: true,
+}
+
+// IsReservedArg returns true if the given template argument is set by the
+// outpost and cannot be provided by the caller.
+func IsReservedArg(key string) bool {
+	return reservedArgs[key]
+}
+
+// Validate performs a stateless validation of the route fields and templates.
+func (r Route) Validate() error {
+	if !routeNameRegex.MatchString(r.Name) {
+		return errorsmod.Wrapf(ErrInvalidRoute, "invalid route name '%s'", r.Name)
+	}
+
+	if strings.TrimSpace(r.ChainId) == "" {
+		return errorsmod.Wrapf(ErrInvalidRoute, "empty chain id for route %s", r.Name)
+	}
+
+	if err := host.ChannelIdentifierValidator(r.SourceChannel); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRoute, "invalid source channel for route %s: %s", r.Name, err)
+	}
+
+	if strings.TrimSpace(r.ReceiverTemplate) == "" {
+		return errorsmod.Wrapf(ErrInvalidRoute, "empty receiver template for route %s", r.Name)
+	}
+
+	if _, err := renderTemplate(r.ReceiverTemplate, nil, false); err != nil {
+		return errorsmod.Wrapf(err, "receiver template of route %s", r.Name)
+	}
+
+	if r.MemoTemplate == "" {
+		return nil
+	}
+
+	// Every placeholder is rendered as a number so that it is valid both
+	// inside and outside JSON strings.
+	memo, err := renderTemplate(r.MemoTemplate, nil, true)
+	if err != nil {
+		return errorsmod.Wrapf(err, "memo template of route %s", r.Name)
+	}
+
+	return validateMemo(memo)
+}
+
+// RenderReceiver renders the receiver template of the route with the given
+// arguments.
+func (r Route) RenderReceiver(args map[string]string) (string, error) {
+	receiver, err := renderTemplate(r.ReceiverTemplate, args, false)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(receiver) == "" {
+		return "", errorsmod.Wrapf(ErrInvalidArgument, "empty receiver for route %s", r.Name)
+	}
+
+	return receiver, nil
+}
+
+// RenderMemo renders the memo template of the route with the given arguments.
+// Arguments placed inside JSON strings are escaped, while arguments placed
+// outside of them must be JSON numbers.
+func (r Route) RenderMemo(args map[string]string) (string, error) {
+	if r.MemoTemplate == "" {
+		return "", nil
+	}
+
+	memo, err := renderTemplate(r.MemoTemplate, args, true)
+	if err != nil {
+		return "", err
+	}
+
+	if err := validateMemo(memo); err != nil {
+		return "", err
+	}
+
+	return memo, nil
+}
+
+// validateMemo checks that the given memo is a JSON object.
+func validateMemo(memo string) error {
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(memo), &obj); err != nil {
+		return errorsmod.Wrapf(ErrInvalidTemplate, "memo is not a JSON object: %s", err)
+	}
+
+	return nil
+}
+
+// renderTemplate replaces the placeholders of the template with the given
+// arguments. When args is nil, every placeholder is replaced with a zero value,
+// which is used to validate the template. When isJSON is true, the template
+// is parsed as JSON: values placed inside strings are escaped and values placed
+// outside of them must be numbers.
+func renderTemplate(tmpl string, args map[string]string, isJSON bool) (string, error) {
+	var (
+		sb       strings.Builder
+		inString bool
+		escaped  bool
+	)
+
+	for i := 0; i < len(tmpl); {
+		if strings.HasPrefix(tmpl[i:], placeholderOpen) {
+			end := strings.Index(tmpl[i:], placeholderClose)
+			if end < 0 {
+				return "", errorsmod.Wrapf(ErrInvalidTemplate, "unclosed placeholder at position %d", i)
+			}
+
+			key := tmpl[i+len(placeholderOpen) : i+end]
+			if !placeholderRegex.MatchString(key) {
+				return "", errorsmod.Wrapf(ErrInvalidTemplate, "invalid placeholder '%s'", key)
+			}
+
+			value, err := templateValue(key, args, isJSON && inString, isJSON && !inString)
+			if err != nil {
+				return "", err
+			}
+
+			sb.WriteString(value)
+			i += end + len(placeholderClose)
+			continue
+		}
+
+		c := tmpl[i]
+		if isJSON {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\' && inString:
+				escaped = true
+			case c == '"':
+				inString = !inString
+			}
+		}
+
+		sb.WriteByte(c)
+		i++
+	}
+
+	return sb.String(), nil
+}
+
+// templateValue returns the value of the placeholder key, escaping it for a
+// JSON string or checking that it is a JSON number when required.
+func templateValue(key string, args map[string]string, jsonString, jsonNumber bool) (string, error) {
+	if args == nil {
+		return "0", nil
+	}
+
+	value, ok := args[key]
+	if !ok {
+		return "", errorsmod.Wrapf(ErrInvalidArgument, "missing argument '%s'", key)
+	}
+
+	switch {
+	case jsonString:
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return "", errorsmod.Wrapf(ErrInvalidArgument, "argument '%s': %s", key, err)
+		}
+		// strip the surrounding quotes
+		return string(bz[1 : len(bz)-1]), nil
+	case jsonNumber:
+		if !jsonNumberRegex.MatchString(value) {
+			return "", errorsmod.Wrapf(ErrInvalidArgument, "argument '%s' must be a number, got '%s'", key, value)
+		}
+	}
+
+	return value, nil
+}
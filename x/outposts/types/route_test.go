@@ -0,0 +1,113 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteValidate(t *testing.T) {
+	validRoute := func() Route {
+		return Route{
+			Name:             "hub-forward",
+			ChainId:          "cosmoshub-4",
+			SourceChannel:    "channel-3",
+			ReceiverTemplate: "pfm",
+			MemoTemplate:     PacketForwardMemoTemplate,
+			Enabled:          true,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*Route)
+		expError bool
+	}{
+		{"valid", func(*Route) {}, false},
+		{"valid: empty memo template", func(r *Route) { r.MemoTemplate = "" }, false},
+		{"valid: wasm hooks template", func(r *Route) { r.MemoTemplate = WasmHooksSwapMemoTemplate }, false},
+		{"invalid: empty name", func(r *Route) { r.Name = "" }, true},
+		{"invalid: name with spaces", func(r *Route) { r.Name = "hub forward" }, true},
+		{"invalid: empty chain id", func(r *Route) { r.ChainId = " " }, true},
+		{"invalid: source channel", func(r *Route) { r.SourceChannel = "channel" }, true},
+		{"invalid: empty receiver template", func(r *Route) { r.ReceiverTemplate = "" }, true},
+		{"invalid: unclosed placeholder", func(r *Route) { r.ReceiverTemplate = "{{receiver" }, true},
+		{"invalid: placeholder key", func(r *Route) { r.ReceiverTemplate = "{{Receiver}}" }, true},
+		{"invalid: memo is not JSON", func(r *Route) { r.MemoTemplate = `{"forward":` }, true},
+		{"invalid: memo is not an object", func(r *Route) { r.MemoTemplate = `["{{receiver}}"]` }, true},
+	}
+
+	for _, tc := range testCases {
+		route := validRoute()
+		tc.malleate(&route)
+		err := route.Validate()
+
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestRouteRender(t *testing.T) {
+	route := Route{
+		Name:             "stride-stake",
+		ChainId:          "stride-1",
+		SourceChannel:    "channel-1",
+		ReceiverTemplate: "{{stride_receiver}}",
+		MemoTemplate:     `{"autopilot":{"receiver":"{{ibc_receiver}}","amount":{{amount}},"note":"{{note}}"}}`,
+	}
+
+	testCases := []struct {
+		name        string
+		args        map[string]string
+		expReceiver string
+		expMemo     string
+		errContains string
+	}{
+		{
+			"pass",
+			map[string]string{"stride_receiver": "stride1abc", ArgIBCReceiver: "stride1abc", ArgAmount: "100", "note": "liquid stake"},
+			"stride1abc",
+			`{"autopilot":{"receiver":"stride1abc","amount":100,"note":"liquid stake"}}`,
+			"",
+		},
+		{
+			"pass: string values are escaped",
+			map[string]string{"stride_receiver": "stride1abc", ArgIBCReceiver: "stride1abc", ArgAmount: "100", "note": `","evil":"`},
+			"stride1abc",
+			`{"autopilot":{"receiver":"stride1abc","amount":100,"note":"\",\"evil\":\""}}`,
+			"",
+		},
+		{
+			"fail: missing argument",
+			map[string]string{"stride_receiver": "stride1abc", ArgIBCReceiver: "stride1abc", ArgAmount: "100"},
+			"stride1abc",
+			"",
+			"missing argument 'note'",
+		},
+		{
+			"fail: number value is not a number",
+			map[string]string{"stride_receiver": "stride1abc", ArgIBCReceiver: "stride1abc", ArgAmount: `1,"evil":2`, "note": ""},
+			"stride1abc",
+			"",
+			"argument 'amount' must be a number",
+		},
+	}
+
+	for _, tc := range testCases {
+		receiver, err := route.RenderReceiver(tc.args)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expReceiver, receiver, tc.name)
+
+		memo, err := route.RenderMemo(tc.args)
+		if tc.errContains != "" {
+			require.ErrorContains(t, err, tc.errContains, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expMemo, memo, tc.name)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package randomness
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/randomness/keeper"
+	"github.com/evmos/evmos/v16/x/randomness/types"
+)
+
+// InitGenesis initializes the randomness module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetSeed(ctx, genState.Seed)
+	k.SetNextRequestID(ctx, genState.NextRequestID)
+
+	for _, randomness := range genState.Randomness {
+		k.SetRandomness(ctx, randomness.Height, randomness.Value)
+	}
+
+	for _, commitment := range genState.Commitments {
+		k.SetCommitment(ctx, commitment)
+	}
+
+	for _, reveal := range genState.Reveals {
+		k.SetReveal(ctx, reveal)
+	}
+
+	for _, request := range genState.Requests {
+		k.SetRequest(ctx, request)
+	}
+}
+
+// ExportGenesis returns the randomness module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	commitments, err := k.GetAllCommitments(ctx)
+	if err != nil {
+		panic(fmt.Errorf("failed to export the commitments: %w", err))
+	}
+
+	reveals, err := k.GetAllReveals(ctx)
+	if err != nil {
+		panic(fmt.Errorf("failed to export the reveals: %w", err))
+	}
+
+	requests, err := k.GetAllRequests(ctx)
+	if err != nil {
+		panic(fmt.Errorf("failed to export the requests: %w", err))
+	}
+
+	return &types.GenesisState{
+		Seed:          k.GetSeed(ctx),
+		Randomness:    k.GetAllRandomness(ctx),
+		Commitments:   commitments,
+		Reveals:       reveals,
+		Requests:      requests,
+		NextRequestID: k.GetNextRequestID(ctx),
+	}
+}
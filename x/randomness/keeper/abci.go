@@ -0,0 +1,70 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v16/x/randomness/types"
+)
+
+// BeginBlocker derives the randomness of the current block from the randomness
+// of the previous block, the hash of the last commit and the secrets whose
+// reveal height is the current one. It then fulfills the randomness requests
+// due at the current height.
+//
+// NOTE: the hash of the last commit only makes the randomness unpredictable
+// to the requesters. The proposer chooses which precommits are included in the
+// last commit, so it can grind over their subsets to bias the hash, and it is
+// not a source of bias-resistant entropy. That is provided by the secrets,
+// which are committed before they are known to the other validators and mixed
+// in at a height fixed by their commitments, so a proposer excluding a reveal
+// transaction only delays it within the reveal window. The validators that
+// don't reveal their secrets in time are skipped and jailed.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	missed, err := k.penalizeMissedReveals(ctx, height)
+	if err != nil {
+		k.Logger(ctx).Error("failed to penalize the missed reveals", "error", err.Error())
+	}
+
+	for _, validator := range missed {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMissedReveal,
+				sdk.NewAttribute(types.AttributeKeyValidator, sdk.ValAddress(validator.Bytes()).String()),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(height, 10)),
+			),
+		)
+	}
+
+	reveals := k.popReveals(ctx, height)
+
+	data := [][]byte{k.GetSeed(ctx).Bytes(), sdk.Uint64ToBigEndian(height), ctx.BlockHeader().LastCommitHash}
+	data = append(data, reveals...)
+	randomness := crypto.Keccak256Hash(data...)
+
+	k.SetRandomness(ctx, height, randomness)
+	k.SetSeed(ctx, randomness)
+
+	if height > types.RandomnessRetention {
+		k.pruneRandomness(ctx, height-types.RandomnessRetention)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRandomness,
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyRandomness, randomness.Hex()),
+			sdk.NewAttribute(types.AttributeKeyReveals, strconv.Itoa(len(reveals))),
+		),
+	)
+
+	if err := k.fulfillRequests(ctx, height, randomness); err != nil {
+		k.Logger(ctx).Error("failed to fulfill the randomness requests", "error", err.Error())
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v16/x/randomness/types"
+)
+
+// Commit stores the commitment of a bonded validator, given its operator
+// address. A validator can only commit again once its previous commitment is
+// revealed, so that it cannot escape the penalty of a missed reveal.
+func (k Keeper) Commit(ctx sdk.Context, validator common.Address, hash common.Hash) error {
+	if hash == (common.Hash{}) {
+		return types.ErrEmptyCommitment
+	}
+
+	if err := k.checkBonded(ctx, validator); err != nil {
+		return err
+	}
+
+	if commitment, found := k.GetCommitment(ctx, validator); found {
+		return errorsmod.Wrapf(types.ErrCommitmentPending, "committed at height %d", commitment.Height)
+	}
+
+	k.SetCommitment(ctx, types.Commitment{
+		Validator: validator,
+		Height:    uint64(ctx.BlockHeight()),
+		Hash:      hash,
+	})
+
+	return nil
+}
+
+// Reveal checks the secret against the commitment of the validator and adds it
+// to the reveals mixed into the randomness at the reveal height of the
+// commitment. The commitment must have been made on a previous block.
+func (k Keeper) Reveal(ctx sdk.Context, validator common.Address, secret common.Hash) error {
+	if err := k.checkBonded(ctx, validator); err != nil {
+		return err
+	}
+
+	commitment, found := k.GetCommitment(ctx, validator)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCommitmentNotFound, "validator %s", validator)
+	}
+
+	if commitment.Height >= uint64(ctx.BlockHeight()) {
+		return errorsmod.Wrapf(types.ErrCommitmentNotMatured, "committed at height %d", commitment.Height)
+	}
+
+	if commitment.RevealHeight() <= uint64(ctx.BlockHeight()) {
+		return errorsmod.Wrapf(types.ErrRevealExpired, "reveal height %d", commitment.RevealHeight())
+	}
+
+	if crypto.Keccak256Hash(secret.Bytes()) != commitment.Hash {
+		return types.ErrInvalidReveal
+	}
+
+	k.deleteCommitment(ctx, validator)
+	k.SetReveal(ctx, types.Reveal{
+		Validator: validator,
+		Height:    commitment.RevealHeight(),
+		Secret:    secret,
+	})
+
+	return nil
+}
+
+// GetCommitment returns the pending commitment of the given validator.
+func (k Keeper) GetCommitment(ctx sdk.Context, validator common.Address) (types.Commitment, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCommitment)
+	bz := store.Get(types.ValidatorKey(validator))
+	if len(bz) == 0 {
+		return types.Commitment{}, false
+	}
+
+	commitment, err := types.UnmarshalCommitment(validator, bz)
+	if err != nil {
+		return types.Commitment{}, false
+	}
+
+	return commitment, true
+}
+
+// SetCommitment stores the commitment of a validator.
+func (k Keeper) SetCommitment(ctx sdk.Context, commitment types.Commitment) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCommitment)
+	store.Set(types.ValidatorKey(commitment.Validator), commitment.Marshal())
+}
+
+// GetAllCommitments returns all the pending commitments.
+func (k Keeper) GetAllCommitments(ctx sdk.Context) ([]types.Commitment, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCommitment)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	commitments := []types.Commitment{}
+	for ; iterator.Valid(); iterator.Next() {
+		commitment, err := types.UnmarshalCommitment(common.BytesToAddress(iterator.Key()), iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		commitments = append(commitments, commitment)
+	}
+
+	return commitments, nil
+}
+
+// deleteCommitment removes the commitment of a validator.
+func (k Keeper) deleteCommitment(ctx sdk.Context, validator common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCommitment)
+	store.Delete(types.ValidatorKey(validator))
+}
+
+// SetReveal stores the reveal of a validator.
+func (k Keeper) SetReveal(ctx sdk.Context, reveal types.Reveal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixReveal)
+	store.Set(types.RevealKey(reveal.Height, reveal.Validator), reveal.Secret.Bytes())
+}
+
+// GetAllReveals returns all the reveals that are not mixed into the randomness
+// yet, ordered by reveal height.
+func (k Keeper) GetAllReveals(ctx sdk.Context) ([]types.Reveal, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixReveal)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	reveals := []types.Reveal{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		reveal, err := types.UnmarshalReveal(common.BytesToAddress(key[8:]), sdk.BigEndianToUint64(key[:8]), iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		reveals = append(reveals, reveal)
+	}
+
+	return reveals, nil
+}
+
+// popReveals returns the secrets revealed for the given height, ordered by
+// validator address, and removes them from the store.
+func (k Keeper) popReveals(ctx sdk.Context, height uint64) [][]byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixReveal, sdk.Uint64ToBigEndian(height)...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys, reveals [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		reveals = append(reveals, iterator.Value())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return reveals
+}
+
+// penalizeMissedReveals removes the commitments whose reveal height is reached
+// without being revealed and jails their validators for the downtime jail
+// duration of the slashing module. Otherwise, a validator could withhold its
+// secret once it knows the other reveals to bias the randomness. It returns
+// the operator addresses of the penalized validators.
+func (k Keeper) penalizeMissedReveals(ctx sdk.Context, height uint64) ([]common.Address, error) {
+	commitments, err := k.GetAllCommitments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var missed []common.Address
+	for _, commitment := range commitments {
+		if commitment.RevealHeight() > height {
+			continue
+		}
+
+		k.deleteCommitment(ctx, commitment.Validator)
+		missed = append(missed, commitment.Validator)
+
+		val, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(commitment.Validator.Bytes()))
+		if !found || !val.IsBonded() || val.IsJailed() {
+			continue
+		}
+
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			k.Logger(ctx).Error("failed to get the consensus address", "validator", val.GetOperator().String(), "error", err.Error())
+			continue
+		}
+
+		if !k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+			k.Logger(ctx).Error("missing signing info of the validator", "validator", val.GetOperator().String())
+			continue
+		}
+
+		k.slashingKeeper.Jail(ctx, consAddr)
+		k.slashingKeeper.JailUntil(ctx, consAddr, ctx.BlockHeader().Time.Add(k.slashingKeeper.DowntimeJailDuration(ctx)))
+	}
+
+	return missed, nil
+}
+
+// checkBonded returns an error if the operator address doesn't belong to a
+// bonded validator.
+func (k Keeper) checkBonded(ctx sdk.Context, validator common.Address) error {
+	val, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(validator.Bytes()))
+	if !found || !val.IsBonded() {
+		return errorsmod.Wrapf(types.ErrValidatorNotBonded, "validator %s", sdk.ValAddress(validator.Bytes()))
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v16/x/randomness/types"
+)
+
+// Keeper of the randomness module. It collects the validator commitments and
+// reveals and derives the randomness of every block.
+type Keeper struct {
+	storeKey       storetypes.StoreKey
+	accountKeeper  types.AccountKeeper
+	stakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
+	evmKeeper      types.EVMKeeper
+}
+
+// NewKeeper returns a new instance of the randomness Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	ak types.AccountKeeper,
+	sk types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
+	evmKeeper types.EVMKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:       storeKey,
+		accountKeeper:  ak,
+		stakingKeeper:  sk,
+		slashingKeeper: slashingKeeper,
+		evmKeeper:      evmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
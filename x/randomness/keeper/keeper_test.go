@@ -0,0 +1,292 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v16/testutil/tx"
+	"github.com/evmos/evmos/v16/x/randomness/types"
+)
+
+func (s *KeeperTestSuite) TestCommitReveal() {
+	secret := common.BytesToHash([]byte("secret"))
+	commitment := crypto.Keccak256Hash(secret.Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func() (common.Address, common.Hash)
+		expError error
+	}{
+		{
+			"fail - not a validator",
+			func() (common.Address, common.Hash) {
+				s.Require().NoError(s.network.App.RandomnessKeeper.Commit(s.network.GetContext(), s.validator, commitment))
+				s.Require().NoError(s.network.NextBlock())
+				return tx.GenerateAddress(), secret
+			},
+			types.ErrValidatorNotBonded,
+		},
+		{
+			"fail - no commitment",
+			func() (common.Address, common.Hash) {
+				return s.validator, secret
+			},
+			types.ErrCommitmentNotFound,
+		},
+		{
+			"fail - reveal on the same block",
+			func() (common.Address, common.Hash) {
+				s.Require().NoError(s.network.App.RandomnessKeeper.Commit(s.network.GetContext(), s.validator, commitment))
+				return s.validator, secret
+			},
+			types.ErrCommitmentNotMatured,
+		},
+		{
+			"fail - secret does not match",
+			func() (common.Address, common.Hash) {
+				s.Require().NoError(s.network.App.RandomnessKeeper.Commit(s.network.GetContext(), s.validator, commitment))
+				s.Require().NoError(s.network.NextBlock())
+				return s.validator, common.BytesToHash([]byte("other"))
+			},
+			types.ErrInvalidReveal,
+		},
+		{
+			"pass - reveal on a later block",
+			func() (common.Address, common.Hash) {
+				s.Require().NoError(s.network.App.RandomnessKeeper.Commit(s.network.GetContext(), s.validator, commitment))
+				s.Require().NoError(s.network.NextBlock())
+				return s.validator, secret
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			validator, reveal := tc.malleate()
+			err := s.network.App.RandomnessKeeper.Reveal(s.network.GetContext(), validator, reveal)
+			if tc.expError != nil {
+				s.Require().ErrorIs(err, tc.expError)
+				return
+			}
+
+			s.Require().NoError(err)
+			_, found := s.network.App.RandomnessKeeper.GetCommitment(s.network.GetContext(), validator)
+			s.Require().False(found, "expected commitment to be removed after reveal")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCommitNotBonded() {
+	err := s.network.App.RandomnessKeeper.Commit(s.network.GetContext(), tx.GenerateAddress(), common.HexToHash("0x01"))
+	s.Require().ErrorIs(err, types.ErrValidatorNotBonded)
+
+	err = s.network.App.RandomnessKeeper.Commit(s.network.GetContext(), s.validator, common.Hash{})
+	s.Require().ErrorIs(err, types.ErrEmptyCommitment)
+}
+
+func (s *KeeperTestSuite) TestCommitPending() {
+	k := s.network.App.RandomnessKeeper
+	secret := common.BytesToHash([]byte("secret"))
+
+	s.Require().NoError(k.Commit(s.network.GetContext(), s.validator, crypto.Keccak256Hash(secret.Bytes())))
+	s.Require().NoError(s.network.NextBlock())
+
+	// the pending commitment cannot be replaced
+	err := k.Commit(s.network.GetContext(), s.validator, common.HexToHash("0x01"))
+	s.Require().ErrorIs(err, types.ErrCommitmentPending)
+
+	// the validator can commit again once revealed
+	s.Require().NoError(k.Reveal(s.network.GetContext(), s.validator, secret))
+	s.Require().NoError(k.Commit(s.network.GetContext(), s.validator, common.HexToHash("0x01")))
+}
+
+func (s *KeeperTestSuite) TestRevealExpired() {
+	k := s.network.App.RandomnessKeeper
+	secret := common.BytesToHash([]byte("secret"))
+
+	ctx := s.network.GetContext()
+	s.Require().NoError(k.Commit(ctx, s.validator, crypto.Keccak256Hash(secret.Bytes())))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(types.RevealWindow))
+	s.Require().ErrorIs(k.Reveal(ctx, s.validator, secret), types.ErrRevealExpired)
+}
+
+func (s *KeeperTestSuite) TestBeginBlocker() {
+	k := s.network.App.RandomnessKeeper
+	secret := common.BytesToHash([]byte("secret"))
+
+	ctx := s.network.GetContext()
+	s.Require().NoError(k.Commit(ctx, s.validator, crypto.Keccak256Hash(secret.Bytes())))
+	revealHeight := uint64(ctx.BlockHeight()) + types.RevealWindow
+	s.Require().NoError(s.network.NextBlock())
+
+	ctx = s.network.GetContext()
+	seed := k.GetSeed(ctx)
+	randomness, found := k.GetRandomness(ctx, uint64(ctx.BlockHeight()))
+	s.Require().True(found)
+	s.Require().Equal(seed, randomness, "expected the seed to be the randomness of the current block")
+
+	s.Require().NoError(k.Reveal(ctx, s.validator, secret))
+	reveals, err := k.GetAllReveals(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Reveal{{Validator: s.validator, Height: revealHeight, Secret: secret}}, reveals)
+
+	// the reveal is not mixed in before the reveal height
+	for uint64(s.network.GetContext().BlockHeight()) < revealHeight-1 {
+		s.Require().NoError(s.network.NextBlock())
+		ctx = s.network.GetContext()
+		height := uint64(ctx.BlockHeight())
+		expRandomness := crypto.Keccak256Hash(seed.Bytes(), types.RandomnessKey(height), ctx.BlockHeader().LastCommitHash)
+
+		randomness, found = k.GetRandomness(ctx, height)
+		s.Require().True(found)
+		s.Require().Equal(expRandomness, randomness)
+		seed = randomness
+	}
+
+	s.Require().NoError(s.network.NextBlock())
+	ctx = s.network.GetContext()
+	s.Require().Equal(revealHeight, uint64(ctx.BlockHeight()))
+	expRandomness := crypto.Keccak256Hash(seed.Bytes(), types.RandomnessKey(revealHeight), ctx.BlockHeader().LastCommitHash, secret.Bytes())
+
+	randomness, found = k.GetRandomness(ctx, revealHeight)
+	s.Require().True(found)
+	s.Require().Equal(expRandomness, randomness, "expected the reveal to be mixed into the randomness")
+	reveals, err = k.GetAllReveals(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(reveals, "expected the reveal to be used only once")
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerMissedReveal() {
+	k := s.network.App.RandomnessKeeper
+
+	ctx := s.network.GetContext()
+
+	// the validators of the test network don't have signing info
+	val, found := s.network.App.StakingKeeper.GetValidator(ctx, s.validator.Bytes())
+	s.Require().True(found)
+	consAddr, err := val.GetConsAddr()
+	s.Require().NoError(err)
+	s.network.App.SlashingKeeper.SetValidatorSigningInfo(
+		ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), 0, time.Unix(0, 0), false, 0),
+	)
+
+	s.Require().NoError(k.Commit(ctx, s.validator, common.HexToHash("0x01")))
+
+	// the commitment is kept until its reveal height
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(types.RevealWindow) - 1).WithEventManager(sdk.NewEventManager())
+	k.BeginBlocker(ctx)
+	_, found = k.GetCommitment(ctx, s.validator)
+	s.Require().True(found)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	k.BeginBlocker(ctx)
+
+	_, found = k.GetCommitment(ctx, s.validator)
+	s.Require().False(found, "expected the missed commitment to be removed")
+
+	val, found = s.network.App.StakingKeeper.GetValidator(ctx, s.validator.Bytes())
+	s.Require().True(found)
+	s.Require().True(val.IsJailed(), "expected the validator to be jailed")
+
+	signingInfo, found := s.network.App.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	s.Require().True(found)
+	expJailedUntil := ctx.BlockHeader().Time.Add(s.network.App.SlashingKeeper.DowntimeJailDuration(ctx))
+	s.Require().Equal(expJailedUntil, signingInfo.JailedUntil, "expected the validator to be jailed for the downtime jail duration")
+
+	var missed bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeMissedReveal {
+			continue
+		}
+		missed = true
+		s.Require().Contains(event.Attributes, abci.EventAttribute{
+			Key:   types.AttributeKeyValidator,
+			Value: sdk.ValAddress(s.validator.Bytes()).String(),
+		})
+	}
+	s.Require().True(missed, "expected a missed reveal event")
+}
+
+func (s *KeeperTestSuite) TestRequestRandomness() {
+	k := s.network.App.RandomnessKeeper
+	requester := tx.GenerateAddress()
+
+	_, err := k.RequestRandomness(s.network.GetContext(), requester, 0)
+	s.Require().ErrorIs(err, types.ErrInvalidCallbackGasLimit)
+
+	_, err = k.RequestRandomness(s.network.GetContext(), requester, types.MaxCallbackGasLimit+1)
+	s.Require().ErrorIs(err, types.ErrInvalidCallbackGasLimit)
+
+	ctx := s.network.GetContext()
+	request, err := k.RequestRandomness(ctx, requester, 100_000)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), request.ID)
+	s.Require().Equal(uint64(ctx.BlockHeight())+types.RequestDelay, request.Height)
+	requests, err := k.GetAllRequests(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Request{request}, requests)
+	s.Require().Equal(uint64(2), k.GetNextRequestID(ctx))
+
+	// the request is pending until the fulfillment height
+	for i := uint64(0); i < types.RequestDelay-1; i++ {
+		s.Require().NoError(s.network.NextBlock())
+		requests, err = k.GetAllRequests(s.network.GetContext())
+		s.Require().NoError(err)
+		s.Require().Len(requests, 1)
+	}
+
+	// run the begin block of the fulfillment height to check the callback events
+	ctx = s.network.GetContext()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	k.BeginBlocker(ctx)
+
+	requests, err = k.GetAllRequests(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(requests, "expected the request to be fulfilled")
+
+	var fulfilled bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeFulfillRequest {
+			continue
+		}
+		fulfilled = true
+		s.Require().Contains(event.Attributes, abci.EventAttribute{Key: types.AttributeKeySuccess, Value: "true"})
+	}
+	s.Require().True(fulfilled, "expected a fulfill request event")
+}
+
+func (s *KeeperTestSuite) TestBeginBlockerCallbackGasCap() {
+	k := s.network.App.RandomnessKeeper
+	requester := tx.GenerateAddress()
+
+	ctx := s.network.GetContext()
+	maxRequests := types.MaxBlockCallbackGas / types.MaxCallbackGasLimit
+	for i := uint64(0); i <= maxRequests; i++ {
+		_, err := k.RequestRandomness(ctx, requester, types.MaxCallbackGasLimit)
+		s.Require().NoError(err)
+	}
+
+	// the requests exceeding the block callback gas are fulfilled on the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(types.RequestDelay)).WithEventManager(sdk.NewEventManager())
+	k.BeginBlocker(ctx)
+
+	requests, err := k.GetAllRequests(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(requests, 1, "expected the last request to be pending")
+	s.Require().Equal(maxRequests+1, requests[0].ID)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	k.BeginBlocker(ctx)
+
+	requests, err = k.GetAllRequests(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(requests, "expected the request to be fulfilled")
+}
@@ -0,0 +1,76 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v16/x/randomness/types"
+)
+
+// GetRandomness returns the randomness of the given height.
+func (k Keeper) GetRandomness(ctx sdk.Context, height uint64) (common.Hash, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRandomness)
+	bz := store.Get(types.RandomnessKey(height))
+	if len(bz) == 0 {
+		return common.Hash{}, false
+	}
+
+	return common.BytesToHash(bz), true
+}
+
+// SetRandomness stores the randomness of the given height.
+func (k Keeper) SetRandomness(ctx sdk.Context, height uint64, randomness common.Hash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRandomness)
+	store.Set(types.RandomnessKey(height), randomness.Bytes())
+}
+
+// GetAllRandomness returns the randomness of all the retained heights.
+func (k Keeper) GetAllRandomness(ctx sdk.Context) []types.Randomness {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRandomness)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	randomness := []types.Randomness{}
+	for ; iterator.Valid(); iterator.Next() {
+		randomness = append(randomness, types.Randomness{
+			Height: sdk.BigEndianToUint64(iterator.Key()),
+			Value:  common.BytesToHash(iterator.Value()),
+		})
+	}
+
+	return randomness
+}
+
+// pruneRandomness deletes the randomness of all the heights lower or equal to
+// the given one.
+func (k Keeper) pruneRandomness(ctx sdk.Context, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRandomness)
+	iterator := store.Iterator(nil, types.RandomnessKey(height+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetSeed returns the latest randomness, which is used as the seed of the
+// randomness of the next block.
+func (k Keeper) GetSeed(ctx sdk.Context) common.Hash {
+	store := ctx.KVStore(k.storeKey)
+	return common.BytesToHash(store.Get(types.KeySeed))
+}
+
+// SetSeed stores the latest randomness.
+func (k Keeper) SetSeed(ctx sdk.Context, seed common.Hash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeySeed, seed.Bytes())
+}
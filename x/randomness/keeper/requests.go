@@ -0,0 +1,213 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	"github.com/evmos/evmos/v16/x/randomness/types"
+)
+
+// fulfillRandomnessArgs are the arguments of the fulfillRandomness callback.
+var fulfillRandomnessArgs abi.Arguments
+
+func init() {
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+	fulfillRandomnessArgs = abi.Arguments{{Type: uint256Type}, {Type: bytes32Type}}
+}
+
+// RequestRandomness registers a randomness request of the given contract. The
+// requester is called back with the randomness of the block RequestDelay
+// blocks after the current one.
+func (k Keeper) RequestRandomness(ctx sdk.Context, requester common.Address, callbackGasLimit uint64) (types.Request, error) {
+	if callbackGasLimit == 0 || callbackGasLimit > types.MaxCallbackGasLimit {
+		return types.Request{}, errorsmod.Wrapf(
+			types.ErrInvalidCallbackGasLimit, "expected (0, %d], got %d", types.MaxCallbackGasLimit, callbackGasLimit,
+		)
+	}
+
+	request := types.Request{
+		ID:               k.GetNextRequestID(ctx),
+		Requester:        requester,
+		Height:           uint64(ctx.BlockHeight()) + types.RequestDelay,
+		CallbackGasLimit: callbackGasLimit,
+	}
+
+	k.SetRequest(ctx, request)
+	k.SetNextRequestID(ctx, request.ID+1)
+
+	return request, nil
+}
+
+// GetNextRequestID returns the identifier of the next randomness request.
+func (k Keeper) GetNextRequestID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyNextRequestID)
+	if len(bz) == 0 {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextRequestID stores the identifier of the next randomness request.
+func (k Keeper) SetNextRequestID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNextRequestID, sdk.Uint64ToBigEndian(id))
+}
+
+// SetRequest stores a pending randomness request.
+func (k Keeper) SetRequest(ctx sdk.Context, request types.Request) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRequest)
+	store.Set(types.RequestKey(request.Height, request.ID), request.Marshal())
+}
+
+// GetAllRequests returns all the pending randomness requests, ordered by
+// fulfillment height.
+func (k Keeper) GetAllRequests(ctx sdk.Context) ([]types.Request, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRequest)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	requests := []types.Request{}
+	for ; iterator.Valid(); iterator.Next() {
+		request, err := types.UnmarshalRequest(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+
+	return requests, nil
+}
+
+// dueRequests returns the pending requests to be fulfilled up to the given
+// height (inclusive), in order, whose total callback gas limit doesn't exceed
+// MaxBlockCallbackGas.
+func (k Keeper) dueRequests(ctx sdk.Context, height uint64) ([]types.Request, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRequest)
+	iterator := store.Iterator(nil, types.RequestKey(height+1, 0))
+	defer iterator.Close()
+
+	var (
+		requests []types.Request
+		gas      uint64
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		request, err := types.UnmarshalRequest(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		if gas+request.CallbackGasLimit > types.MaxBlockCallbackGas {
+			break
+		}
+
+		gas += request.CallbackGasLimit
+		requests = append(requests, request)
+	}
+
+	return requests, nil
+}
+
+// fulfillRequests calls back the contracts of the requests due at the given
+// height, up to MaxBlockCallbackGas. Each callback is executed in a cached
+// context and is limited to the gas requested, which is paid by the requester
+// on the request, so a failing callback doesn't affect the block execution.
+func (k Keeper) fulfillRequests(ctx sdk.Context, height uint64, randomness common.Hash) error {
+	requests, err := k.dueRequests(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRequest)
+	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	from := common.BytesToAddress(moduleAcc.GetAddress())
+
+	for _, request := range requests {
+		store.Delete(types.RequestKey(request.Height, request.ID))
+
+		value, found := k.GetRandomness(ctx, request.Height)
+		if !found {
+			// requests imported from genesis might point to a pruned height
+			value = randomness
+		}
+
+		err := k.callback(ctx, from, moduleAcc.GetSequence(), request, value)
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyRequestID, strconv.FormatUint(request.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRequester, request.Requester.Hex()),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		}
+		if err != nil {
+			k.Logger(ctx).Debug(
+				"randomness callback failed",
+				"request-id", request.ID,
+				"requester", request.Requester.Hex(),
+				"error", err.Error(),
+			)
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyErrorMessage, err.Error()))
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFulfillRequest, attrs...))
+	}
+
+	return nil
+}
+
+// callback executes the fulfillRandomness method of the requester contract.
+// The state changes are only committed if the call succeeds.
+func (k Keeper) callback(
+	ctx sdk.Context,
+	from common.Address,
+	nonce uint64,
+	request types.Request,
+	randomness common.Hash,
+) error {
+	args, err := fulfillRandomnessArgs.Pack(new(big.Int).SetUint64(request.ID), randomness)
+	if err != nil {
+		return err
+	}
+
+	data := append(crypto.Keccak256([]byte(types.FulfillRandomnessSignature))[:4], args...)
+
+	msg := ethtypes.NewMessage(
+		from,
+		&request.Requester,
+		nonce,
+		big.NewInt(0),            // amount
+		request.CallbackGasLimit, // gasLimit
+		big.NewInt(0),            // gasFeeCap
+		big.NewInt(0),            // gasTipCap
+		big.NewInt(0),            // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	res, err := k.evmKeeper.ApplyMessage(cacheCtx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return err
+	}
+
+	if res.Failed() {
+		return errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	writeFn()
+	return nil
+}
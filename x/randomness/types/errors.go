@@ -0,0 +1,21 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrValidatorNotBonded      = errorsmod.Register(ModuleName, 2, "validator is not bonded")
+	ErrCommitmentNotFound      = errorsmod.Register(ModuleName, 3, "commitment not found")
+	ErrCommitmentNotMatured    = errorsmod.Register(ModuleName, 4, "commitment must be revealed on a later block")
+	ErrInvalidReveal           = errorsmod.Register(ModuleName, 5, "reveal does not match commitment")
+	ErrRandomnessNotFound      = errorsmod.Register(ModuleName, 6, "randomness not found")
+	ErrInvalidCallbackGasLimit = errorsmod.Register(ModuleName, 7, "invalid callback gas limit")
+	ErrEmptyCommitment         = errorsmod.Register(ModuleName, 8, "commitment cannot be empty")
+	ErrCommitmentPending       = errorsmod.Register(ModuleName, 9, "pending commitment must be revealed first")
+	ErrRevealExpired           = errorsmod.Register(ModuleName, 10, "reveal height of the commitment reached")
+)
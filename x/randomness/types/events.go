@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// randomness events
+const (
+	EventTypeRandomness      = "randomness"
+	EventTypeFulfillRequest  = "fulfill_randomness_request"
+	EventTypeMissedReveal    = "missed_randomness_reveal"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyHeight       = "height"
+	AttributeKeyRandomness   = "randomness"
+	AttributeKeyReveals      = "reveals"
+	AttributeKeyRequestID    = "request_id"
+	AttributeKeyRequester    = "requester"
+	AttributeKeySuccess      = "success"
+	AttributeKeyErrorMessage = "error"
+)
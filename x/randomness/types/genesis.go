@@ -0,0 +1,95 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GenesisState defines the randomness module's genesis state.
+type GenesisState struct {
+	// Seed is the initial randomness, used as the previous randomness of the first block.
+	Seed common.Hash `json:"seed"`
+	// Randomness is the randomness of the retained heights.
+	Randomness []Randomness `json:"randomness"`
+	// Commitments are the pending validator commitments.
+	Commitments []Commitment `json:"commitments"`
+	// Reveals are the secrets revealed by the validators that are not mixed into the randomness yet.
+	Reveals []Reveal `json:"reveals"`
+	// Requests are the pending randomness requests.
+	Requests []Request `json:"requests"`
+	// NextRequestID is the identifier of the next randomness request.
+	NextRequestID uint64 `json:"next_request_id"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(seed common.Hash) *GenesisState {
+	return &GenesisState{
+		Seed:          seed,
+		Randomness:    []Randomness{},
+		Commitments:   []Commitment{},
+		Reveals:       []Reveal{},
+		Requests:      []Request{},
+		NextRequestID: 1,
+	}
+}
+
+// DefaultGenesisState sets default randomness genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(common.Hash{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenHeights := make(map[uint64]bool)
+	for _, r := range gs.Randomness {
+		if seenHeights[r.Height] {
+			return fmt.Errorf("duplicated randomness for height %d", r.Height)
+		}
+		seenHeights[r.Height] = true
+	}
+
+	seenValidators := make(map[common.Address]bool)
+	for _, c := range gs.Commitments {
+		if seenValidators[c.Validator] {
+			return fmt.Errorf("duplicated commitment for validator %s", c.Validator)
+		}
+		if c.Hash == (common.Hash{}) {
+			return fmt.Errorf("empty commitment for validator %s", c.Validator)
+		}
+		seenValidators[c.Validator] = true
+	}
+
+	seenReveals := make(map[string]bool)
+	for _, r := range gs.Reveals {
+		key := string(RevealKey(r.Height, r.Validator))
+		if seenReveals[key] {
+			return fmt.Errorf("duplicated reveal for validator %s at height %d", r.Validator, r.Height)
+		}
+		seenReveals[key] = true
+	}
+
+	seenRequests := make(map[uint64]bool)
+	for _, r := range gs.Requests {
+		if seenRequests[r.ID] {
+			return fmt.Errorf("duplicated request %d", r.ID)
+		}
+		if r.ID >= gs.NextRequestID {
+			return fmt.Errorf("request id %d must be lower than the next request id %d", r.ID, gs.NextRequestID)
+		}
+		if r.CallbackGasLimit == 0 || r.CallbackGasLimit > MaxCallbackGasLimit {
+			return fmt.Errorf("invalid callback gas limit %d for request %d", r.CallbackGasLimit, r.ID)
+		}
+		seenRequests[r.ID] = true
+	}
+
+	if gs.NextRequestID == 0 {
+		return fmt.Errorf("next request id cannot be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	validator := common.HexToAddress("0x1")
+	requester := common.HexToAddress("0x2")
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			&GenesisState{
+				Seed:          common.HexToHash("0x1"),
+				Randomness:    []Randomness{{Height: 1, Value: common.HexToHash("0x2")}},
+				Commitments:   []Commitment{{Validator: validator, Height: 1, Hash: common.HexToHash("0x3")}},
+				Reveals:       []Reveal{{Validator: validator, Height: 11, Secret: common.HexToHash("0x4")}},
+				Requests:      []Request{{ID: 1, Requester: requester, Height: 2, CallbackGasLimit: 100_000}},
+				NextRequestID: 2,
+			},
+			true,
+		},
+		{
+			"invalid genesis - duplicated randomness",
+			&GenesisState{
+				Randomness:    []Randomness{{Height: 1}, {Height: 1}},
+				NextRequestID: 1,
+			},
+			false,
+		},
+		{
+			"invalid genesis - duplicated reveal",
+			&GenesisState{
+				Reveals: []Reveal{
+					{Validator: validator, Height: 11, Secret: common.HexToHash("0x3")},
+					{Validator: validator, Height: 11, Secret: common.HexToHash("0x4")},
+				},
+				NextRequestID: 1,
+			},
+			false,
+		},
+		{
+			"invalid genesis - duplicated commitment",
+			&GenesisState{
+				Commitments: []Commitment{
+					{Validator: validator, Hash: common.HexToHash("0x3")},
+					{Validator: validator, Hash: common.HexToHash("0x4")},
+				},
+				NextRequestID: 1,
+			},
+			false,
+		},
+		{
+			"invalid genesis - empty commitment",
+			&GenesisState{
+				Commitments:   []Commitment{{Validator: validator}},
+				NextRequestID: 1,
+			},
+			false,
+		},
+		{
+			"invalid genesis - request id not lower than next request id",
+			&GenesisState{
+				Requests:      []Request{{ID: 1, Requester: requester, CallbackGasLimit: 100_000}},
+				NextRequestID: 1,
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid callback gas limit",
+			&GenesisState{
+				Requests:      []Request{{ID: 1, Requester: requester, CallbackGasLimit: MaxCallbackGasLimit + 1}},
+				NextRequestID: 2,
+			},
+			false,
+		},
+		{
+			"invalid genesis - zero next request id",
+			&GenesisState{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *GenesisTestSuite) TestEncoding() {
+	commitment := Commitment{Validator: common.HexToAddress("0x1"), Height: 10, Hash: common.HexToHash("0x2")}
+	decodedCommitment, err := UnmarshalCommitment(commitment.Validator, commitment.Marshal())
+	suite.Require().NoError(err)
+	suite.Require().Equal(commitment, decodedCommitment)
+
+	request := Request{ID: 3, Requester: common.HexToAddress("0x4"), Height: 5, CallbackGasLimit: 6}
+	decodedRequest, err := UnmarshalRequest(request.Marshal())
+	suite.Require().NoError(err)
+	suite.Require().Equal(request, decodedRequest)
+
+	_, err = UnmarshalRequest(request.Marshal()[1:])
+	suite.Require().Error(err)
+}
@@ -0,0 +1,41 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+)
+
+// AccountKeeper defines the expected interface needed to retrieve the module account.
+type AccountKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+}
+
+// StakingKeeper defines the expected interface needed to check the validator status.
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+}
+
+// SlashingKeeper defines the expected interface needed to jail the validators
+// that miss their reveals.
+type SlashingKeeper interface {
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+	HasValidatorSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+	DowntimeJailDuration(ctx sdk.Context) time.Duration
+}
+
+// EVMKeeper defines the expected EVM keeper interface used to execute the
+// randomness request callbacks.
+type EVMKeeper interface {
+	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
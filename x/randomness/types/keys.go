@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "randomness"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for randomness
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the randomness persistent store
+const (
+	prefixRandomness = iota + 1
+	prefixCommitment
+	prefixReveal
+	prefixRequest
+	prefixNextRequestID
+	prefixSeed
+)
+
+// KVStore key prefixes
+var (
+	// KeyPrefixRandomness defines the prefix key for the randomness of each height
+	KeyPrefixRandomness = []byte{prefixRandomness}
+	// KeyPrefixCommitment defines the prefix key for the validator commitments
+	KeyPrefixCommitment = []byte{prefixCommitment}
+	// KeyPrefixReveal defines the prefix key for the validator reveals, indexed by reveal height
+	KeyPrefixReveal = []byte{prefixReveal}
+	// KeyPrefixRequest defines the prefix key for the pending randomness requests
+	KeyPrefixRequest = []byte{prefixRequest}
+	// KeyNextRequestID defines the key for the identifier of the next randomness request
+	KeyNextRequestID = []byte{prefixNextRequestID}
+	// KeySeed defines the key for the latest randomness, used as the seed of the next block
+	KeySeed = []byte{prefixSeed}
+)
+
+// RandomnessKey returns the key of the randomness for the given height.
+func RandomnessKey(height uint64) []byte {
+	return sdk.Uint64ToBigEndian(height)
+}
+
+// RequestKey returns the key of a randomness request. Requests are indexed by
+// the height at which they are fulfilled so they can be iterated in order.
+func RequestKey(height, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// RevealKey returns the key of a reveal. Reveals are indexed by the height at
+// which they are mixed into the randomness so they can be iterated in order.
+func RevealKey(height uint64, validator common.Address) []byte {
+	return append(sdk.Uint64ToBigEndian(height), validator.Bytes()...)
+}
+
+// ValidatorKey returns the key of a commitment of the given validator.
+func ValidatorKey(validator common.Address) []byte {
+	return validator.Bytes()
+}
@@ -0,0 +1,144 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// RequestDelay defines the number of blocks between a randomness request
+	// and its fulfillment. The randomness used to fulfill a request includes
+	// the last commit of blocks committed after the request, so it cannot be
+	// known by the requester at the time of the request.
+	RequestDelay uint64 = 2
+	// RevealWindow defines the number of blocks after a commitment at which
+	// its secret is mixed into the randomness. The secret must be revealed
+	// before then, otherwise the validator is jailed for the downtime jail
+	// duration of the slashing module. The window spans several
+	// proposers, so that a single proposer cannot exclude a reveal.
+	RevealWindow uint64 = 10
+	// MaxCallbackGasLimit defines the maximum gas limit of a randomness request callback.
+	MaxCallbackGasLimit uint64 = 1_000_000
+	// MaxBlockCallbackGas defines the maximum total gas limit of the callbacks
+	// executed in a block. The requests that exceed it are fulfilled on the
+	// next blocks, in order.
+	MaxBlockCallbackGas uint64 = 10_000_000
+	// RandomnessRetention defines the number of blocks for which the randomness
+	// is kept in the store.
+	RandomnessRetention uint64 = 10_000
+)
+
+// commitmentLength is the length of the stored commitment (height + hash).
+const commitmentLength = 8 + common.HashLength
+
+// revealLength is the length of the stored reveal (secret).
+const revealLength = common.HashLength
+
+// requestLength is the length of the stored request (id + requester + height + gas limit).
+const requestLength = 8 + common.AddressLength + 8 + 8
+
+// Commitment defines the hash of a secret committed by a validator, which must
+// be revealed on a later block to be included in the randomness.
+type Commitment struct {
+	Validator common.Address `json:"validator"`
+	Height    uint64         `json:"height"`
+	Hash      common.Hash    `json:"hash"`
+}
+
+// Marshal encodes the commitment height and hash. The validator address is
+// part of the store key.
+func (c Commitment) Marshal() []byte {
+	bz := make([]byte, commitmentLength)
+	binary.BigEndian.PutUint64(bz[:8], c.Height)
+	copy(bz[8:], c.Hash.Bytes())
+	return bz
+}
+
+// RevealHeight returns the height at which the secret of the commitment is
+// mixed into the randomness, which is the deadline to reveal it.
+func (c Commitment) RevealHeight() uint64 {
+	return c.Height + RevealWindow
+}
+
+// UnmarshalCommitment decodes the commitment of the given validator.
+func UnmarshalCommitment(validator common.Address, bz []byte) (Commitment, error) {
+	if len(bz) != commitmentLength {
+		return Commitment{}, fmt.Errorf("invalid commitment length, expected %d, got %d", commitmentLength, len(bz))
+	}
+
+	return Commitment{
+		Validator: validator,
+		Height:    binary.BigEndian.Uint64(bz[:8]),
+		Hash:      common.BytesToHash(bz[8:]),
+	}, nil
+}
+
+// Reveal defines a secret revealed by a validator, which is mixed into the
+// randomness of the reveal height of its commitment.
+type Reveal struct {
+	Validator common.Address `json:"validator"`
+	Height    uint64         `json:"height"`
+	Secret    common.Hash    `json:"secret"`
+}
+
+// UnmarshalReveal decodes the reveal of the given validator and height, which
+// are part of the store key.
+func UnmarshalReveal(validator common.Address, height uint64, bz []byte) (Reveal, error) {
+	if len(bz) != revealLength {
+		return Reveal{}, fmt.Errorf("invalid reveal length, expected %d, got %d", revealLength, len(bz))
+	}
+
+	return Reveal{
+		Validator: validator,
+		Height:    height,
+		Secret:    common.BytesToHash(bz),
+	}, nil
+}
+
+// Request defines a pending randomness request. The requester contract is
+// called back with the randomness of the given height.
+type Request struct {
+	ID               uint64         `json:"id"`
+	Requester        common.Address `json:"requester"`
+	Height           uint64         `json:"height"`
+	CallbackGasLimit uint64         `json:"callback_gas_limit"`
+}
+
+// Marshal encodes the request.
+func (r Request) Marshal() []byte {
+	bz := make([]byte, requestLength)
+	binary.BigEndian.PutUint64(bz[:8], r.ID)
+	copy(bz[8:8+common.AddressLength], r.Requester.Bytes())
+	binary.BigEndian.PutUint64(bz[8+common.AddressLength:16+common.AddressLength], r.Height)
+	binary.BigEndian.PutUint64(bz[16+common.AddressLength:], r.CallbackGasLimit)
+	return bz
+}
+
+// UnmarshalRequest decodes a request.
+func UnmarshalRequest(bz []byte) (Request, error) {
+	if len(bz) != requestLength {
+		return Request{}, fmt.Errorf("invalid request length, expected %d, got %d", requestLength, len(bz))
+	}
+
+	return Request{
+		ID:               binary.BigEndian.Uint64(bz[:8]),
+		Requester:        common.BytesToAddress(bz[8 : 8+common.AddressLength]),
+		Height:           binary.BigEndian.Uint64(bz[8+common.AddressLength : 16+common.AddressLength]),
+		CallbackGasLimit: binary.BigEndian.Uint64(bz[16+common.AddressLength:]),
+	}, nil
+}
+
+// Randomness defines the randomness of a given height.
+type Randomness struct {
+	Height uint64      `json:"height"`
+	Value  common.Hash `json:"value"`
+}
+
+// FulfillRandomnessSignature defines the signature of the callback method
+// called on the requester contract once its randomness request is fulfilled.
+const FulfillRandomnessSignature = "fulfillRandomness(uint256,bytes32)"
@@ -5,6 +5,7 @@ package revenue
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/evmos/evmos/v16/x/revenue/v1/keeper"
@@ -17,7 +18,17 @@ func InitGenesis(
 	k keeper.Keeper,
 	data types.GenesisState,
 ) {
-	err := k.SetParams(ctx, data.Params)
+	// convert the deprecated developer shares of the genesis states exported before
+	// the x/feemarket EVM fee split was introduced
+	params := data.Params
+	if !params.DeveloperShares.IsZero() {
+		if err := k.SetEVMDevelopersShare(ctx, params.DeveloperShares); err != nil {
+			panic(errorsmod.Wrapf(err, "failed converting the developer shares"))
+		}
+		params.DeveloperShares = math.LegacyZeroDec()
+	}
+
+	err := k.SetParams(ctx, params)
 	if err != nil {
 		panic(errorsmod.Wrapf(err, "failed setting params"))
 	}
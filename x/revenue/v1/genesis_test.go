@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
@@ -111,6 +112,63 @@ func (suite *GenesisTestSuite) TestRevenueInitGenesis() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestRevenueInitGenesisDeveloperShares() {
+	testCases := []struct {
+		name           string
+		evmFeeSplit    feemarkettypes.FeeSplit
+		expPanic       bool
+		expEVMFeeSplit feemarkettypes.FeeSplit
+	}{
+		{
+			"fail - developer shares exceed the validators share",
+			feemarkettypes.NewFeeSplit(math.LegacyNewDecWithPrec(90, 2), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(10, 2), math.LegacyZeroDec()),
+			true,
+			feemarkettypes.FeeSplit{},
+		},
+		{
+			"pass - developer shares taken from the validators share",
+			feemarkettypes.DefaultEVMFeeSplit(),
+			false,
+			feemarkettypes.NewFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(80, 2), math.LegacyNewDecWithPrec(20, 2)),
+		},
+		{
+			"pass - developer shares replace the developers share",
+			feemarkettypes.NewFeeSplit(math.LegacyNewDecWithPrec(10, 2), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(40, 2), math.LegacyNewDecWithPrec(50, 2)),
+			false,
+			feemarkettypes.NewFeeSplit(math.LegacyNewDecWithPrec(10, 2), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(70, 2), math.LegacyNewDecWithPrec(20, 2)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			feeMarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			feeMarketParams.EVMFeeSplit = tc.evmFeeSplit
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams))
+
+			// genesis state exported before the deprecation of the developer shares
+			genesis := suite.genesis
+			genesis.Params.DeveloperShares = math.LegacyNewDecWithPrec(20, 2)
+			suite.Require().NoError(genesis.Validate())
+
+			if tc.expPanic {
+				suite.Require().Panics(func() {
+					revenue.InitGenesis(suite.ctx, suite.app.RevenueKeeper, genesis)
+				})
+				return
+			}
+
+			suite.Require().NotPanics(func() {
+				revenue.InitGenesis(suite.ctx, suite.app.RevenueKeeper, genesis)
+			})
+
+			suite.Require().True(suite.app.RevenueKeeper.GetParams(suite.ctx).DeveloperShares.IsZero())
+			suite.Require().Equal(tc.expEVMFeeSplit, suite.app.FeeMarketKeeper.GetParams(suite.ctx).EVMFeeSplit)
+		})
+	}
+}
+
 func (suite *GenesisTestSuite) TestRevenueExportGenesis() {
 	revenue.InitGenesis(suite.ctx, suite.app.RevenueKeeper, suite.genesis)
 
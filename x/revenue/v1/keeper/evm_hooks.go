@@ -5,7 +5,6 @@ package keeper
 
 import (
 	errorsmod "cosmossdk.io/errors"
-	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -36,8 +35,8 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 
 // PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
 // interaction with a registered contract, the contract deployer (or, if set,
-// the withdraw address) receives a share from the transaction fees paid by the
-// transaction sender.
+// the withdraw address) receives the developers share of the EVM fee split
+// from the transaction fees paid by the transaction sender.
 func (k Keeper) PostTxProcessing(
 	ctx sdk.Context,
 	msg core.Message,
@@ -50,10 +49,11 @@ func (k Keeper) PostTxProcessing(
 		return nil
 	}
 
-	// check if the fees are globally enabled or if the
-	// developer shares are set to zero
+	// check if the fees are globally enabled or if the developers share of the
+	// EVM fee split is set to zero
 	params := k.GetParams(ctx)
-	if !params.EnableRevenue || params.DeveloperShares.IsZero() {
+	evmFeeSplit := k.feeMarketKeeper.GetParams(ctx).EVMFeeSplit
+	if !params.EnableRevenue || evmFeeSplit.Developers.IsZero() {
 		return nil
 	}
 
@@ -76,11 +76,17 @@ func (k Keeper) PostTxProcessing(
 		}
 	}
 
-	// calculate fees to be paid
-	txFee := math.NewIntFromUint64(receipt.GasUsed).Mul(math.NewIntFromBigInt(msg.GasPrice()))
-	developerFee := (params.DeveloperShares).MulInt(txFee).TruncateInt()
-	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
-	fees := sdk.Coins{{Denom: evmDenom, Amount: developerFee}}
+	// calculate fees to be paid, which are the developers share of the fees charged
+	// for the gas used, in the accepted fee denom used to pay them, if any
+	txFees, err := k.evmKeeper.GasUsedFeeCoins(ctx, msg, receipt.GasUsed, evmParams.EvmDenom)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to compute the developer fees")
+	}
+
+	fees := evmFeeSplit.Split(txFees).Developers
+	if fees.IsZero() {
+		return nil
+	}
 
 	// get available precompiles from evm params and check if contract is in the list
 	if containsPrecompile {
@@ -111,7 +117,7 @@ func (k Keeper) PostTxProcessing(
 				sdk.NewAttribute(sdk.AttributeKeySender, msg.From().String()),
 				sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
 				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, withdrawer.String()),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, developerFee.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, fees.String()),
 			),
 		},
 	)
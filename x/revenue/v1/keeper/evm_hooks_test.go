@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v16/testutil"
+	"github.com/evmos/evmos/v16/utils"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
+	"github.com/evmos/evmos/v16/x/revenue/v1/types"
+)
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	var (
+		feeDenom = "uusdc"
+		gasUsed  = uint64(100_000)
+		gasPrice = big.NewInt(1_000)
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expFees  sdk.Coins
+	}{
+		{
+			"pass - fees paid in the EVM denom",
+			func() {},
+			// 100_000 gas * 1_000 * 50% developers share
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 50_000_000)),
+		},
+		{
+			"pass - fees paid in an accepted fee denom",
+			func() {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.FeeDenoms = []feemarkettypes.FeeDenom{feemarkettypes.NewFeeDenom(feeDenom, math.LegacyNewDecWithPrec(5, 1))}
+				suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+				suite.app.EvmKeeper.SetTxFeeDenomTransient(suite.ctx, suite.address, 0, feeDenom)
+			},
+			// 100_000 gas * 1_000 * 50% developers share * 0.5 conversion rate
+			sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 25_000_000)),
+		},
+		{
+			"pass - fees discounted by the gas price multiplier of the contract",
+			func() {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.ContractGasPriceMultipliers = []feemarkettypes.ContractGasPriceMultiplier{
+					feemarkettypes.NewContractGasPriceMultiplier(contract, math.LegacyNewDecWithPrec(5, 1)),
+				}
+				suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+			},
+			// 100_000 gas * 1_000 * 0.5 multiplier * 50% developers share
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 25_000_000)),
+		},
+		{
+			"pass - fees surcharged by the gas price multiplier of the contract",
+			func() {
+				params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+				params.ContractGasPriceMultipliers = []feemarkettypes.ContractGasPriceMultiplier{
+					feemarkettypes.NewContractGasPriceMultiplier(contract, math.LegacyNewDec(2)),
+				}
+				suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+			},
+			// 100_000 gas * 1_000 * 2 multiplier * 50% developers share
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 100_000_000)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.EVMFeeSplit = feemarkettypes.NewFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyNewDecWithPrec(50, 2), math.LegacyNewDecWithPrec(50, 2))
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+			suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(contract, deployer, withdraw))
+
+			collected := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1e9), sdk.NewInt64Coin(feeDenom, 1e9))
+			err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, collected)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			msg := ethtypes.NewMessage(suite.address, &contract, 0, nil, gasUsed, gasPrice, gasPrice, gasPrice, nil, nil, false)
+			err = suite.app.RevenueKeeper.PostTxProcessing(suite.ctx, msg, &ethtypes.Receipt{GasUsed: gasUsed})
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.expFees.String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, withdraw).String())
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package keeper_test
 
 import (
-	"fmt"
 	"math"
 	"math/big"
 	"strings"
@@ -198,6 +197,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 			params = types.DefaultParams()
 			params.EnableRevenue = true
 			s.app.RevenueKeeper.SetParams(s.ctx, params) //nolint:errcheck
+			setDeveloperShares(sdkmath.LegacyNewDecWithPrec(50, 2))
 		})
 
 		Describe("Registering a contract for receiving tx fees", func() {
@@ -240,7 +240,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 					s.Commit()
 
-					developerCoins, _ := calculateFees(denom, params, res, gasPrice)
+					developerCoins, _ := calculateFees(denom, res, gasPrice)
 					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
 					Expect(developerCoins.IsPositive()).To(BeTrue())
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
@@ -339,7 +339,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 					s.Commit()
 
-					developerCoins, _ := calculateFees(denom, params, res, gasPrice)
+					developerCoins, _ := calculateFees(denom, res, gasPrice)
 					balance := s.app.BankKeeper.GetBalance(s.ctx, withdrawerAddress, denom)
 					Expect(developerCoins.IsPositive()).To(BeTrue())
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
@@ -371,9 +371,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 			Context("with a 50/50 validators-developers revenue", func() {
 				BeforeEach(func() {
-					params = s.app.RevenueKeeper.GetParams(s.ctx)
-					params.DeveloperShares = sdkmath.LegacyNewDecWithPrec(50, 2)
-					s.app.RevenueKeeper.SetParams(s.ctx, params) //nolint:errcheck
+					setDeveloperShares(sdkmath.LegacyNewDecWithPrec(50, 2))
 				})
 
 				It("should transfer legacy tx fees to validators and contract developer evenly", func() {
@@ -383,7 +381,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					data := make([]byte, 0)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 
-					developerCoins, validatorCoins := calculateFees(denom, params, res, gasPrice)
+					developerCoins, validatorCoins := calculateFees(denom, res, gasPrice)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
 					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
 
@@ -410,7 +408,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 						&ethtypes.AccessList{},
 					)
 
-					developerCoins, validatorCoins := calculateFees(denom, params, res, gasFeeCap)
+					developerCoins, validatorCoins := calculateFees(denom, res, gasFeeCap)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
 					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
@@ -421,9 +419,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 			Context("with a 100/0 validators-developers revenue", func() {
 				BeforeEach(func() {
-					params = s.app.RevenueKeeper.GetParams(s.ctx)
-					params.DeveloperShares = sdkmath.LegacyNewDec(0)
-					s.app.RevenueKeeper.SetParams(s.ctx, params) //nolint:errcheck
+					setDeveloperShares(sdkmath.LegacyNewDec(0))
 				})
 
 				It("should transfer all tx fees to validators", func() {
@@ -442,7 +438,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 						&ethtypes.AccessList{},
 					)
 
-					_, validatorCoins := calculateFees(denom, params, res, gasFeeCap)
+					_, validatorCoins := calculateFees(denom, res, gasFeeCap)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
 					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance))
@@ -453,9 +449,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 			Context("with a 0/100 validators-developers revenue", func() {
 				BeforeEach(func() {
-					params = s.app.RevenueKeeper.GetParams(s.ctx)
-					params.DeveloperShares = sdkmath.LegacyNewDec(1)
-					s.app.RevenueKeeper.SetParams(s.ctx, params) //nolint:errcheck
+					setDeveloperShares(sdkmath.LegacyNewDec(1))
 				})
 
 				It("should transfer all tx fees to developers", func() {
@@ -474,7 +468,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 						&ethtypes.AccessList{},
 					)
 
-					developerCoins, _ := calculateFees(denom, params, res, gasFeeCap)
+					developerCoins, _ := calculateFees(denom, res, gasFeeCap)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
 					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
@@ -486,16 +480,8 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 		Describe("Funding community pool from precompiled contract calls", func() {
 			Context("calling a precompiled registered contract with 100/0 community pool revenue", func() {
-				var params types.Params
-
 				BeforeEach(func() {
-					params = s.app.RevenueKeeper.GetParams(s.ctx)
-					params.DeveloperShares = sdkmath.LegacyNewDec(1)
-					err := s.app.RevenueKeeper.SetParams(s.ctx, params)
-					if err != nil {
-						fmt.Println(err)
-						return
-					}
+					setDeveloperShares(sdkmath.LegacyNewDec(1))
 				})
 
 				It("should transfer all tx fees to the community pool", func() {
@@ -516,7 +502,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 						&ethtypes.AccessList{},
 					)
 					Expect(res.IsOK()).To(BeTrue())
-					communityCoins, _ := calculateFees(denom, params, res, gasFeeCap)
+					communityCoins, _ := calculateFees(denom, res, gasFeeCap)
 					communityCoinsDec := sdk.NewDecCoinFromCoin(communityCoins)
 					communityPoolAfter := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
 					Expect(communityPoolAfter).To(Equal(communityPoolBefore.Add(communityCoinsDec)))
@@ -587,7 +573,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 					s.Commit()
 
-					developerCoins, _ := calculateFees(denom, params, res, gasPrice)
+					developerCoins, _ := calculateFees(denom, res, gasPrice)
 					balanceD := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
 					balanceW := s.app.BankKeeper.GetBalance(s.ctx, withdrawerAddress, denom)
 					Expect(balanceW).To(Equal(preBalanceW.Add(developerCoins)))
@@ -785,7 +771,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					data := make([]byte, 0)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, data, nil)
 
-					developerCoins, _ := calculateFees(denom, params, res, gasPrice)
+					developerCoins, _ := calculateFees(denom, res, gasPrice)
 					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					s.Commit()
@@ -808,7 +794,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 						&ethtypes.AccessList{},
 					)
 
-					developerCoins, _ := calculateFees(denom, params, res, gasFeeCap)
+					developerCoins, _ := calculateFees(denom, res, gasFeeCap)
 					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					s.Commit()
@@ -25,6 +25,7 @@ type Keeper struct {
 	evmKeeper          types.EVMKeeper
 	accountKeeper      types.AccountKeeper
 	distributionKeeper types.DistributionKeeper
+	feeMarketKeeper    types.FeeMarketKeeper
 	feeCollectorName   string
 }
 
@@ -37,6 +38,7 @@ func NewKeeper(
 	dk types.DistributionKeeper,
 	ak types.AccountKeeper,
 	evmKeeper types.EVMKeeper,
+	feeMarketKeeper types.FeeMarketKeeper,
 	feeCollector string,
 ) Keeper {
 	return Keeper{
@@ -46,6 +48,7 @@ func NewKeeper(
 		bankKeeper:         bk,
 		distributionKeeper: dk,
 		evmKeeper:          evmKeeper,
+		feeMarketKeeper:    feeMarketKeeper,
 		accountKeeper:      ak,
 		feeCollectorName:   feeCollector,
 	}
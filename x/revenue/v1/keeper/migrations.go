@@ -6,6 +6,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v2 "github.com/evmos/evmos/v16/x/revenue/v1/migrations/v2"
+	v3 "github.com/evmos/evmos/v16/x/revenue/v1/migrations/v3"
 	"github.com/evmos/evmos/v16/x/revenue/v1/types"
 )
 
@@ -27,3 +28,8 @@ func NewMigrator(keeper Keeper, legacySubspace types.Subspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates the store from consensus version 2 to 3
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
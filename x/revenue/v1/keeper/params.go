@@ -4,8 +4,12 @@
 package keeper
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 	"github.com/evmos/evmos/v16/x/revenue/v1/types"
 )
 
@@ -21,6 +25,32 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
+// GetDeveloperShares returns the deprecated developer shares. It's used to
+// migrate them to the developers share of the x/feemarket EVM fee split.
+func (k Keeper) GetDeveloperShares(ctx sdk.Context) math.LegacyDec {
+	return k.GetParams(ctx).DeveloperShares
+}
+
+// SetEVMDevelopersShare sets the developers share of the x/feemarket EVM fee
+// split to the given deprecated developer shares, which are taken from the
+// validators share. It's used to convert the developer shares of the genesis
+// state.
+func (k Keeper) SetEVMDevelopersShare(ctx sdk.Context, developerShares math.LegacyDec) error {
+	params := k.feeMarketKeeper.GetParams(ctx)
+	feeSplit := params.EVMFeeSplit
+
+	validators := feeSplit.Validators.Add(feeSplit.Developers).Sub(developerShares)
+	if validators.IsNegative() {
+		return fmt.Errorf(
+			"developer shares %s exceed the validators and developers shares of the EVM fee split: %s, %s",
+			developerShares, feeSplit.Validators, feeSplit.Developers,
+		)
+	}
+
+	params.EVMFeeSplit = feemarkettypes.NewFeeSplit(feeSplit.Burn, feeSplit.CommunityPool, validators, developerShares)
+	return k.feeMarketKeeper.SetParams(ctx, params)
+}
+
 // SetParams sets the revenue params in a single key
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	store := ctx.KVStore(k.storeKey)
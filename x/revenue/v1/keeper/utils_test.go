@@ -20,6 +20,7 @@ import (
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/utils"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 	"github.com/evmos/evmos/v16/x/revenue/v1/types"
 	"github.com/stretchr/testify/require"
 )
@@ -107,19 +108,32 @@ func (suite *KeeperTestSuite) CommitAfter(t time.Duration) {
 
 func calculateFees(
 	denom string,
-	params types.Params,
 	res abci.ResponseDeliverTx,
 	gasPrice *big.Int,
 ) (sdk.Coin, sdk.Coin) {
+	developerShares := s.app.FeeMarketKeeper.GetParams(s.ctx).EVMFeeSplit.Developers
 	feeDistribution := math.NewInt(res.GasUsed).Mul(math.NewIntFromBigInt(gasPrice))
-	developerFee := math.LegacyNewDecFromInt(feeDistribution).Mul(params.DeveloperShares)
+	developerFee := math.LegacyNewDecFromInt(feeDistribution).Mul(developerShares)
 	developerCoins := sdk.NewCoin(denom, developerFee.TruncateInt())
-	validatorShares := math.LegacyOneDec().Sub(params.DeveloperShares)
+	validatorShares := math.LegacyOneDec().Sub(developerShares)
 	validatorFee := math.LegacyNewDecFromInt(feeDistribution).Mul(validatorShares)
 	validatorCoins := sdk.NewCoin(denom, validatorFee.TruncateInt())
 	return developerCoins, validatorCoins
 }
 
+// setDeveloperShares sets the developers share of the EVM fee split, the
+// remaining fees being kept by the validators.
+func setDeveloperShares(developerShares math.LegacyDec) {
+	params := s.app.FeeMarketKeeper.GetParams(s.ctx)
+	params.EVMFeeSplit = feemarkettypes.NewFeeSplit(
+		math.LegacyZeroDec(),
+		math.LegacyZeroDec(),
+		math.LegacyOneDec().Sub(developerShares),
+		developerShares,
+	)
+	s.app.FeeMarketKeeper.SetParams(s.ctx, params) //nolint:errcheck
+}
+
 func getNonce(addressBytes []byte) uint64 {
 	return s.app.EvmKeeper.GetNonce(
 		s.ctx,
@@ -0,0 +1,48 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:LGPL-3.0-only
+
+package v3
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v16/x/revenue/v1/types"
+)
+
+// MigrateStore migrates the x/revenue module state from the consensus version 2 to
+// version 3. Specifically, it zeroes the deprecated developer shares, which are
+// superseded by the developers share of the x/feemarket EVM fee split.
+//
+// NOTE: the x/feemarket migration to its consensus version 6 builds the EVM fee
+// split from the developer shares, so it must run before this migration, as
+// declared by the order of the module migrations of the app.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+
+	paramsBz := store.Get(types.ParamsKey)
+	if err := cdc.Unmarshal(paramsBz, &params); err != nil {
+		return err
+	}
+
+	params.DeveloperShares = math.LegacyZeroDec()
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
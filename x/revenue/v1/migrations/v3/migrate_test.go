@@ -0,0 +1,36 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v16/app"
+	"github.com/evmos/evmos/v16/encoding"
+	v3 "github.com/evmos/evmos/v16/x/revenue/v1/migrations/v3"
+	"github.com/evmos/evmos/v16/x/revenue/v1/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	// params stored before the developer shares were deprecated
+	v2Params := types.NewParams(false, math.LegacyNewDecWithPrec(60, 2), 100)
+	kvStore.Set(types.ParamsKey, cdc.MustMarshal(&v2Params))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(kvStore.Get(types.ParamsKey), &params)
+
+	require.Equal(t, types.NewParams(false, math.LegacyZeroDec(), 100), params)
+}
@@ -28,7 +28,7 @@ import (
 )
 
 // consensusVersion defines the current x/v1/revenue module consensus version.
-const consensusVersion = 2
+const consensusVersion = 3
 
 // type check to ensure the interface is properly implemented
 var (
@@ -149,6 +149,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(errorsmod.Wrapf(err, "error running store migration"))
 	}
+
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(errorsmod.Wrapf(err, "error running store migration"))
+	}
 }
 
 // InitGenesis performs the fees module's genesis initialization. It returns
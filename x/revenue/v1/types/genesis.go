@@ -38,5 +38,5 @@ func (gs GenesisState) Validate() error {
 		seenContract[fs.ContractAddress] = true
 	}
 
-	return gs.Params.Validate()
+	return gs.Params.ValidateGenesis()
 }
@@ -83,8 +83,9 @@ func (m *GenesisState) GetRevenues() []Revenue {
 type Params struct {
 	// enable_revenue defines a parameter to enable the revenue module
 	EnableRevenue bool `protobuf:"varint,1,opt,name=enable_revenue,json=enableRevenue,proto3" json:"enable_revenue,omitempty"`
-	// developer_shares defines the proportion of the transaction fees to be
-	// distributed to the registered contract owner
+	// DEPRECATED: developer_shares defined the proportion of the transaction fees
+	// to be distributed to the registered contract owner. It is superseded by the
+	// developers share of the x/feemarket EVM fee split and must be zero.
 	DeveloperShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=developer_shares,json=developerShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"developer_shares"`
 	// addr_derivation_cost_create defines the cost of address derivation for
 	// verifying the contract deployer at fee registration
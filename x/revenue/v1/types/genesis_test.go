@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	utiltx "github.com/evmos/evmos/v16/testutil/tx"
 	"github.com/evmos/evmos/v16/x/revenue/v1/types"
@@ -67,6 +69,14 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: true,
 		},
+		{
+			name: "valid genesis - deprecated developer shares",
+			genState: &types.GenesisState{
+				Params:   types.NewParams(true, math.LegacyNewDecWithPrec(50, 2), types.DefaultAddrDerivationCostCreate),
+				Revenues: []types.Revenue{},
+			},
+			expPass: true,
+		},
 		{
 			name:     "empty genesis",
 			genState: &types.GenesisState{},
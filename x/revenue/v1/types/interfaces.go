@@ -9,11 +9,13 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/evmos/evmos/v16/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v16/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v16/x/feemarket/types"
 )
 
 // AccountKeeper defines the expected interface needed to retrieve account info.
@@ -40,6 +42,15 @@ type EVMKeeper interface {
 	EVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress, chainID *big.Int) (*statedb.EVMConfig, error)
 	GetParams(ctx sdk.Context) evmtypes.Params
 	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	GasUsedFeeCoins(ctx sdk.Context, msg core.Message, gasUsed uint64, denom string) (sdk.Coins, error)
+}
+
+// FeeMarketKeeper defines the expected fee market keeper interface used to
+// retrieve the developers share of the EVM transaction fees and to set it from
+// the deprecated developer shares of the genesis state.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) feemarkettypes.Params
+	SetParams(ctx sdk.Context, params feemarkettypes.Params) error
 }
 
 type (
@@ -11,8 +11,10 @@ import (
 
 // Parameter store key
 var (
-	DefaultEnableRevenue   = true
-	DefaultDeveloperShares = math.LegacyNewDecWithPrec(50, 2) // 50%
+	DefaultEnableRevenue = true
+	// DefaultDeveloperShares is zero as the developer shares are deprecated in favour
+	// of the developers share of the x/feemarket EVM fee split
+	DefaultDeveloperShares = math.LegacyZeroDec()
 	// DefaultAddrDerivationCostCreate Cost for executing `crypto.CreateAddress` must be at least 36 gas for the
 	// contained keccak256(word) operation
 	DefaultAddrDerivationCostCreate = uint64(50)
@@ -86,7 +88,31 @@ func validateShares(i interface{}) error {
 	return nil
 }
 
+// validateDeprecatedShares returns an error if the deprecated developer shares
+// are set, since they have no effect.
+func validateDeprecatedShares(i interface{}) error {
+	if err := validateShares(i); err != nil {
+		return err
+	}
+
+	if !i.(math.LegacyDec).IsZero() {
+		return fmt.Errorf("developer shares are deprecated and must be zero, use the developers share of the x/feemarket EVM fee split instead")
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
+	if err := p.ValidateGenesis(); err != nil {
+		return err
+	}
+	return validateDeprecatedShares(p.DeveloperShares)
+}
+
+// ValidateGenesis performs the validation of the genesis params. Unlike Validate,
+// it accepts the deprecated developer shares, which are converted to the
+// developers share of the x/feemarket EVM fee split on InitGenesis.
+func (p Params) ValidateGenesis() error {
 	if err := validateBool(p.EnableRevenue); err != nil {
 		return err
 	}
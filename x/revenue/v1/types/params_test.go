@@ -19,18 +19,23 @@ func TestParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid: enabled",
-			NewParams(true, devShares, derivCostCreate),
+			NewParams(true, math.LegacyZeroDec(), derivCostCreate),
 			false,
 		},
 		{
 			"valid: disabled",
-			NewParams(false, devShares, derivCostCreate),
+			NewParams(false, math.LegacyZeroDec(), derivCostCreate),
 			false,
 		},
 		{
-			"valid: 100% devs",
+			"invalid: deprecated developer shares are set",
+			NewParams(true, devShares, derivCostCreate),
+			true,
+		},
+		{
+			"invalid: 100% devs",
 			Params{true, math.LegacyNewDecFromInt(math.NewInt(1)), derivCostCreate},
-			false,
+			true,
 		},
 		{
 			"empty",
@@ -49,7 +54,7 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"invalid: wrong address derivation cost",
-			NewParams(true, devShares, 50),
+			NewParams(true, math.LegacyZeroDec(), 50),
 			false,
 		},
 	}
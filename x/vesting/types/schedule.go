@@ -81,6 +81,38 @@ func ReadPastPeriodCount(
 	return passedPeriods
 }
 
+// ReadNextEvent returns the time and the amount of the first event of the
+// schedule after readTime. Simultaneous events are combined into a single
+// event. It returns a zero time and empty coins when all the events have
+// already passed.
+func ReadNextEvent(
+	startTime int64,
+	periods sdkvesting.Periods,
+	readTime int64,
+) (int64, sdk.Coins) {
+	elapsedTime := startTime
+
+	for i, period := range periods {
+		elapsedTime += period.Length
+		// events are only reached after the start time, as in ReadSchedule
+		if readTime > startTime && readTime >= elapsedTime {
+			continue
+		}
+
+		coins := sdk.NewCoins(period.Amount...)
+		for _, next := range periods[i+1:] {
+			if next.Length != 0 {
+				break
+			}
+			coins = coins.Add(next.Amount...)
+		}
+
+		return elapsedTime, coins
+	}
+
+	return 0, sdk.NewCoins()
+}
+
 // DisjunctPeriods returns the union of two vesting period schedules.
 // The returned schedule is the union of the vesting events.
 // Simultaneous events are combined into a single event.
@@ -206,6 +206,74 @@ func (suite *ScheduleTestSuite) TestReadPastPeriodCount() {
 	}
 }
 
+func (suite *ScheduleTestSuite) TestReadNextEvent() {
+	testCases := []struct {
+		name      string
+		startTime int64
+		readTime  int64
+		periods   sdkvesting.Periods
+		expTime   int64
+		expAmount sdk.Coins
+	}{
+		{
+			name:      "empty",
+			startTime: 0,
+			readTime:  0,
+			periods:   sdkvesting.Periods{},
+			expTime:   0,
+			expAmount: sdk.NewCoins(),
+		},
+		{
+			name:      "before start time",
+			startTime: 100,
+			readTime:  0,
+			periods:   sdkvesting.Periods{period(25, 10), period(50, 20), period(25, 40)},
+			expTime:   125,
+			expAmount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		},
+		{
+			name:      "at start time, with event at start time",
+			startTime: 100,
+			readTime:  100,
+			periods:   sdkvesting.Periods{period(0, 10), period(50, 20)},
+			expTime:   100,
+			expAmount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		},
+		{
+			name:      "at end of first period",
+			startTime: 100,
+			readTime:  125,
+			periods:   sdkvesting.Periods{period(25, 10), period(50, 20), period(25, 40)},
+			expTime:   175,
+			expAmount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)),
+		},
+		{
+			name:      "simultaneous events are combined",
+			startTime: 100,
+			readTime:  130,
+			periods:   sdkvesting.Periods{period(25, 10), period(50, 20), period(0, 40), period(25, 40)},
+			expTime:   175,
+			expAmount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60)),
+		},
+		{
+			name:      "at end time, all periods passed",
+			startTime: 100,
+			readTime:  200,
+			periods:   sdkvesting.Periods{period(25, 10), period(50, 20), period(25, 40)},
+			expTime:   0,
+			expAmount: sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			eventTime, amount := ReadNextEvent(tc.startTime, tc.periods, tc.readTime)
+			suite.Require().Equal(tc.expTime, eventTime)
+			suite.Require().Equal(tc.expAmount, amount)
+		})
+	}
+}
+
 func (suite *ScheduleTestSuite) TestDisjunctPeriods() {
 	testCases := []struct {
 		name         string